@@ -0,0 +1,169 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"antoine-cli/internal/config"
+	"antoine-cli/internal/core"
+)
+
+var analyticsCmd = &cobra.Command{
+	Use:   "analytics",
+	Short: "Manage anonymous usage analytics",
+	Long: `Inspect, flush or disable the usage analytics Antoine collects. Nothing is
+sent until you run 'antoine analytics opt-in'; after that, events are queued
+locally and sent in batches to the configured analytics endpoint.`,
+}
+
+var analyticsStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show analytics settings and pending events",
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg := config.Get()
+
+		fmt.Println("📊 Analytics Status:")
+		fmt.Printf("  Enabled: %v, Anonymous: %v\n", cfg.Analytics.Enabled, cfg.Analytics.Anonymous)
+		fmt.Printf("  Endpoint: %s\n", cfg.Analytics.Endpoint)
+		fmt.Printf("  Batch Size: %d, Flush Interval: %s\n", cfg.Analytics.BatchSize, cfg.Analytics.FlushInterval)
+
+		pipeline, err := newTelemetryPipeline(cfg, "")
+		if err != nil {
+			fmt.Printf("  Queue: unavailable (%v)\n", err)
+			return
+		}
+		fmt.Printf("  Export Consent: %v\n", pipeline.Consented())
+
+		pending, err := pipeline.Pending()
+		if err != nil {
+			fmt.Printf("  Queue: unavailable (%v)\n", err)
+			return
+		}
+
+		lastFlush := "never"
+		if t := pipeline.LastFlush(); !t.IsZero() {
+			lastFlush = t.Format("2006-01-02 15:04")
+		}
+		fmt.Printf("  Pending Events: %d, Last Flush: %s\n", len(pending), lastFlush)
+	},
+}
+
+var analyticsFlushCmd = &cobra.Command{
+	Use:   "flush",
+	Short: "Send pending analytics events now",
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg := config.Get()
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		endpoint, _ := cmd.Flags().GetString("endpoint")
+
+		pipeline, err := newTelemetryPipeline(cfg, endpoint)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+
+		enabled := cfg.Analytics.Enabled && pipeline.Consented()
+		if !enabled && !dryRun {
+			fmt.Println("Analytics export is disabled. Run 'antoine analytics opt-in' to enable it.")
+			return
+		}
+
+		if dryRun {
+			// Sin consentimiento la cola está vacía: se muestra lo que saldría del historial local
+			if enabled {
+				err = pipeline.DryRun(os.Stdout)
+			} else {
+				fmt.Println("Analytics export is disabled and nothing is sent. Once you opt in, activity like your local history is sent as:")
+				err = pipeline.Preview(os.Stdout, client.Analytics().TelemetryEvents())
+			}
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+			}
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+
+		sent, err := pipeline.Flush(ctx)
+		if err != nil {
+			fmt.Printf("❌ Flush failed after %d events: %v\n", sent, err)
+			return
+		}
+		fmt.Printf("✅ Sent %d events\n", sent)
+	},
+}
+
+var analyticsOptOutCmd = &cobra.Command{
+	Use:   "opt-out",
+	Short: "Stop collecting and sending analytics",
+	Run: func(cmd *cobra.Command, args []string) {
+		if exporter := client.Analytics().Exporter(); exporter != nil {
+			exporter.Stop()
+			client.Analytics().SetExporter(nil)
+		}
+
+		if pipeline, err := newTelemetryPipeline(config.Get(), ""); err == nil {
+			if err := pipeline.Clear(); err != nil {
+				fmt.Printf("⚠️  Could not clear pending events: %v\n", err)
+			}
+			if err := pipeline.SetConsent(false); err != nil {
+				fmt.Printf("⚠️  Could not save analytics consent: %v\n", err)
+			}
+		}
+
+		if err := config.Set("analytics.enabled", "false"); err != nil {
+			fmt.Printf("❌ Failed to save configuration: %v\n", err)
+			return
+		}
+		fmt.Println("✅ Analytics disabled. Pending events were discarded; local stats are kept.")
+	},
+}
+
+var analyticsOptInCmd = &cobra.Command{
+	Use:   "opt-in",
+	Short: "Allow sending anonymous analytics",
+	Run: func(cmd *cobra.Command, args []string) {
+		if err := config.Set("analytics.enabled", "true"); err != nil {
+			fmt.Printf("❌ Failed to save configuration: %v\n", err)
+			return
+		}
+
+		pipeline, err := newTelemetryPipeline(config.Get(), "")
+		if err == nil {
+			err = pipeline.SetConsent(true)
+		}
+		if err != nil {
+			fmt.Printf("❌ Failed to save analytics consent: %v\n", err)
+			return
+		}
+		fmt.Println("✅ Analytics enabled. Events are anonymized and sent in batches; run 'antoine analytics opt-out' to stop.")
+	},
+}
+
+// newTelemetryPipeline crea un pipeline a partir de la configuración, con endpoint opcional
+func newTelemetryPipeline(cfg *config.Config, endpoint string) (*core.TelemetryPipeline, error) {
+	options, err := core.TelemetryOptionsFromConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint != "" {
+		options.Endpoint = endpoint
+	}
+
+	return core.NewTelemetryPipeline(options)
+}
+
+func init() {
+	analyticsFlushCmd.Flags().Bool("dry-run", false, "print the batches that would be sent without sending them")
+	analyticsFlushCmd.Flags().String("endpoint", "", "override the analytics endpoint (e.g. a local collector)")
+
+	analyticsCmd.AddCommand(analyticsStatusCmd)
+	analyticsCmd.AddCommand(analyticsFlushCmd)
+	analyticsCmd.AddCommand(analyticsOptOutCmd)
+	analyticsCmd.AddCommand(analyticsOptInCmd)
+}
@@ -1,6 +1,13 @@
 package cmd
 
 import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"antoine-cli/internal/config"
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/models"
 	"antoine-cli/internal/ui/views"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -21,12 +28,25 @@ var analyzeRepoCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		repoURL := args[0]
 
+		// Encolar el análisis en segundo plano en lugar de bloquear la terminal
+		if cmd.Flag("detach").Changed {
+			focus, _ := cmd.Flags().GetStringSlice("focus")
+			detachRepositoryAnalysis(repoURL, models.AnalysisOptions{
+				Depth:               cmd.Flag("depth").Value.String(),
+				IncludeDependencies: cmd.Flag("include-dependencies").Changed,
+				Focus:               focus,
+				Hackathon:           cmd.Flag("hackathon").Value.String(),
+			})
+			return
+		}
+
 		options := &views.AnalysisOptions{
 			RepoURL:             repoURL,
 			Depth:               cmd.Flag("depth").Value.String(),
 			IncludeDependencies: cmd.Flag("include-dependencies").Changed,
 			GenerateReport:      cmd.Flag("generate-report").Changed,
 			Focus:               cmd.Flag("focus").Value.String(),
+			Hackathon:           cmd.Flag("hackathon").Value.String(),
 			Format:              viper.GetString("format"),
 		}
 
@@ -35,37 +55,330 @@ var analyzeRepoCmd = &cobra.Command{
 	},
 }
 
+var analyzeLocalCmd = &cobra.Command{
+	Use:   "local [path]",
+	Short: "Analyze a repository checkout on disk",
+	Long: `Analyze a working tree without any network access. Files ignored by
+.gitignore are skipped, and analysis.repository.max_files and
+max_file_size_mb limit how much of the tree is read.
+
+The result has the same shape as 'antoine analyze repo', so languages,
+complexity and code quality are reported the same way. Go, JavaScript,
+TypeScript, Python and Rust get per-function complexity; other languages
+are estimated line by line.
+
+Dependencies are read from go.mod, package.json and its lockfiles,
+requirements.txt, pyproject.toml, Cargo.toml/Cargo.lock and pom.xml.
+Licenses come from the installed packages (node_modules, vendor, the Go,
+Cargo and Maven caches and local virtualenvs). Dependency versions are
+checked against the database imported with 'antoine vulndb update'.
+
+The import graph of Go, JavaScript and TypeScript packages gives the
+coupling metrics of the architecture score; import cycles and layer
+violations lower it (see 'antoine analyze arch'). Duplicated code lowers
+the maintainability score (see 'antoine analyze duplication').
+
+Every file is also scanned for leaked credentials, as 'antoine scan
+secrets' does; findings lower the security score.
+
+The project license is recognized from LICENSE/COPYING files by their
+SPDX text, or from the license declared in the manifests. Dependency
+licenses are classified (permissive, weak, strong or network copyleft,
+proprietary) and checked against it. With --hackathon, a missing license
+is an error when the hackathon requires open-source submissions.`,
+	Args: cobra.MaximumNArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		path := "."
+		if len(args) > 0 {
+			path = args[0]
+		}
+
+		includeDependencies, _ := cmd.Flags().GetBool("include-dependencies")
+		options := &views.AnalysisOptions{
+			Path:                path,
+			Depth:               cmd.Flag("depth").Value.String(),
+			IncludeDependencies: includeDependencies,
+			Focus:               cmd.Flag("focus").Value.String(),
+			Hackathon:           cmd.Flag("hackathon").Value.String(),
+			Format:              viper.GetString("output.format"),
+		}
+
+		view := views.NewAnalysisView(client)
+		view.AnalyzeRepository(options)
+	},
+}
+
+var analyzeComplexityCmd = &cobra.Command{
+	Use:   "complexity [path]",
+	Short: "List the most complex functions of a checkout",
+	Long: `Parse the sources of a directory (or a single file) and compute the
+cyclomatic and cognitive complexity of every function and method. Go is
+parsed with go/parser; JavaScript, TypeScript, Python and Rust with a
+tokenizer. The most complex functions are listed first; values over the
+limits are highlighted.`,
+	Args: cobra.MaximumNArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		path := "."
+		if len(args) > 0 {
+			path = args[0]
+		}
+
+		top, _ := cmd.Flags().GetInt("top")
+		sortBy, _ := cmd.Flags().GetString("sort")
+		if sortBy != "cognitive" && sortBy != "cyclomatic" {
+			fmt.Printf("❌ invalid --sort %q: use cognitive or cyclomatic\n", sortBy)
+			return
+		}
+
+		views.NewComplexityView().Show(&views.ComplexityOptions{
+			Path:   path,
+			Top:    top,
+			Sort:   sortBy,
+			Limits: core.LocalLimitsFromConfig(config.Get()),
+			Format: viper.GetString("output.format"),
+		})
+	},
+}
+
+var analyzeDuplicationCmd = &cobra.Command{
+	Use:   "duplication [path]",
+	Short: "Find copy-pasted code in a checkout",
+	Long: `Find duplicated blocks across the sources of a directory. Every file is
+turned into a stream of tokens where identifiers are normalized, so a
+block copied and then renamed still matches; keywords, member names and
+literals are kept. Blocks of at least --min-tokens tokens and 5
+lines are compared with a Rabin-Karp rolling hash. Imports and test files
+are ignored.
+
+The percentage of duplicated lines lowers the maintainability score of
+'antoine analyze local'.`,
+	Args: cobra.MaximumNArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		path := "."
+		if len(args) > 0 {
+			path = args[0]
+		}
+
+		top, _ := cmd.Flags().GetInt("top")
+		minTokens, _ := cmd.Flags().GetInt("min-tokens")
+		views.NewDuplicationView().Show(&views.DuplicationOptions{
+			Path:      path,
+			Top:       top,
+			MinTokens: minTokens,
+			Limits:    core.LocalLimitsFromConfig(config.Get()),
+			Format:    viper.GetString("output.format"),
+		})
+	},
+}
+
+var analyzeArchCmd = &cobra.Command{
+	Use:   "arch [path]",
+	Short: "Show the import graph, coupling and layer violations of a checkout",
+	Long: `Build the import graph of the Go, JavaScript and TypeScript packages of
+a directory. Go imports are resolved through go.mod; JavaScript and
+TypeScript imports through relative paths and the @/ and ~/ aliases.
+
+For every package the afferent (Ca) and efferent (Ce) coupling and the
+instability Ce/(Ca+Ce) are listed. Import cycles are reported, and so are
+imports that go against the layers recognized by directory name
+(presentation → application → data → domain → shared).
+
+--graph writes the graph as Graphviz DOT (.dot, .gv) or Mermaid (.mmd,
+.mermaid, or a fenced block in .md).`,
+	Example: `  antoine analyze arch . --graph out.dot
+  dot -Tsvg out.dot > arch.svg`,
+	Args: cobra.MaximumNArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		path := "."
+		if len(args) > 0 {
+			path = args[0]
+		}
+
+		graph, _ := cmd.Flags().GetString("graph")
+		views.NewArchitectureView().Show(&views.ArchitectureOptions{
+			Path:   path,
+			Graph:  graph,
+			Limits: core.LocalLimitsFromConfig(config.Get()),
+			Format: viper.GetString("output.format"),
+		})
+	},
+}
+
+var analyzeBatchCmd = &cobra.Command{
+	Use:   "batch [repos-file]",
+	Short: "Analyze many repositories and rank them",
+	Long: `Analyze every repository listed in a file and produce a ranking.
+The file can contain one URL per line, a CSV with a url column, or a JSON list.
+Progress is saved in the output directory, so an interrupted batch resumes
+where it stopped when the same command is run again.`,
+	Args: cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		file := args[0]
+
+		outputDir, _ := cmd.Flags().GetString("output")
+		if outputDir == "" {
+			base := filepath.Base(file)
+			outputDir = strings.TrimSuffix(base, filepath.Ext(base)) + "-results"
+		}
+
+		concurrency, _ := cmd.Flags().GetInt("concurrency")
+		if concurrency <= 0 {
+			concurrency = config.Get().Analysis.MaxConcurrentJobs
+		}
+
+		focus, _ := cmd.Flags().GetStringSlice("focus")
+		options := &views.BatchOptions{
+			File:                file,
+			OutputDir:           outputDir,
+			Concurrency:         concurrency,
+			Depth:               cmd.Flag("depth").Value.String(),
+			IncludeDependencies: cmd.Flag("include-dependencies").Changed,
+			Focus:               focus,
+			Format:              viper.GetString("output.format"),
+		}
+
+		view := views.NewBatchView(client)
+		view.AnalyzeBatch(options)
+	},
+}
+
+var analyzeCompareCmd = &cobra.Command{
+	Use:   "compare [repo1] [repo2] [...]",
+	Short: "Compare several repositories side by side",
+	Long: `Analyze two or more repositories and show a comparison matrix covering
+code quality, complexity, dependencies, security, languages and activity.
+The best value in each row is highlighted.`,
+	Args: cobra.MinimumNArgs(2),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		focus, _ := cmd.Flags().GetStringSlice("focus")
+		includeDependencies, _ := cmd.Flags().GetBool("include-dependencies")
+		options := &views.AnalysisOptions{
+			Repos:               args,
+			Depth:               cmd.Flag("depth").Value.String(),
+			IncludeDependencies: includeDependencies,
+			Focus:               strings.Join(focus, ","),
+			Format:              viper.GetString("output.format"),
+		}
+
+		view := views.NewAnalysisView(client)
+		view.CompareRepositories(options)
+	},
+}
+
 var analyzeTrendsCmd = &cobra.Command{
 	Use:   "trends",
 	Short: "Analyze technology and market trends",
 
 	Run: func(cmd *cobra.Command, args []string) {
-		techSlice, _ := cmd.Flags().GetStringSlice("tech")
+		runTrends(cmd)
+	},
+}
+
+var analyzeMarketCmd = &cobra.Command{
+	Use:   "market [domain]",
+	Short: "Analyze competitors, funding and market potential of a domain",
+	Long: `Search competitors, recent funding rounds and news for a market domain
+and score its opportunity, commercial viability and user need.
+With --project the result is saved as the project's market potential.`,
+	Args: cobra.MinimumNArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
 		options := &views.AnalysisOptions{
-			Tech:      techSlice,
-			Timeframe: cmd.Flag("timeframe").Value.String(),
-			Metrics:   cmd.Flag("include-metrics").Changed,
-			Format:    viper.GetString("format"),
+			Market:      strings.Join(args, " "),
+			ProjectFile: cmd.Flag("project").Value.String(),
+			Format:      viper.GetString("output.format"),
 		}
 
 		view := views.NewAnalysisView(client)
-		view.AnalyzeTrends(options)
+		view.AnalyzeMarket(options)
 	},
 }
 
+// detachRepositoryAnalysis crea un trabajo en segundo plano y lanza su worker
+func detachRepositoryAnalysis(repoURL string, options models.AnalysisOptions) {
+	jobs, err := newJobManager()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	job, err := jobs.Submit(models.AnalysisRequest{
+		Type:    "repository",
+		Target:  repoURL,
+		Options: options,
+	})
+	if err != nil {
+		fmt.Printf("❌ Failed to queue analysis: %v\n", err)
+		return
+	}
+
+	if err := startJobWorker(jobs, job); err != nil {
+		fmt.Printf("❌ Failed to start background worker: %v\n", err)
+		fmt.Println("The job stays queued and will be resumed by the next 'antoine jobs' command")
+		return
+	}
+
+	fmt.Printf("🚀 Analysis queued as job %s\n", job.ID)
+	fmt.Printf("   Track it with 'antoine jobs status %s' or 'antoine jobs logs %s -f'\n", job.ID, job.ID)
+}
+
 func init() {
 	// Flags para análisis de repositorio
 	analyzeRepoCmd.Flags().String("depth", "standard", "analysis depth (quick, standard, deep)")
 	analyzeRepoCmd.Flags().Bool("include-dependencies", false, "analyze dependencies")
 	analyzeRepoCmd.Flags().Bool("generate-report", false, "generate detailed report")
 	analyzeRepoCmd.Flags().StringSlice("focus", []string{}, "focus areas (architecture, security, performance)")
+	analyzeRepoCmd.Flags().Bool("detach", false, "run the analysis as a background job (see 'antoine jobs')")
+	analyzeRepoCmd.Flags().String("hackathon", "", "cached hackathon (ID, URL or name) whose requirements are checked")
+
+	// Flags para análisis local
+	analyzeLocalCmd.Flags().String("depth", "standard", "analysis depth (quick, standard, deep)")
+	analyzeLocalCmd.Flags().StringSlice("focus", []string{}, "focus areas (architecture, security, performance)")
+	analyzeLocalCmd.Flags().Bool("include-dependencies", true, "parse dependency manifests and resolve licenses")
+	analyzeLocalCmd.Flags().String("hackathon", "", "cached hackathon (ID, URL or name) whose requirements are checked")
+
+	// Flags para complejidad
+	analyzeComplexityCmd.Flags().Int("top", 20, "number of functions to list (0 = all)")
+	analyzeComplexityCmd.Flags().String("sort", "cognitive", "rank by cognitive or cyclomatic complexity")
+
+	// Flags para duplicación
+	analyzeDuplicationCmd.Flags().Int("top", 20, "number of duplicated blocks to list (0 = all)")
+	analyzeDuplicationCmd.Flags().Int("min-tokens", core.DuplicationMinTokens, "minimum size of a duplicated block in tokens")
+
+	// Flags para arquitectura
+	analyzeArchCmd.Flags().String("graph", "", "write the import graph to a .dot, .gv, .mmd or .md file")
+
+	// Flags para análisis por lotes
+	analyzeBatchCmd.Flags().StringP("output", "o", "", "directory for per-repo results (default <file>-results)")
+	analyzeBatchCmd.Flags().Int("concurrency", 0, "repositories analyzed at once (default analysis.max_concurrent_jobs)")
+	analyzeBatchCmd.Flags().String("depth", "standard", "analysis depth (quick, standard, deep)")
+	analyzeBatchCmd.Flags().Bool("include-dependencies", false, "analyze dependencies")
+	analyzeBatchCmd.Flags().StringSlice("focus", []string{}, "focus areas (architecture, security, performance)")
+
+	// Flags para comparación de repositorios
+	analyzeCompareCmd.Flags().String("depth", "standard", "analysis depth (quick, standard, deep)")
+	analyzeCompareCmd.Flags().Bool("include-dependencies", true, "analyze dependencies")
+	analyzeCompareCmd.Flags().StringSlice("focus", []string{}, "focus areas (architecture, security, performance)")
 
 	// Flags para análisis de tendencias
-	analyzeTrendsCmd.Flags().StringSlice("tech", []string{}, "technologies to analyze")
-	analyzeTrendsCmd.Flags().String("timeframe", "6months", "analysis timeframe (1month, 3months, 6months, 1year)")
-	analyzeTrendsCmd.Flags().Bool("include-metrics", false, "include detailed metrics")
-	analyzeTrendsCmd.Flags().String("market", "", "specific market (defi, gaming, ai, etc.)")
+	addTrendsFlags(analyzeTrendsCmd)
+
+	// Flags para análisis de mercado
+	analyzeMarketCmd.Flags().String("project", "", "project JSON file whose market potential is updated")
 
 	analyzeCmd.AddCommand(analyzeRepoCmd)
+	analyzeCmd.AddCommand(analyzeLocalCmd)
+	analyzeCmd.AddCommand(analyzeComplexityCmd)
+	analyzeCmd.AddCommand(analyzeDuplicationCmd)
+	analyzeCmd.AddCommand(analyzeArchCmd)
+	analyzeCmd.AddCommand(analyzeBatchCmd)
+	analyzeCmd.AddCommand(analyzeCompareCmd)
 	analyzeCmd.AddCommand(analyzeTrendsCmd)
+	analyzeCmd.AddCommand(analyzeMarketCmd)
 }
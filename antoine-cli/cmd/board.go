@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/ui/views"
+)
+
+var boardCmd = &cobra.Command{
+	Use:   "board",
+	Short: "Track your hackathon participation on a kanban board",
+	Long: `Track the hackathons you take part in as they move through
+interested → registered → building → submitted → judged,
+with notes and links to your projects.
+
+Without a subcommand, opens the interactive kanban board. Hackathons are
+added from the local cache by ID, URL or name and stored by Hackathon.ID in
+~/.antoine/board.json. Tracked hackathons that are not judged yet are
+included in 'antoine calendar export'.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		views.NewBoardView().Show(viper.GetString("output.format"))
+	},
+}
+
+var boardAddCmd = &cobra.Command{
+	Use:   "add [id|url|name]",
+	Short: "Add a hackathon to the board",
+	Args:  cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		var status string
+		if cmd.Flag("status").Changed {
+			parsed, err := core.ParseBoardStatus(cmd.Flag("status").Value.String())
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				return
+			}
+			status = parsed
+		}
+
+		views.NewBoardView().Add(args[0], status, cmd.Flag("note").Value.String())
+	},
+}
+
+var boardMoveCmd = &cobra.Command{
+	Use:   "move [id|url|name] [status]",
+	Short: "Move a hackathon to another status",
+	Long: fmt.Sprintf(`Move a hackathon to another status: %s.
+Unique prefixes are accepted (e.g. 'reg' for registered).`, strings.Join(core.BoardStatuses, ", ")),
+	Args: cobra.ExactArgs(2),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		status, err := core.ParseBoardStatus(args[1])
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+
+		views.NewBoardView().Move(args[0], status)
+	},
+}
+
+var boardNoteCmd = &cobra.Command{
+	Use:   "note [id|url|name] [text...]",
+	Short: "Add a note to a hackathon on the board",
+	Args:  cobra.MinimumNArgs(2),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		views.NewBoardView().Note(args[0], strings.Join(args[1:], " "))
+	},
+}
+
+var boardLinkCmd = &cobra.Command{
+	Use:   "link [id|url|name] [project]",
+	Short: "Link a project (repository, demo or project file) to a hackathon",
+	Args:  cobra.ExactArgs(2),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		views.NewBoardView().Link(args[0], args[1])
+	},
+}
+
+var boardListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List the board grouped by status",
+	Aliases: []string{"ls"},
+
+	Run: func(cmd *cobra.Command, args []string) {
+		views.NewBoardView().List(viper.GetString("output.format"))
+	},
+}
+
+var boardRemoveCmd = &cobra.Command{
+	Use:     "rm [id|url|name]",
+	Short:   "Remove a hackathon from the board",
+	Aliases: []string{"remove"},
+	Args:    cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		views.NewBoardView().Remove(args[0])
+	},
+}
+
+func init() {
+	boardAddCmd.Flags().String("status", "", "initial status (default interested)")
+	boardAddCmd.Flags().String("note", "", "note to add")
+
+	boardCmd.AddCommand(boardAddCmd)
+	boardCmd.AddCommand(boardMoveCmd)
+	boardCmd.AddCommand(boardNoteCmd)
+	boardCmd.AddCommand(boardLinkCmd)
+	boardCmd.AddCommand(boardListCmd)
+	boardCmd.AddCommand(boardRemoveCmd)
+}
@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"antoine-cli/internal/ui/views"
+)
+
+var bookmarkCmd = &cobra.Command{
+	Use:   "bookmark",
+	Short: "Bookmark hackathons you plan to attend",
+	Long: `Keep a list of the hackathons you plan to attend. Bookmarked hackathons are
+exported by 'antoine calendar export' with reminders before they start.
+
+Hackathons are looked up in the local cache by ID, URL or name, so search
+for them first with 'antoine search hackathons'.`,
+	Aliases: []string{"bookmarks"},
+
+	Run: func(cmd *cobra.Command, args []string) {
+		views.NewBookmarkView().List(viper.GetString("output.format"))
+	},
+}
+
+var bookmarkAddCmd = &cobra.Command{
+	Use:   "add [id|url|name]",
+	Short: "Bookmark a hackathon",
+	Args:  cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		views.NewBookmarkView().Add(args[0], cmd.Flag("note").Value.String())
+	},
+}
+
+var bookmarkListCmd = &cobra.Command{
+	Use:     "list",
+	Short:   "List bookmarked hackathons",
+	Aliases: []string{"ls"},
+
+	Run: func(cmd *cobra.Command, args []string) {
+		views.NewBookmarkView().List(viper.GetString("output.format"))
+	},
+}
+
+var bookmarkRemoveCmd = &cobra.Command{
+	Use:     "rm [id|url|name]",
+	Short:   "Remove a bookmark",
+	Aliases: []string{"remove"},
+	Args:    cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		views.NewBookmarkView().Remove(args[0])
+	},
+}
+
+func init() {
+	bookmarkAddCmd.Flags().String("note", "", "note shown in the calendar event")
+
+	bookmarkCmd.AddCommand(bookmarkAddCmd)
+	bookmarkCmd.AddCommand(bookmarkListCmd)
+	bookmarkCmd.AddCommand(bookmarkRemoveCmd)
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"antoine-cli/internal/ui/views"
+	"antoine-cli/internal/utils"
+)
+
+var calendarCmd = &cobra.Command{
+	Use:   "calendar",
+	Short: "Export hackathons to your calendar",
+}
+
+var calendarExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export bookmarked hackathons and deadlines as iCalendar (.ics)",
+	Long: `Write an RFC 5545 calendar with your bookmarked hackathons. Each hackathon
+becomes an event in its own timezone, and registration and submission deadlines
+get separate events with reminders. Import the file in your calendar app, or
+serve it so the team calendar stays in sync.
+
+Use --cached to include every upcoming hackathon from the local cache.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		alarmFlags, _ := cmd.Flags().GetStringSlice("alarm")
+		var alarms []time.Duration
+		for _, value := range alarmFlags {
+			alarm, err := utils.ParseDurationExtended(value)
+			if err != nil || alarm <= 0 {
+				fmt.Printf("❌ Invalid --alarm %q: use a duration like 1h, 24h or 2d\n", value)
+				return
+			}
+			alarms = append(alarms, alarm)
+		}
+
+		options := &views.CalendarOptions{
+			Output: cmd.Flag("output").Value.String(),
+			Cached: cmd.Flag("cached").Changed,
+			Past:   cmd.Flag("past").Changed,
+			Alarms: alarms,
+			Name:   cmd.Flag("name").Value.String(),
+		}
+
+		views.NewCalendarView().Export(options)
+	},
+}
+
+func init() {
+	calendarExportCmd.Flags().StringP("output", "o", "", "write to a file instead of stdout")
+	calendarExportCmd.Flags().Bool("cached", false, "include all upcoming hackathons from the local cache")
+	calendarExportCmd.Flags().Bool("past", false, "include hackathons that already finished")
+	calendarExportCmd.Flags().StringSlice("alarm", []string{"24h"}, "reminders before deadlines and bookmarked hackathons (e.g. 2d,1h)")
+	calendarExportCmd.Flags().String("name", "Antoine Hackathons", "calendar name")
+
+	calendarCmd.AddCommand(calendarExportCmd)
+}
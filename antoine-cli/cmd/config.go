@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"antoine-cli/internal/config"
+	"antoine-cli/internal/ui/views"
 	"fmt"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 )
 
 var configCmd = &cobra.Command{
@@ -12,14 +14,6 @@ var configCmd = &cobra.Command{
 	Long:  `Configure API keys, preferences, and settings for optimal Antoine experience.`,
 }
 
-var trendsCmd = &cobra.Command{
-	Use:   "trends",
-	Short: "Show current tech trends",
-	Run: func(cmd *cobra.Command, args []string) {
-		fmt.Println("Current tech trends: AI, Blockchain, Web3, IoT, Edge Computing")
-	},
-}
-
 var configShowCmd = &cobra.Command{
 	Use:   "show",
 	Short: "Show current configuration",
@@ -39,7 +33,65 @@ var configSetCmd = &cobra.Command{
 	},
 }
 
+var configRatesCmd = &cobra.Command{
+	Use:   "rates",
+	Short: "Show the exchange rates used to compare prizes",
+	Long: `Prize pools come in different currencies and tokens. Antoine converts them
+to search.prizes.base_currency with a local rate table so that --prize-min,
+sorting by prize and the prize columns compare like with like.
+
+The table lives in ~/.antoine/rates.json. Until you set or refresh a rate,
+the table bundled with Antoine is used, so everything works offline.`,
+	Aliases: []string{"rate"},
+
+	Run: func(cmd *cobra.Command, args []string) {
+		views.NewRatesView().Show(viper.GetString("output.format"))
+	},
+}
+
+var configRatesSetCmd = &cobra.Command{
+	Use:     "set [currency] [rate]",
+	Short:   "Set how many units of a currency one unit of the table base is worth",
+	Example: "  antoine config rates set EUR 0.92\n  antoine config rates set ETH 0.00031",
+	Args:    cobra.ExactArgs(2),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		views.NewRatesView().Set(args[0], args[1])
+	},
+}
+
+var configRatesRefreshCmd = &cobra.Command{
+	Use:   "refresh",
+	Short: "Download current exchange rates",
+	Long: `Download current rates from search.prizes.rates_url (or --url). Currencies the
+source does not cover, such as crypto tokens, keep their previous rate.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		url, _ := cmd.Flags().GetString("url")
+		if url == "" {
+			url = config.Get().Search.Prizes.RatesURL
+		}
+		views.NewRatesView().Refresh(url)
+	},
+}
+
+var configRatesResetCmd = &cobra.Command{
+	Use:   "reset",
+	Short: "Go back to the bundled rate table",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		views.NewRatesView().Reset()
+	},
+}
+
 func init() {
+	configRatesRefreshCmd.Flags().String("url", "", "rates endpoint returning {\"base\": ..., \"rates\": {...}}")
+
+	configRatesCmd.AddCommand(configRatesSetCmd)
+	configRatesCmd.AddCommand(configRatesRefreshCmd)
+	configRatesCmd.AddCommand(configRatesResetCmd)
+
 	configCmd.AddCommand(configShowCmd)
 	configCmd.AddCommand(configSetCmd)
+	configCmd.AddCommand(configRatesCmd)
 }
@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"antoine-cli/internal/config"
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/ui/views"
+	"antoine-cli/internal/utils"
+)
+
+var jobsCmd = &cobra.Command{
+	Use:   "jobs",
+	Short: "Manage background analysis jobs",
+	Long: `Inspect and control analyses started with --detach. Jobs keep running
+after the CLI exits and at most analysis.max_concurrent_jobs run at once.`,
+}
+
+var jobsListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List background jobs",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		view, err := newJobsView()
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+		view.ListJobs(&views.JobsOptions{Format: viper.GetString("output.format")})
+	},
+}
+
+var jobsStatusCmd = &cobra.Command{
+	Use:   "status [job-id]",
+	Short: "Show the status of a job",
+	Args:  cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		view, err := newJobsView()
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+		view.ShowStatus(&views.JobsOptions{ID: args[0], Format: viper.GetString("output.format")})
+	},
+}
+
+var jobsLogsCmd = &cobra.Command{
+	Use:   "logs [job-id]",
+	Short: "Show the log of a job",
+	Args:  cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		view, err := newJobsView()
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+		view.ShowLogs(&views.JobsOptions{ID: args[0], Follow: cmd.Flag("follow").Changed})
+	},
+}
+
+var jobsCancelCmd = &cobra.Command{
+	Use:   "cancel [job-id]",
+	Short: "Cancel a queued or running job",
+	Args:  cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		view, err := newJobsView()
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+		view.CancelJob(&views.JobsOptions{ID: args[0]})
+	},
+}
+
+var jobsResultCmd = &cobra.Command{
+	Use:   "result [job-id]",
+	Short: "Show the result of a completed job",
+	Args:  cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		view, err := newJobsView()
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+		view.ShowResult(&views.JobsOptions{ID: args[0], Format: viper.GetString("output.format")})
+	},
+}
+
+// jobsRunCmd es el punto de entrada del worker desacoplado; no está pensado para usarse a mano
+var jobsRunCmd = &cobra.Command{
+	Use:    "run [job-id]",
+	Short:  "Run a queued job in the foreground",
+	Args:   cobra.ExactArgs(1),
+	Hidden: true,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		jobs, err := newJobManager()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			os.Exit(1)
+		}
+
+		timeout, err := time.ParseDuration(config.Get().Analysis.Timeout)
+		if err != nil || timeout <= 0 {
+			timeout = 10 * time.Minute
+		}
+
+		view := views.NewJobsView(client, jobs)
+		if err := view.RunJob(&views.JobsOptions{ID: args[0]}, timeout); err != nil {
+			fmt.Fprintf(os.Stderr, "Job %s stopped: %v\n", args[0], err)
+		}
+	},
+}
+
+// newJobManager crea el gestor de trabajos con el límite de concurrencia configurado
+func newJobManager() (*core.JobManager, error) {
+	return core.NewJobManager(config.Get().Analysis.MaxConcurrentJobs)
+}
+
+// newJobsView prepara la vista y relanza los trabajos encolados que se quedaron sin worker
+func newJobsView() (*views.JobsView, error) {
+	jobs, err := newJobManager()
+	if err != nil {
+		return nil, err
+	}
+
+	orphaned, err := jobs.Reconcile()
+	if err != nil {
+		return nil, err
+	}
+	for _, job := range orphaned {
+		if err := startJobWorker(jobs, job); err != nil {
+			utils.WithError(err).Warn("Failed to resume queued job")
+		}
+	}
+
+	return views.NewJobsView(client, jobs), nil
+}
+
+// startJobWorker lanza 'antoine jobs run <id>' desacoplado de la terminal actual
+func startJobWorker(jobs *core.JobManager, job *core.Job) error {
+	args := []string{"jobs", "run", job.ID, "--quiet"}
+	if cfgFile != "" {
+		args = append(args, "--config", cfgFile)
+	}
+	return jobs.Spawn(job, args...)
+}
+
+func init() {
+	jobsLogsCmd.Flags().BoolP("follow", "f", false, "keep printing new log lines until the job finishes")
+
+	jobsCmd.AddCommand(jobsListCmd)
+	jobsCmd.AddCommand(jobsStatusCmd)
+	jobsCmd.AddCommand(jobsLogsCmd)
+	jobsCmd.AddCommand(jobsCancelCmd)
+	jobsCmd.AddCommand(jobsResultCmd)
+	jobsCmd.AddCommand(jobsRunCmd)
+}
@@ -19,7 +19,7 @@ var mentorStartCmd = &cobra.Command{
 
 	Run: func(cmd *cobra.Command, args []string) {
 		view := views.NewMentorView(client)
-		view.StartSession()
+		view.StartSession(&views.MentorOptions{Profile: activeProfile()})
 	},
 }
 
@@ -33,6 +33,7 @@ var mentorFeedbackCmd = &cobra.Command{
 			ProjectID:  cmd.Flag("project-id").Value.String(),
 			Focus:      cmd.Flag("focus").Value.String(),
 			Quick:      cmd.Flag("quick").Changed,
+			Profile:    activeProfile(),
 		}
 
 		view := views.NewMentorView(client)
@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/ui/views"
+	"antoine-cli/internal/utils"
+)
+
+var profileCmd = &cobra.Command{
+	Use:   "profile",
+	Short: "Manage your developer profile",
+	Long: `Your profile stores your skills, preferred technologies, experience level,
+timezone, location, travel willingness and team preferences. Search and mentor
+commands use it as default context; use --no-profile to ignore it.`,
+}
+
+var profileInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Create your profile with an interactive form",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		options, err := profileOptions(cmd)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+		options.Force = cmd.Flag("force").Changed
+
+		view := views.NewProfileView(client)
+		view.InitProfile(options)
+	},
+}
+
+var profileShowCmd = &cobra.Command{
+	Use:   "show",
+	Short: "Show your profile",
+
+	Run: func(cmd *cobra.Command, args []string) {
+		view := views.NewProfileView(client)
+		view.ShowProfile(&views.ProfileOptions{Format: viper.GetString("output.format")})
+	},
+}
+
+var profileEditCmd = &cobra.Command{
+	Use:   "edit",
+	Short: "Edit your profile",
+	Long: `Open the profile form with your current values, or change single fields
+without the form:
+
+  antoine profile edit --set experience_level=advanced --set technologies=Go,Rust`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		options, err := profileOptions(cmd)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+
+		view := views.NewProfileView(client)
+		view.EditProfile(options)
+	},
+}
+
+// profileOptions lee los valores --set campo=valor
+func profileOptions(cmd *cobra.Command) (*views.ProfileOptions, error) {
+	pairs, _ := cmd.Flags().GetStringArray("set")
+
+	options := &views.ProfileOptions{Set: make(map[string]string)}
+	for _, pair := range pairs {
+		field, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid --set %q, expected field=value", pair)
+		}
+		options.Set[strings.TrimSpace(field)] = value
+	}
+
+	return options, nil
+}
+
+// activeProfile devuelve el perfil del usuario o nil si no existe o se pidió --no-profile
+func activeProfile() *models.UserProfile {
+	if viper.GetBool("profile.disabled") {
+		return nil
+	}
+
+	profile, err := core.LoadProfile()
+	if err != nil {
+		if !errors.Is(err, core.ErrProfileNotFound) {
+			utils.WithError(err).Warn("Ignoring unreadable profile")
+		}
+		return nil
+	}
+
+	return profile
+}
+
+func init() {
+	profileInitCmd.Flags().Bool("force", false, "overwrite an existing profile")
+	profileInitCmd.Flags().StringArray("set", []string{}, "set a field without the form (field=value, repeatable)")
+	profileEditCmd.Flags().StringArray("set", []string{}, "set a field without the form (field=value, repeatable)")
+
+	profileCmd.AddCommand(profileInitCmd)
+	profileCmd.AddCommand(profileShowCmd)
+	profileCmd.AddCommand(profileEditCmd)
+}
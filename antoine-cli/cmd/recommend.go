@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"antoine-cli/internal/config"
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/ui/views"
+)
+
+var recommendCmd = &cobra.Command{
+	Use:   "recommend",
+	Short: "Personalized recommendations based on your profile",
+}
+
+var recommendHackathonsCmd = &cobra.Command{
+	Use:   "hackathons",
+	Short: "Rank hackathons by how well they fit your profile",
+	Long: `Score every hackathon against your profile (technologies, interests, dates,
+location, timezone, team size, difficulty and prize) and explain each factor.
+
+Weights come from search.recommend.weights in the config file and can be
+overridden per run, e.g. --weights prize=0.4,location=0.
+
+Hackathons come from a live search, falling back to the ones cached by earlier
+searches (--source auto). Use --source cache to work offline.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg := config.Get()
+
+		weights, err := core.ParseRecommendationWeights(cfg.Search.Recommend.Weights, cmd.Flag("weights").Value.String())
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+
+		source := cmd.Flag("source").Value.String()
+		switch source {
+		case core.SourceAuto, core.SourceLive, core.SourceCache:
+		default:
+			fmt.Printf("❌ Invalid --source %q (auto, live, cache)\n", source)
+			return
+		}
+
+		limit, _ := cmd.Flags().GetInt("limit")
+		if !cmd.Flag("limit").Changed && cfg.Search.Recommend.Limit > 0 {
+			limit = cfg.Search.Recommend.Limit
+		}
+
+		options := &views.RecommendOptions{
+			Query:       cmd.Flag("query").Value.String(),
+			Source:      source,
+			Limit:       limit,
+			Weights:     weights,
+			IncludePast: cmd.Flag("include-past").Changed,
+			Profile:     activeProfile(),
+			Format:      viper.GetString("output.format"),
+		}
+
+		view := views.NewRecommendView(client)
+		view.RecommendHackathons(options)
+	},
+}
+
+func init() {
+	recommendHackathonsCmd.Flags().String("query", "", "search terms for the live search")
+	recommendHackathonsCmd.Flags().String("source", core.SourceAuto, "where hackathons come from (auto, live, cache)")
+	recommendHackathonsCmd.Flags().Int("limit", 10, "number of recommendations (default search.recommend.limit)")
+	recommendHackathonsCmd.Flags().String("weights", "", "override factor weights (e.g. technology=0.5,prize=0.1)")
+	recommendHackathonsCmd.Flags().Bool("include-past", false, "include hackathons that already ended")
+
+	recommendCmd.AddCommand(recommendHackathonsCmd)
+}
@@ -13,6 +13,7 @@ import (
 
 	"antoine-cli/internal/config"
 	"antoine-cli/internal/core"
+	"antoine-cli/internal/utils"
 	"antoine-cli/pkg/ascii"
 	"antoine-cli/pkg/terminal"
 )
@@ -22,8 +23,37 @@ var (
 	client  *core.AntoineClient
 	version = "1.0.0"
 	rootCmd *cobra.Command
+	welcome func(cmd *cobra.Command)
 )
 
+// SetWelcome registra la bienvenida que se muestra antes de ejecutar cada comando,
+// cuando las flags ya están parseadas
+func SetWelcome(show func(cmd *cobra.Command)) {
+	welcome = show
+}
+
+// dataCommands escriben un documento en stdout (SBOM, calendario) que no se puede mezclar
+// con la bienvenida aunque stdout sea un terminal
+var dataCommands = map[string]bool{"sbom": true, "calendar": true}
+
+// stdoutIsTerminal indica si stdout es un terminal y no un archivo o una tubería
+var stdoutIsTerminal = func() bool {
+	info, err := os.Stdout.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// welcomeAllowed decide si la bienvenida puede ir a stdout antes de ejecutar cmd
+func welcomeAllowed(cmd *cobra.Command) bool {
+	if !stdoutIsTerminal() {
+		return false
+	}
+	top := cmd
+	for top.HasParent() && top.Parent().HasParent() {
+		top = top.Parent()
+	}
+	return !dataCommands[top.Name()]
+}
+
 // init inicializa el comando root
 func init() {
 	// Crear el comando root
@@ -49,6 +79,21 @@ from every hackathon to help you build what's next.`,
 			// Mostrar ayuda por defecto
 			cmd.Help()
 		},
+
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			if welcome != nil && welcomeAllowed(cmd) {
+				welcome(cmd)
+			}
+		},
+
+		// Cerrar el cliente al terminar para enviar analytics pendientes
+		PersistentPostRun: func(cmd *cobra.Command, args []string) {
+			if client != nil {
+				if err := client.Close(); err != nil {
+					utils.WithError(err).Debug("Error closing Antoine client")
+				}
+			}
+		},
 	}
 
 	// Configurar inicialización
@@ -62,7 +107,7 @@ from every hackathon to help you build what's next.`,
 	rootCmd.PersistentFlags().Bool("no-animation", false,
 		"disable animations")
 	rootCmd.PersistentFlags().String("format", "interactive",
-		"output format (interactive, json, yaml, table)")
+		"output format (interactive, json, yaml, table, ics)")
 	rootCmd.PersistentFlags().BoolP("verbose", "v", false,
 		"verbose output")
 	rootCmd.PersistentFlags().Bool("debug", false,
@@ -71,6 +116,8 @@ from every hackathon to help you build what's next.`,
 		"suppress non-essential output")
 	rootCmd.PersistentFlags().String("theme", "",
 		"UI theme (dark, light, minimal)")
+	rootCmd.PersistentFlags().Bool("no-profile", false,
+		"ignore your profile as default context")
 
 	// Flags del comando root
 	rootCmd.Flags().Bool("version", false, "show version")
@@ -81,6 +128,7 @@ from every hackathon to help you build what's next.`,
 	viper.BindPFlag("output.format", rootCmd.PersistentFlags().Lookup("format"))
 	viper.BindPFlag("logging.level", rootCmd.PersistentFlags().Lookup("verbose"))
 	viper.BindPFlag("debug.enabled", rootCmd.PersistentFlags().Lookup("debug"))
+	viper.BindPFlag("profile.disabled", rootCmd.PersistentFlags().Lookup("no-profile"))
 
 	// Añadir subcomandos
 	initSubcommands()
@@ -167,6 +215,18 @@ func initSubcommands() {
 	rootCmd.AddCommand(analyzeCmd)
 	rootCmd.AddCommand(mentorCmd)
 	rootCmd.AddCommand(trendsCmd)
+	rootCmd.AddCommand(statsCmd)
+	rootCmd.AddCommand(analyticsCmd)
+	rootCmd.AddCommand(jobsCmd)
+	rootCmd.AddCommand(profileCmd)
+	rootCmd.AddCommand(recommendCmd)
+	rootCmd.AddCommand(watchCmd)
+	rootCmd.AddCommand(bookmarkCmd)
+	rootCmd.AddCommand(calendarCmd)
+	rootCmd.AddCommand(boardCmd)
+	rootCmd.AddCommand(scanCmd)
+	rootCmd.AddCommand(sbomCmd)
+	rootCmd.AddCommand(vulndbCmd)
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(getVersionCommand())
 
@@ -200,7 +260,7 @@ func showDashboard() {
 	fmt.Println(ascii.GetHeader(termWidth, ascii.SubtitleMain))
 	fmt.Println()
 
-	// Cargar estadísticas (mock por ahora)
+	// Cargar estadísticas
 	stats := getDashboardStats()
 
 	// Mostrar dashboard
@@ -211,18 +271,56 @@ func showDashboard() {
 	showQuickCommands(cfg)
 }
 
-// getDashboardStats obtiene las estadísticas del dashboard
+// getDashboardStats obtiene las estadísticas del dashboard desde las métricas persistidas
 func getDashboardStats() map[string]interface{} {
-	// TODO: Implementar estadísticas reales desde el cliente
+	analytics := client.Analytics()
+	totals := analytics.Totals()
+	today := analytics.Summary("today", startOfDay(time.Now()))
+
+	lastUpdate := "never"
+	if updated := analytics.LastUpdate(); !updated.IsZero() {
+		lastUpdate = updated.Format("2006-01-02 15:04")
+	}
+
+	// Resultados de la última búsqueda de hackathons, frente al acumulado de todas
+	lastSearch := "-"
+	if count, ok := analytics.GetMetrics()["results_hackathons"].(int); ok {
+		lastSearch = fmt.Sprint(count)
+	}
+
+	// Aciertos de caché acumulados en todas las ejecuciones
+	cacheHitRate := "-"
+	if totals.CacheHits+totals.CacheMisses > 0 {
+		cacheHitRate = fmt.Sprintf("%.0f%%", totals.CacheHitRate()*100)
+	}
+
+	// Las barras se escalan respecto a la tecnología más consultada
+	var trends []ascii.DashboardTrend
+	top := totals.TopTechnologies(3)
+	for _, tech := range top {
+		trends = append(trends, ascii.DashboardTrend{
+			Name:    tech.Name,
+			Percent: tech.Count * 100 / top[0].Count,
+		})
+	}
+
 	return map[string]interface{}{
-		"hackathons":   42,
-		"projects":     1337,
-		"searches":     156,
-		"success_rate": 94,
-		"last_update":  time.Now().Format("2006-01-02 15:04"),
+		"hackathons":       totals.Results["hackathons"],
+		"last_search":      lastSearch,
+		"projects":         totals.TotalAnalyses(),
+		"searches":         today.TotalSearches(),
+		"cache_hit_rate":   cacheHitRate,
+		"top_technologies": trends,
+		"last_update":      lastUpdate,
 	}
 }
 
+// startOfDay devuelve la medianoche local del día de t
+func startOfDay(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+}
+
 // showQuickCommands muestra una guía rápida de comandos
 func showQuickCommands(cfg *config.Config) {
 	var style lipgloss.Style
@@ -246,6 +344,7 @@ func showQuickCommands(cfg *config.Config) {
 • antoine analyze repo <url>                - Analyze a GitHub repository  
 • antoine mentor start                      - Start interactive mentorship
 • antoine trends --tech "blockchain"        - See blockchain trends
+• antoine stats                             - Show your usage statistics
 • antoine config show                       - View current configuration
 
 Type 'antoine --help' for complete command reference.`
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/ui/views"
+)
+
+var sbomCmd = &cobra.Command{
+	Use:   "sbom [path|url]",
+	Short: "Generate a software bill of materials",
+	Long: `Generate a software bill of materials (SBOM) for a checkout on disk or a
+GitHub repository, from the same dependency manifests and lockfiles that
+'antoine analyze' reads.
+
+Every dependency is listed with its version, license (as an SPDX
+expression), package URL (purl) and, when a lockfile records it, its hash.
+The go.sum h1 hash is not a file hash and goes in the go:h1 property
+(CycloneDX only). Two formats are supported:
+
+  cyclonedx-json   CycloneDX 1.5
+  spdx-json        SPDX 2.3
+
+The dependency graph comes from the lockfiles (package-lock.json,
+npm-shrinkwrap.json, yarn.lock, pnpm-lock.yaml, Cargo.lock): the project
+depends on its direct dependencies and each locked package on the
+packages it requires. Without a lockfile only the direct edges are known.
+
+Dependencies declared only as version ranges (no lockfile) are listed
+without a version. Manifests that cannot be read or parsed are reported
+on stderr.`,
+	Example: `  antoine sbom . > sbom.cdx.json
+  antoine sbom ./my-project --format spdx-json -o sbom.spdx.json
+  antoine sbom https://github.com/user/project`,
+	Args: cobra.MaximumNArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		target := "."
+		if len(args) > 0 {
+			target = args[0]
+		}
+
+		ok := views.NewSBOMView(client).Generate(&views.SBOMOptions{
+			Target:  target,
+			Format:  cmd.Flag("format").Value.String(),
+			Output:  cmd.Flag("output").Value.String(),
+			Version: version,
+		})
+		if !ok {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	sbomCmd.Flags().String("format", core.SBOMCycloneDX, "SBOM format: cyclonedx-json or spdx-json")
+	sbomCmd.Flags().StringP("output", "o", "", "write the SBOM to a file instead of stdout")
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestSBOMWritesOnlyJSON(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	project := t.TempDir()
+	files := map[string]string{
+		"package.json": `{"dependencies": {"a": "^1.0.0"}}`,
+		"package-lock.json": `{"lockfileVersion": 3, "packages": {
+			"": {"dependencies": {"a": "^1.0.0"}},
+			"node_modules/a": {"version": "1.0.0", "license": "MIT"}
+		}}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(project, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Como en un terminal: la bienvenida se mostraría si el comando no la excluyera
+	restoreTerminal, restoreWelcome := stdoutIsTerminal, welcome
+	stdoutIsTerminal = func() bool { return true }
+	SetWelcome(func(*cobra.Command) { fmt.Println("╔═══ welcome ═══╗") })
+	t.Cleanup(func() { stdoutIsTerminal, welcome = restoreTerminal, restoreWelcome })
+
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = writer
+	output := make(chan []byte)
+	go func() {
+		data, _ := io.ReadAll(reader)
+		output <- data
+	}()
+
+	rootCmd.SetArgs([]string{"sbom", project})
+	execErr := rootCmd.Execute()
+	os.Stdout = stdout
+	writer.Close()
+	data := <-output
+	if execErr != nil {
+		t.Fatal(execErr)
+	}
+
+	var bom struct {
+		BOMFormat  string            `json:"bomFormat"`
+		Components []json.RawMessage `json:"components"`
+	}
+	if err := json.Unmarshal(data, &bom); err != nil {
+		t.Fatalf("stdout is not JSON: %v\n%s", err, data)
+	}
+	if bom.BOMFormat != "CycloneDX" || len(bom.Components) != 1 {
+		t.Errorf("bom = %+v, want a CycloneDX document with one component", bom)
+	}
+}
+
+func TestWelcomeAllowed(t *testing.T) {
+	restore := stdoutIsTerminal
+	t.Cleanup(func() { stdoutIsTerminal = restore })
+
+	tests := []struct {
+		name     string
+		args     []string
+		terminal bool
+		want     bool
+	}{
+		{"search in a terminal", []string{"search"}, true, true},
+		{"search redirected", []string{"search"}, false, false},
+		{"sbom in a terminal", []string{"sbom"}, true, false},
+		{"calendar subcommand", []string{"calendar", "export"}, true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			command, _, err := rootCmd.Find(tt.args)
+			if err != nil {
+				t.Fatal(err)
+			}
+			stdoutIsTerminal = func() bool { return tt.terminal }
+			if got := welcomeAllowed(command); got != tt.want {
+				t.Errorf("welcomeAllowed(%s) = %v, want %v", command.CommandPath(), got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"antoine-cli/internal/config"
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/ui/views"
+)
+
+var scanCmd = &cobra.Command{
+	Use:   "scan",
+	Short: "Pre-submission checks for a repository checkout",
+}
+
+var scanSecretsCmd = &cobra.Command{
+	Use:   "secrets [path]",
+	Short: "Find leaked API keys, private keys and credentials",
+	Long: `Scan every file of a checkout (or a single file) for credentials before
+you publish it: provider keys (AWS, GitHub, GitLab, Slack, Stripe, Google,
+OpenAI, Anthropic, Hugging Face, SendGrid, npm, PyPI...), private keys,
+passwords in connection strings, high-entropy values assigned to secret-looking
+names, and credentials in committed .env files. Files ignored by .gitignore
+are skipped unless git already tracks them. Values are always redacted in the
+output.
+
+False positives can be allowed in a .secretsignore file at the repository
+root (or the file given with --allowlist), one entry per line:
+
+  tests/fixtures/            paths, with .gitignore syntax
+  rule:jwt                   a whole rule
+  fingerprint:3f9a0c1d2e4b   one secret, by the fingerprint in the report
+  regex:^AKIA.*TEST$         values matching a regular expression
+
+Fingerprints are keyed with a random per-machine key (~/.antoine/secrets.json),
+so a shared allowlist should use paths or rules instead.
+
+A line containing "antoine:allow" is never reported. The command exits with
+status 1 when secrets are found or the scan fails, so it can run as a pre-commit hook or CI step.`,
+	Args: cobra.MaximumNArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		path := "."
+		if len(args) > 0 {
+			path = args[0]
+		}
+
+		clean := views.NewScanView().Secrets(&views.ScanOptions{
+			Path:      path,
+			Allowlist: cmd.Flag("allowlist").Value.String(),
+			Limits:    core.LocalLimitsFromConfig(config.Get()),
+			Format:    viper.GetString("output.format"),
+		})
+		if !clean {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	scanSecretsCmd.Flags().String("allowlist", "", "allowlist file (default <path>/"+core.SecretAllowlistFile+")")
+
+	scanCmd.AddCommand(scanSecretsCmd)
+}
@@ -1,6 +1,11 @@
 package cmd
 
 import (
+	"fmt"
+	"strings"
+
+	"antoine-cli/internal/config"
+	"antoine-cli/internal/core"
 	"antoine-cli/internal/ui/views"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -20,14 +25,57 @@ var searchHackathonsCmd = &cobra.Command{
 Antoine analyzes thousands of hackathons to find your ideal match.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
+		tech, _ := cmd.Flags().GetStringSlice("tech")
+		cfg := config.Get()
+		sortBy, sortOrder := cmd.Flag("sort").Value.String(), cmd.Flag("order").Value.String()
+		if sortBy == "" {
+			sortBy = cfg.Search.Hackathons.SortBy
+		}
+		if sortOrder == "" {
+			sortOrder = cfg.Search.Hackathons.SortOrder
+		}
+
+		near := cmd.Flag("near").Value.String()
+		if near != "" && cmd.Flag("location").Changed {
+			fmt.Println("❌ Use either --near or --location, not both")
+			return
+		}
+		profile := activeProfile()
+
+		// Radio: --radius, después la distancia máxima del perfil y por último la configuración
+		var radius float64
+		if near != "" {
+			value := cfg.Search.Hackathons.DefaultRadius
+			switch {
+			case cmd.Flag("radius").Changed:
+				value = cmd.Flag("radius").Value.String()
+			case profile != nil && profile.Travel.MaxDistance > 0:
+				value = fmt.Sprintf("%dkm", profile.Travel.MaxDistance)
+			}
+			parsed, err := core.ParseDistance(value)
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				return
+			}
+			radius = parsed
+		}
+
 		options := &views.SearchOptions{
-			Tech:     cmd.Flag("tech").Value.String(),
-			Location: cmd.Flag("location").Value.String(),
-			PrizeMin: cmd.Flag("prize-min").Value.String(),
-			DateFrom: cmd.Flag("date-from").Value.String(),
-			DateTo:   cmd.Flag("date-to").Value.String(),
-			Online:   cmd.Flag("online").Changed,
-			Format:   viper.GetString("format"),
+			Query:      cmd.Flag("query").Value.String(),
+			Tech:       strings.Join(tech, ","),
+			Location:   cmd.Flag("location").Value.String(),
+			Near:       near,
+			Radius:     radius,
+			PrizeMin:   cmd.Flag("prize-min").Value.String(),
+			DateFrom:   cmd.Flag("date-from").Value.String(),
+			DateTo:     cmd.Flag("date-to").Value.String(),
+			Online:     cmd.Flag("online").Changed,
+			Difficulty: cmd.Flag("difficulty").Value.String(),
+			Sort:       sortBy,
+			SortOrder:  sortOrder,
+			Save:       cmd.Flag("save").Value.String(),
+			Profile:    profile,
+			Format:     viper.GetString("output.format"),
 		}
 
 		view := views.NewSearchView(client)
@@ -42,12 +90,15 @@ var searchProjectsCmd = &cobra.Command{
 and get inspiration for your next project.`,
 
 	Run: func(cmd *cobra.Command, args []string) {
+		tech, _ := cmd.Flags().GetStringSlice("tech")
+		category, _ := cmd.Flags().GetStringSlice("category")
 		options := &views.SearchOptions{
 			Hackathon: cmd.Flag("hackathon").Value.String(),
-			Category:  cmd.Flag("category").Value.String(),
-			Tech:      cmd.Flag("tech").Value.String(),
+			Category:  strings.Join(category, ","),
+			Tech:      strings.Join(tech, ","),
 			Sort:      cmd.Flag("sort").Value.String(),
-			Format:    viper.GetString("format"),
+			Profile:   activeProfile(),
+			Format:    viper.GetString("output.format"),
 		}
 
 		view := views.NewSearchView(client)
@@ -55,15 +106,46 @@ and get inspiration for your next project.`,
 	},
 }
 
+var searchSavedCmd = &cobra.Command{
+	Use:   "saved",
+	Short: "List saved hackathon searches",
+	Long: `List the searches saved with 'antoine search hackathons --save <name>'.
+Saved searches keep the query, filters and sort, and are re-run by 'antoine watch'.`,
+	Aliases: []string{"ls"},
+
+	Run: func(cmd *cobra.Command, args []string) {
+		view := views.NewSearchView(client)
+		view.ListSavedSearches(viper.GetString("output.format"))
+	},
+}
+
+var searchSavedDeleteCmd = &cobra.Command{
+	Use:     "rm [name]",
+	Short:   "Delete a saved search",
+	Aliases: []string{"delete"},
+	Args:    cobra.ExactArgs(1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		view := views.NewSearchView(client)
+		view.DeleteSavedSearch(args[0])
+	},
+}
+
 func init() {
 	// Flags para hackathons
 	searchHackathonsCmd.Flags().StringSlice("tech", []string{}, "technologies (e.g., --tech AI,Blockchain)")
 	searchHackathonsCmd.Flags().String("location", "", "location (city, country, or 'online')")
-	searchHackathonsCmd.Flags().Int("prize-min", 0, "minimum prize amount")
+	searchHackathonsCmd.Flags().Int("prize-min", 0, "minimum prize amount in the base currency (search.prizes.base_currency)")
 	searchHackathonsCmd.Flags().String("date-from", "", "start date (YYYY-MM-DD)")
 	searchHackathonsCmd.Flags().String("date-to", "", "end date (YYYY-MM-DD)")
-	searchHackathonsCmd.Flags().Bool("online", false, "online hackathons only")
+	searchHackathonsCmd.Flags().Bool("online", false, "online hackathons only (with --near: also include online and hybrid events)")
+	searchHackathonsCmd.Flags().String("near", "", "city or lat,lng to search around (e.g., --near Madrid, --near 40.41,-3.70)")
+	searchHackathonsCmd.Flags().String("radius", "", "distance from --near (e.g., 100km, 60mi; default profile max distance or search.hackathons.default_radius)")
 	searchHackathonsCmd.Flags().String("difficulty", "", "difficulty level (beginner, intermediate, advanced)")
+	searchHackathonsCmd.Flags().String("query", "", "search terms")
+	searchHackathonsCmd.Flags().String("sort", "", "sort by (start_date, prize_pool, popularity, name; default search.hackathons.sort_by)")
+	searchHackathonsCmd.Flags().String("order", "", "sort order (asc, desc; default search.hackathons.sort_order)")
+	searchHackathonsCmd.Flags().String("save", "", "save this search under a name (see 'antoine watch')")
 
 	// Flags para proyectos
 	searchProjectsCmd.Flags().String("hackathon", "", "specific hackathon name")
@@ -74,4 +156,6 @@ func init() {
 
 	searchCmd.AddCommand(searchHackathonsCmd)
 	searchCmd.AddCommand(searchProjectsCmd)
+	searchCmd.AddCommand(searchSavedCmd)
+	searchSavedCmd.AddCommand(searchSavedDeleteCmd)
 }
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"antoine-cli/internal/ui/views"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var statsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Show your Antoine usage statistics",
+	Long: `Show a breakdown of your searches, analyses, cache efficiency and most
+requested technologies for today, the last 7 and 30 days, and all time.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		top, _ := cmd.Flags().GetInt("top")
+		options := &views.StatsOptions{
+			Top:    top,
+			Reset:  cmd.Flag("reset").Changed,
+			Format: viper.GetString("output.format"),
+		}
+
+		view := views.NewStatsView(client)
+		view.ShowStats(options)
+	},
+}
+
+func init() {
+	statsCmd.Flags().Int("top", 5, "number of top technologies to show")
+	statsCmd.Flags().Bool("reset", false, "clear all recorded statistics")
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"antoine-cli/internal/ui/views"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// defaultTrendTechnologies se usan cuando no se indica --tech
+var defaultTrendTechnologies = []string{"ai", "blockchain", "web3", "iot", "rust"}
+
+var trendsCmd = &cobra.Command{
+	Use:   "trends",
+	Short: "Show current tech trends",
+	Long: `Show activity, growth and related technologies for the given technologies
+over a timeframe. Same as 'antoine analyze trends'.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		runTrends(cmd)
+	},
+}
+
+// addTrendsFlags registra las flags compartidas por 'trends' y 'analyze trends'
+func addTrendsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringSlice("tech", []string{}, "technologies to analyze")
+	cmd.Flags().String("timeframe", "6months", "analysis timeframe (1month, 3months, 6months, 1year)")
+	cmd.Flags().Bool("include-metrics", false, "include detailed metrics")
+	cmd.Flags().String("market", "", "only count activity in this market (defi, gaming, ai, etc.)")
+}
+
+// runTrends construye las opciones desde las flags y muestra el informe de tendencias
+func runTrends(cmd *cobra.Command) {
+	techSlice, _ := cmd.Flags().GetStringSlice("tech")
+	if len(techSlice) == 0 {
+		techSlice = defaultTrendTechnologies
+	}
+
+	options := &views.AnalysisOptions{
+		Tech:      techSlice,
+		Timeframe: cmd.Flag("timeframe").Value.String(),
+		Metrics:   cmd.Flag("include-metrics").Changed,
+		Market:    cmd.Flag("market").Value.String(),
+		Format:    viper.GetString("output.format"),
+	}
+
+	view := views.NewAnalysisView(client)
+	view.AnalyzeTrends(options)
+}
+
+func init() {
+	addTrendsFlags(trendsCmd)
+}
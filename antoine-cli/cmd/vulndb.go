@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"antoine-cli/internal/ui/views"
+)
+
+var vulndbCmd = &cobra.Command{
+	Use:   "vulndb",
+	Short: "Manage the offline vulnerability database",
+	Long: `Manage the local vulnerability database used to check dependencies during
+'antoine analyze'. The database is imported from OSV exports, so analysis
+works offline and never sends your dependency list anywhere.
+
+Without a subcommand, shows which ecosystems have been imported and when.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		views.NewVulnDBView().Status(viper.GetString("output.format"))
+	},
+}
+
+var vulndbUpdateCmd = &cobra.Command{
+	Use:   "update",
+	Short: "Import OSV vulnerability data",
+	Long: `Import OSV advisories for Go, npm, PyPI, crates.io and Maven into
+~/.antoine/vulndb. Download the exports per ecosystem from
+https://osv-vulnerabilities.storage.googleapis.com/<ecosystem>/all.zip
+(for example .../PyPI/all.zip) and pass one or more with --from. A single
+OSV .json file or a directory of them is accepted too.
+
+Each ecosystem in the import replaces the data previously imported for it;
+other ecosystems are kept. Withdrawn advisories are skipped.`,
+	Example: `  antoine vulndb update --from ~/Downloads/npm-all.zip
+  antoine vulndb update --from Go.zip,PyPI.zip`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		sources, _ := cmd.Flags().GetStringSlice("from")
+		views.NewVulnDBView().Update(sources, viper.GetString("output.format"))
+	},
+}
+
+func init() {
+	vulndbUpdateCmd.Flags().StringSlice("from", nil, "OSV export to import: all.zip, a .json file or a directory")
+	_ = vulndbUpdateCmd.MarkFlagRequired("from")
+
+	vulndbCmd.AddCommand(vulndbUpdateCmd)
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"antoine-cli/internal/config"
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/ui/views"
+)
+
+var watchCmd = &cobra.Command{
+	Use:   "watch [saved-search...]",
+	Short: "Watch saved searches for new or changed hackathons",
+	Long: `Periodically re-run saved searches (all of them, or the ones named) and
+compare the results with the last snapshot. New or changed hackathons trigger
+notifications through the channels in the notifications config section:
+terminal bell or OSC 9, a desktop command hook, and a webhook.
+
+Save a search first with 'antoine search hackathons --save <name>'.
+Use --once to run a single check, e.g. from cron.`,
+
+	Run: func(cmd *cobra.Command, args []string) {
+		interval, err := time.ParseDuration(cmd.Flag("interval").Value.String())
+		if err != nil || interval < time.Minute {
+			fmt.Println("❌ --interval must be a duration of at least 1m (e.g. 30m, 6h)")
+			return
+		}
+
+		options := &views.WatchOptions{
+			Names:    args,
+			Interval: interval,
+			Once:     cmd.Flag("once").Changed,
+			Notifier: core.NewNotifier(config.Get().Notifications, os.Stdout),
+			Format:   viper.GetString("output.format"),
+		}
+
+		view := views.NewWatchView(client)
+		view.Watch(options)
+	},
+}
+
+func init() {
+	watchCmd.Flags().String("interval", "1h", "time between checks")
+	watchCmd.Flags().Bool("once", false, "check once and exit")
+}
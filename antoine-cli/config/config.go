@@ -83,7 +83,7 @@ func SetDefaults() {
 	viper.SetDefault("cache.max_size", 1000)
 
 	// Analytics defaults
-	viper.SetDefault("analytics.enabled", true)
+	viper.SetDefault("analytics.enabled", false)
 	viper.SetDefault("analytics.anonymous", true)
 }
 
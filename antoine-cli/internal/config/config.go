@@ -127,6 +127,14 @@ type SearchConfig struct {
 	MaxLimit     int                   `mapstructure:"max_limit"`
 	Hackathons   HackathonSearchConfig `mapstructure:"hackathons"`
 	Projects     ProjectSearchConfig   `mapstructure:"projects"`
+	Recommend    RecommendConfig       `mapstructure:"recommend"`
+	Prizes       PrizesConfig          `mapstructure:"prizes"`
+}
+
+// PrizesConfig represents prize normalization configuration
+type PrizesConfig struct {
+	BaseCurrency string `mapstructure:"base_currency"` // moneda a la que se convierten filtros, orden y columnas
+	RatesURL     string `mapstructure:"rates_url"`     // fuente de "antoine config rates refresh"
 }
 
 // HackathonSearchConfig represents hackathon search configuration
@@ -136,6 +144,13 @@ type HackathonSearchConfig struct {
 	IncludePast    bool   `mapstructure:"include_past"`
 	IncludeVirtual bool   `mapstructure:"include_virtual"`
 	MinPrize       int    `mapstructure:"min_prize"`
+	DefaultRadius  string `mapstructure:"default_radius"` // radio de --near si no se indica --radius
+}
+
+// RecommendConfig represents hackathon recommendation configuration
+type RecommendConfig struct {
+	Limit   int                `mapstructure:"limit"`
+	Weights map[string]float64 `mapstructure:"weights"`
 }
 
 // ProjectSearchConfig represents project search configuration
@@ -214,6 +229,9 @@ type NotificationsConfig struct {
 	Desktop  bool   `mapstructure:"desktop"`
 	Sound    bool   `mapstructure:"sound"`
 	Duration string `mapstructure:"duration"`
+	Terminal string `mapstructure:"terminal"` // bell, osc9 or none
+	Command  string `mapstructure:"command"`  // desktop hook, receives ANTOINE_TITLE and ANTOINE_MESSAGE
+	Webhook  string `mapstructure:"webhook"`  // URL that receives a JSON POST
 }
 
 // Get returns the current configuration
@@ -284,7 +302,7 @@ func SetDefaults() {
 	viper.SetDefault("logging.file.compress", true)
 
 	// Analytics defaults
-	viper.SetDefault("analytics.enabled", true)
+	viper.SetDefault("analytics.enabled", false)
 	viper.SetDefault("analytics.anonymous", true)
 	viper.SetDefault("analytics.endpoint", "https://analytics.antoine.ai")
 	viper.SetDefault("analytics.batch_size", 100)
@@ -298,10 +316,24 @@ func SetDefaults() {
 	viper.SetDefault("search.hackathons.include_past", false)
 	viper.SetDefault("search.hackathons.include_virtual", true)
 	viper.SetDefault("search.hackathons.min_prize", 0)
+	viper.SetDefault("search.hackathons.default_radius", "100km")
+	viper.SetDefault("search.prizes.base_currency", "USD")
+	viper.SetDefault("search.prizes.rates_url", "https://api.frankfurter.app/latest?from=USD")
 	viper.SetDefault("search.projects.sort_by", "popularity")
 	viper.SetDefault("search.projects.sort_order", "desc")
 	viper.SetDefault("search.projects.include_forks", false)
 	viper.SetDefault("search.projects.min_stars", 0)
+	viper.SetDefault("search.recommend.limit", 10)
+	viper.SetDefault("search.recommend.weights", map[string]float64{
+		"technology": 0.25,
+		"theme":      0.15,
+		"dates":      0.15,
+		"location":   0.15,
+		"timezone":   0.05,
+		"team":       0.05,
+		"difficulty": 0.10,
+		"prize":      0.10,
+	})
 
 	// Analysis defaults
 	viper.SetDefault("analysis.parallel_analysis", true)
@@ -353,6 +385,9 @@ func SetDefaults() {
 	viper.SetDefault("notifications.desktop", false)
 	viper.SetDefault("notifications.sound", false)
 	viper.SetDefault("notifications.duration", "3s")
+	viper.SetDefault("notifications.terminal", "")
+	viper.SetDefault("notifications.command", "")
+	viper.SetDefault("notifications.webhook", "")
 }
 
 // setMCPDefaults configures MCP server defaults
@@ -2,62 +2,254 @@ package core
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
 	"time"
+
+	"antoine-cli/internal/utils"
+)
+
+// Tipos de eventos que registra el AnalyticsManager
+const (
+	EventSearch   = "search"
+	EventAnalysis = "analysis"
+	EventTrend    = "trend"
+	EventCache    = "cache"
 )
 
+// eventRetention limita el historial guardado para calcular ventanas de tiempo
+const eventRetention = 90 * 24 * time.Hour
+
+// AnalyticsEvent es un registro de uso con marca de tiempo
+type AnalyticsEvent struct {
+	Type      string    `json:"type"`
+	Name      string    `json:"name"`
+	Value     int       `json:"value"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// analyticsState es la representación persistida de las métricas
+type analyticsState struct {
+	Counters  map[string]int       `json:"counters"`
+	LastSeen  map[string]time.Time `json:"last_seen"`
+	Events    []AnalyticsEvent     `json:"events"`
+	UpdatedAt time.Time            `json:"updated_at"`
+}
+
+// analyticsDelta son los cambios de este proceso aún sin guardar. Al persistir se suman a lo
+// que haya en disco, de forma que varios procesos a la vez no se pisan los contadores.
+type analyticsDelta struct {
+	added    map[string]int // contadores acumulativos
+	set      map[string]int // valores absolutos (resultados de la última búsqueda)
+	lastSeen map[string]time.Time
+	events   []AnalyticsEvent
+}
+
 type AnalyticsManager struct {
-	metrics map[string]interface{}
-	mu      sync.RWMutex
+	state    analyticsState
+	pending  analyticsDelta
+	path     string
+	exporter *TelemetryPipeline
+	mu       sync.RWMutex
+}
+
+// TechnologyCount asocia una tecnología con el número de consultas de tendencias
+type TechnologyCount struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+// AnalyticsSummary agrega las métricas de una ventana de tiempo
+type AnalyticsSummary struct {
+	Window      string         `json:"window"`
+	Since       time.Time      `json:"since,omitempty"`
+	Searches    map[string]int `json:"searches"`
+	Results     map[string]int `json:"results"`
+	Analyses    map[string]int `json:"analyses"`
+	Trends      map[string]int `json:"trends"`
+	CacheHits   int            `json:"cache_hits"`
+	CacheMisses int            `json:"cache_misses"`
 }
 
+// NewAnalyticsManager crea un manager persistido en ~/.antoine/analytics/metrics.json.
+// Si el archivo no se puede usar, las métricas quedan solo en memoria.
 func NewAnalyticsManager() *AnalyticsManager {
-	return &AnalyticsManager{
-		metrics: make(map[string]interface{}),
+	path, err := DataPath("analytics", "metrics.json")
+	if err != nil {
+		utils.WithError(err).Debug("Analytics persistence disabled")
+		path = ""
+	}
+
+	am, err := NewAnalyticsManagerAt(path)
+	if err != nil {
+		utils.WithError(err).Debug("Discarding unreadable analytics state")
+		am = &AnalyticsManager{state: newAnalyticsState(), pending: newAnalyticsDelta(), path: path}
+	}
+
+	return am
+}
+
+// NewAnalyticsManagerAt crea un manager que persiste en path (vacío = solo memoria)
+func NewAnalyticsManagerAt(path string) (*AnalyticsManager, error) {
+	am := &AnalyticsManager{
+		state:   newAnalyticsState(),
+		pending: newAnalyticsDelta(),
+		path:    path,
+	}
+
+	if path == "" {
+		return am, nil
+	}
+
+	if _, err := readJSONFile(path, &am.state); err != nil {
+		return nil, err
+	}
+	am.state.initMaps()
+
+	return am, nil
+}
+
+func newAnalyticsState() analyticsState {
+	return analyticsState{
+		Counters: make(map[string]int),
+		LastSeen: make(map[string]time.Time),
+	}
+}
+
+func (s *analyticsState) initMaps() {
+	if s.Counters == nil {
+		s.Counters = make(map[string]int)
+	}
+	if s.LastSeen == nil {
+		s.LastSeen = make(map[string]time.Time)
+	}
+}
+
+func newAnalyticsDelta() analyticsDelta {
+	return analyticsDelta{
+		added:    make(map[string]int),
+		set:      make(map[string]int),
+		lastSeen: make(map[string]time.Time),
+	}
+}
+
+// apply aplica los cambios pendientes sobre state
+func (d analyticsDelta) apply(state *analyticsState) {
+	for key, value := range d.added {
+		state.Counters[key] += value
+	}
+	for key, value := range d.set {
+		state.Counters[key] = value
+	}
+	for key, seen := range d.lastSeen {
+		if seen.After(state.LastSeen[key]) {
+			state.LastSeen[key] = seen
+		}
+	}
+	state.Events = append(state.Events, d.events...)
+	for _, event := range d.events {
+		if event.Timestamp.After(state.UpdatedAt) {
+			state.UpdatedAt = event.Timestamp
+		}
+	}
+
+	// Los eventos de varios procesos pueden llegar desordenados
+	sort.SliceStable(state.Events, func(i, j int) bool { return state.Events[i].Timestamp.Before(state.Events[j].Timestamp) })
+	cutoff := time.Now().Add(-eventRetention)
+	first := 0
+	for first < len(state.Events) && state.Events[first].Timestamp.Before(cutoff) {
+		first++
 	}
+	state.Events = state.Events[first:]
 }
 
-func (am *AnalyticsManager) RecordSearch(searchType string, resultCount int) {
+// SetExporter conecta el pipeline que exporta los eventos (nil lo desactiva)
+func (am *AnalyticsManager) SetExporter(exporter *TelemetryPipeline) {
 	am.mu.Lock()
 	defer am.mu.Unlock()
 
-	key := fmt.Sprintf("searches_%s", searchType)
-	if count, exists := am.metrics[key]; exists {
-		am.metrics[key] = count.(int) + 1
-	} else {
-		am.metrics[key] = 1
-	}
+	am.exporter = exporter
+}
+
+// Exporter devuelve el pipeline de exportación activo, si hay uno
+func (am *AnalyticsManager) Exporter() *TelemetryPipeline {
+	am.mu.RLock()
+	defer am.mu.RUnlock()
+
+	return am.exporter
+}
+
+func (am *AnalyticsManager) RecordSearch(searchType, query string, resultCount int) {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+
+	now := time.Now()
+	am.addLocked(fmt.Sprintf("searches_%s", searchType), 1)
+	am.addLocked(fmt.Sprintf("results_total_%s", searchType), resultCount)
+	am.setLocked(fmt.Sprintf("results_%s", searchType), resultCount)
+	am.seenLocked(fmt.Sprintf("last_search_%s", searchType), now)
 
-	am.metrics[fmt.Sprintf("last_search_%s", searchType)] = time.Now()
-	am.metrics[fmt.Sprintf("results_%s", searchType)] = resultCount
+	am.appendEventLocked(AnalyticsEvent{Type: EventSearch, Name: searchType, Value: resultCount, Timestamp: now})
+	am.exportLocked(TelemetryEvent{Type: EventSearch, Name: searchType, Query: query, Count: resultCount, Timestamp: now})
 }
 
 func (am *AnalyticsManager) RecordAnalysis(analysisType, target string) {
 	am.mu.Lock()
 	defer am.mu.Unlock()
 
-	key := fmt.Sprintf("analysis_%s", analysisType)
-	if count, exists := am.metrics[key]; exists {
-		am.metrics[key] = count.(int) + 1
-	} else {
-		am.metrics[key] = 1
-	}
+	now := time.Now()
+	am.addLocked(fmt.Sprintf("analysis_%s", analysisType), 1)
+	am.seenLocked(fmt.Sprintf("last_analysis_%s", analysisType), now)
 
-	am.metrics[fmt.Sprintf("last_analysis_%s", analysisType)] = time.Now()
+	am.appendEventLocked(AnalyticsEvent{Type: EventAnalysis, Name: analysisType, Value: 1, Timestamp: now})
+	am.exportLocked(TelemetryEvent{Type: EventAnalysis, Name: analysisType, RepoURL: target, Count: 1, Timestamp: now})
 }
 
 func (am *AnalyticsManager) RecordTrends(technologies []string) {
 	am.mu.Lock()
 	defer am.mu.Unlock()
 
+	now := time.Now()
+	events := make([]AnalyticsEvent, 0, len(technologies))
 	for _, tech := range technologies {
-		key := fmt.Sprintf("trend_requests_%s", tech)
-		if count, exists := am.metrics[key]; exists {
-			am.metrics[key] = count.(int) + 1
-		} else {
-			am.metrics[key] = 1
-		}
+		am.addLocked(fmt.Sprintf("trend_requests_%s", tech), 1)
+		events = append(events, AnalyticsEvent{Type: EventTrend, Name: tech, Value: 1, Timestamp: now})
+		am.exportLocked(TelemetryEvent{Type: EventTrend, Name: tech, Count: 1, Timestamp: now})
+	}
+
+	am.appendEventLocked(events...)
+}
+
+// RecordCacheLookup registra un acierto o fallo de caché para una categoría de datos
+func (am *AnalyticsManager) RecordCacheLookup(category string, hit bool) {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+
+	value := 0
+	if hit {
+		value = 1
+		am.addLocked("cache_hits", 1)
+	} else {
+		am.addLocked("cache_misses", 1)
+	}
+
+	now := time.Now()
+	am.appendEventLocked(AnalyticsEvent{Type: EventCache, Name: category, Value: value, Timestamp: now})
+	am.exportLocked(TelemetryEvent{Type: EventCache, Name: category, Count: value, Timestamp: now})
+}
+
+// TelemetryEvents devuelve el historial local como eventos de exportación, para enseñar qué
+// se enviaría antes de aceptar la exportación
+func (am *AnalyticsManager) TelemetryEvents() []TelemetryEvent {
+	am.mu.RLock()
+	defer am.mu.RUnlock()
+
+	events := make([]TelemetryEvent, 0, len(am.state.Events))
+	for _, event := range am.state.Events {
+		events = append(events, TelemetryEvent{Type: event.Type, Name: event.Name, Count: event.Value, Timestamp: event.Timestamp})
 	}
+	return events
 }
 
 func (am *AnalyticsManager) GetMetrics() map[string]interface{} {
@@ -65,9 +257,210 @@ func (am *AnalyticsManager) GetMetrics() map[string]interface{} {
 	defer am.mu.RUnlock()
 
 	result := make(map[string]interface{})
-	for k, v := range am.metrics {
+	for k, v := range am.state.Counters {
+		result[k] = v
+	}
+	for k, v := range am.state.LastSeen {
 		result[k] = v
 	}
 
 	return result
 }
+
+// LastUpdate devuelve el momento del último evento registrado
+func (am *AnalyticsManager) LastUpdate() time.Time {
+	am.mu.RLock()
+	defer am.mu.RUnlock()
+
+	return am.state.UpdatedAt
+}
+
+// Totals resume las métricas acumuladas desde el primer uso
+func (am *AnalyticsManager) Totals() AnalyticsSummary {
+	am.mu.RLock()
+	defer am.mu.RUnlock()
+
+	summary := newAnalyticsSummary("all time", time.Time{})
+	for key, value := range am.state.Counters {
+		switch {
+		case strings.HasPrefix(key, "searches_"):
+			summary.Searches[strings.TrimPrefix(key, "searches_")] = value
+		case strings.HasPrefix(key, "results_total_"):
+			summary.Results[strings.TrimPrefix(key, "results_total_")] = value
+		case strings.HasPrefix(key, "analysis_"):
+			summary.Analyses[strings.TrimPrefix(key, "analysis_")] = value
+		case strings.HasPrefix(key, "trend_requests_"):
+			summary.Trends[strings.TrimPrefix(key, "trend_requests_")] = value
+		case key == "cache_hits":
+			summary.CacheHits = value
+		case key == "cache_misses":
+			summary.CacheMisses = value
+		}
+	}
+
+	return summary
+}
+
+// Summary resume los eventos registrados desde since
+func (am *AnalyticsManager) Summary(window string, since time.Time) AnalyticsSummary {
+	am.mu.RLock()
+	defer am.mu.RUnlock()
+
+	summary := newAnalyticsSummary(window, since)
+	for _, event := range am.state.Events {
+		if event.Timestamp.Before(since) {
+			continue
+		}
+
+		switch event.Type {
+		case EventSearch:
+			summary.Searches[event.Name]++
+			summary.Results[event.Name] += event.Value
+		case EventAnalysis:
+			summary.Analyses[event.Name]++
+		case EventTrend:
+			summary.Trends[event.Name]++
+		case EventCache:
+			if event.Value > 0 {
+				summary.CacheHits++
+			} else {
+				summary.CacheMisses++
+			}
+		}
+	}
+
+	return summary
+}
+
+// Reset borra todas las métricas, también las persistidas
+func (am *AnalyticsManager) Reset() error {
+	am.mu.Lock()
+	defer am.mu.Unlock()
+
+	am.state = newAnalyticsState()
+	am.pending = newAnalyticsDelta()
+	if am.path == "" {
+		return nil
+	}
+	var stored analyticsState
+	return updateJSONFile(am.path, &stored, func(bool) error {
+		stored = newAnalyticsState()
+		return nil
+	})
+}
+
+func (am *AnalyticsManager) addLocked(key string, value int) {
+	am.state.Counters[key] += value
+	am.pending.added[key] += value
+}
+
+func (am *AnalyticsManager) setLocked(key string, value int) {
+	am.state.Counters[key] = value
+	am.pending.set[key] = value
+}
+
+func (am *AnalyticsManager) seenLocked(key string, seen time.Time) {
+	am.state.LastSeen[key] = seen
+	am.pending.lastSeen[key] = seen
+}
+
+// appendEventLocked añade eventos y persiste el estado
+func (am *AnalyticsManager) appendEventLocked(events ...AnalyticsEvent) {
+	am.state.Events = append(am.state.Events, events...)
+	am.state.UpdatedAt = time.Now()
+	am.pending.events = append(am.pending.events, events...)
+	if am.path == "" {
+		am.pending = newAnalyticsDelta()
+		return
+	}
+
+	if err := am.persistLocked(); err != nil {
+		utils.WithError(err).Debug("Failed to persist analytics")
+	}
+}
+
+// exportLocked encola el evento en el pipeline de exportación si está activo
+func (am *AnalyticsManager) exportLocked(event TelemetryEvent) {
+	if am.exporter == nil {
+		return
+	}
+
+	if err := am.exporter.Enqueue(event); err != nil {
+		utils.WithError(err).Debug("Failed to enqueue analytics event")
+	}
+}
+
+// persistLocked suma los cambios pendientes al estado guardado en disco (que puede haber
+// cambiado otro proceso) y recarga el resultado. Si falla, los cambios siguen pendientes.
+func (am *AnalyticsManager) persistLocked() error {
+	var stored analyticsState
+	err := updateJSONFile(am.path, &stored, func(bool) error {
+		stored.initMaps()
+		am.pending.apply(&stored)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	am.state = stored
+	am.pending = newAnalyticsDelta()
+	return nil
+}
+
+func newAnalyticsSummary(window string, since time.Time) AnalyticsSummary {
+	return AnalyticsSummary{
+		Window:   window,
+		Since:    since,
+		Searches: make(map[string]int),
+		Results:  make(map[string]int),
+		Analyses: make(map[string]int),
+		Trends:   make(map[string]int),
+	}
+}
+
+// TotalSearches suma las búsquedas de todos los tipos
+func (s AnalyticsSummary) TotalSearches() int {
+	total := 0
+	for _, count := range s.Searches {
+		total += count
+	}
+	return total
+}
+
+// TotalAnalyses suma los análisis de todos los tipos
+func (s AnalyticsSummary) TotalAnalyses() int {
+	total := 0
+	for _, count := range s.Analyses {
+		total += count
+	}
+	return total
+}
+
+// CacheHitRate devuelve la proporción de aciertos de caché (0-1)
+func (s AnalyticsSummary) CacheHitRate() float64 {
+	lookups := s.CacheHits + s.CacheMisses
+	if lookups == 0 {
+		return 0
+	}
+	return float64(s.CacheHits) / float64(lookups)
+}
+
+// TopTechnologies devuelve las n tecnologías más consultadas en tendencias
+func (s AnalyticsSummary) TopTechnologies(n int) []TechnologyCount {
+	techs := make([]TechnologyCount, 0, len(s.Trends))
+	for name, count := range s.Trends {
+		techs = append(techs, TechnologyCount{Name: name, Count: count})
+	}
+
+	sort.Slice(techs, func(i, j int) bool {
+		if techs[i].Count == techs[j].Count {
+			return techs[i].Name < techs[j].Name
+		}
+		return techs[i].Count > techs[j].Count
+	})
+
+	if n > 0 && len(techs) > n {
+		techs = techs[:n]
+	}
+	return techs
+}
@@ -0,0 +1,116 @@
+package core
+
+import (
+	"path/filepath"
+	"sync"
+	"testing"
+)
+
+func TestAnalyticsManagersMergeCounters(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+
+	// Cada manager simula un proceso distinto con su propia copia en memoria
+	const managers, events = 4, 25
+	var wg sync.WaitGroup
+	for i := 0; i < managers; i++ {
+		am, err := NewAnalyticsManagerAt(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < events; j++ {
+				am.RecordAnalysis("local", "/tmp/project")
+			}
+			am.RecordSearch("hackathons", "ai", 3)
+		}()
+	}
+	wg.Wait()
+
+	am, err := NewAnalyticsManagerAt(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	totals := am.Totals()
+	if got := totals.Analyses["local"]; got != managers*events {
+		t.Errorf("analyses = %d, want %d", got, managers*events)
+	}
+	if got := totals.Results["hackathons"]; got != managers*3 {
+		t.Errorf("cumulative results = %d, want %d", got, managers*3)
+	}
+	if got := am.GetMetrics()["results_hackathons"]; got != 3 {
+		t.Errorf("last search results = %v, want 3", got)
+	}
+	if got := len(am.state.Events); got != managers*(events+1) {
+		t.Errorf("events = %d, want %d", got, managers*(events+1))
+	}
+}
+
+func TestAnalyticsReset(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	am, err := NewAnalyticsManagerAt(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	am.RecordTrends([]string{"Go", "Rust"})
+	if err := am.Reset(); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := NewAnalyticsManagerAt(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if trends := reloaded.Totals().Trends; len(trends) != 0 {
+		t.Errorf("trends after reset = %v", trends)
+	}
+}
+
+func TestAnalyticsCacheLookups(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "metrics.json")
+	am, err := NewAnalyticsManagerAt(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	am.RecordCacheLookup("hackathons", false)
+	am.RecordCacheLookup("hackathons", true)
+	am.RecordCacheLookup("projects", true)
+	am.RecordCacheLookup("trends", true)
+
+	// Los aciertos deben sobrevivir a otro proceso que relee el archivo
+	reloaded, err := NewAnalyticsManagerAt(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	totals := reloaded.Totals()
+	if totals.CacheHits != 3 || totals.CacheMisses != 1 {
+		t.Errorf("cache hits/misses = %d/%d, want 3/1", totals.CacheHits, totals.CacheMisses)
+	}
+	if got := totals.CacheHitRate(); got != 0.75 {
+		t.Errorf("hit rate = %v, want 0.75", got)
+	}
+	if got := (AnalyticsSummary{}).CacheHitRate(); got != 0 {
+		t.Errorf("hit rate without lookups = %v, want 0", got)
+	}
+}
+
+func TestAnalyticsTelemetryEvents(t *testing.T) {
+	am, err := NewAnalyticsManagerAt(filepath.Join(t.TempDir(), "metrics.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	am.RecordSearch("hackathons", "ai", 3)
+	am.RecordTrends([]string{"Go"})
+
+	events := am.TelemetryEvents()
+	if len(events) != 2 {
+		t.Fatalf("events = %+v, want 2", events)
+	}
+	if got := events[0]; got.Type != EventSearch || got.Name != "hackathons" || got.Count != 3 || got.Query != "" {
+		t.Errorf("search event = %+v", got)
+	}
+	if got := events[1]; got.Type != EventTrend || got.Name != "Go" || got.Count != 1 {
+		t.Errorf("trend event = %+v", got)
+	}
+}
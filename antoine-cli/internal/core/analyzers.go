@@ -0,0 +1,63 @@
+package core
+
+// SourceAnalyzer calcula las métricas de los archivos de un lenguaje. Analyze rellena las
+// líneas, funciones, clases y la complejidad por función; AnalyzeSourceFile completa la ruta,
+// el tamaño y los tests por nombre. Si devuelve error se usa la estimación por líneas.
+type SourceAnalyzer interface {
+	Analyze(file SourceFile, content []byte) (FileMetrics, error)
+}
+
+// sourceAnalyzers se indexa por el nombre del lenguaje de languageSpecs
+var sourceAnalyzers = map[string]SourceAnalyzer{}
+
+// RegisterSourceAnalyzer asocia un analizador a uno o varios lenguajes
+func RegisterSourceAnalyzer(analyzer SourceAnalyzer, languages ...string) {
+	for _, language := range languages {
+		sourceAnalyzers[language] = analyzer
+	}
+}
+
+// HasSourceAnalyzer indica si el lenguaje tiene análisis por función
+func HasSourceAnalyzer(language string) bool {
+	_, ok := sourceAnalyzers[language]
+	return ok
+}
+
+func init() {
+	RegisterSourceAnalyzer(goAnalyzer{}, "Go")
+	RegisterSourceAnalyzer(newScriptAnalyzer(false), "JavaScript")
+	RegisterSourceAnalyzer(newScriptAnalyzer(true), "TypeScript")
+	RegisterSourceAnalyzer(pythonAnalyzer{}, "Python")
+	RegisterSourceAnalyzer(rustAnalyzer{}, "Rust")
+}
+
+// goAnalyzer cuenta las líneas por patrones y las funciones sobre el AST de go/parser
+type goAnalyzer struct{}
+
+func (goAnalyzer) Analyze(file SourceFile, content []byte) (FileMetrics, error) {
+	functions, types, err := goFileComplexity(file.Path, content)
+	if err != nil {
+		return FileMetrics{}, err
+	}
+	metrics, err := estimateSourceFile(file, content)
+	if err != nil {
+		return FileMetrics{}, err
+	}
+	metrics.Classes = types
+	summarizeFunctions(&metrics, functions)
+	return metrics, nil
+}
+
+// summarizeFunctions guarda el detalle por función y recalcula los totales del archivo
+func summarizeFunctions(metrics *FileMetrics, functions []FunctionComplexity) {
+	metrics.FunctionComplexity = functions
+	if metrics.FunctionComplexity == nil {
+		metrics.FunctionComplexity = []FunctionComplexity{}
+	}
+	metrics.Functions = len(functions)
+	metrics.Cyclomatic, metrics.Cognitive = 0, 0
+	for _, function := range functions {
+		metrics.Cyclomatic += float64(function.Cyclomatic)
+		metrics.Cognitive += float64(function.Cognitive)
+	}
+}
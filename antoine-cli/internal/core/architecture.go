@@ -0,0 +1,589 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"antoine-cli/internal/models"
+)
+
+// PackageCoupling es un nodo del grafo de imports: un paquete de Go o un directorio de JS/TS
+type PackageCoupling struct {
+	Name        string  `json:"name"` // directorio relativo a la raíz; "." es la raíz
+	Language    string  `json:"language"`
+	Files       int     `json:"files"`
+	Layer       string  `json:"layer,omitempty"`
+	Afferent    int     `json:"afferent"`    // paquetes que lo importan (Ca)
+	Efferent    int     `json:"efferent"`    // paquetes que importa (Ce)
+	Instability float64 `json:"instability"` // Ce / (Ca + Ce): 0 estable, 1 inestable
+}
+
+// ImportEdge es una dependencia entre dos paquetes del proyecto
+type ImportEdge struct {
+	From    string `json:"from"`
+	To      string `json:"to"`
+	Imports int    `json:"imports"` // archivos de From que importan To
+}
+
+// LayerViolation es un import de una capa inferior hacia una superior
+type LayerViolation struct {
+	From      string `json:"from"`
+	FromLayer string `json:"from_layer"`
+	To        string `json:"to"`
+	ToLayer   string `json:"to_layer"`
+}
+
+func (v LayerViolation) String() string {
+	return fmt.Sprintf("Layer violation: %s (%s) imports %s (%s)", v.From, v.FromLayer, v.To, v.ToLayer)
+}
+
+// ImportGraph es el grafo de imports internos de un árbol, con el acoplamiento por paquete
+type ImportGraph struct {
+	Root       string            `json:"root"`
+	Files      int               `json:"files"`
+	Packages   []PackageCoupling `json:"packages"`
+	Edges      []ImportEdge      `json:"edges"`
+	Cycles     [][]string        `json:"cycles"` // cada ciclo empieza y acaba en el mismo paquete
+	Violations []LayerViolation  `json:"layer_violations"`
+}
+
+// architectureLayers ordena las capas de más externa a más interna: una capa solo debería
+// importar las que tiene debajo. Se reconocen por el nombre de los directorios.
+var architectureLayers = []struct {
+	name string
+	dirs []string
+}{
+	{"presentation", []string{"cmd", "cli", "ui", "views", "components", "pages", "screens", "handlers", "controllers", "routes", "api"}},
+	{"application", []string{"services", "service", "usecases", "usecase", "core", "application", "app"}},
+	{"data", []string{"repository", "repositories", "store", "stores", "storage", "db", "database", "dal", "dao", "persistence", "infra", "infrastructure", "adapters"}},
+	{"domain", []string{"models", "model", "domain", "entities", "entity", "types"}},
+	{"shared", []string{"utils", "util", "lib", "libs", "helpers", "common", "shared", "pkg"}},
+}
+
+// packageLayer devuelve la capa del paquete según su directorio más profundo reconocible
+func packageLayer(name string) (string, int) {
+	segments := strings.Split(name, "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		for rank, layer := range architectureLayers {
+			if containsString(layer.dirs, strings.ToLower(segments[i])) {
+				return layer.name, rank
+			}
+		}
+	}
+	return "", -1
+}
+
+// importGraphBuilder acumula los imports de cada archivo durante el recorrido del árbol
+type importGraphBuilder struct {
+	root    string
+	files   map[string]string   // archivo -> lenguaje
+	imports map[string][]string // archivo -> imports sin resolver
+	modules map[string]string   // directorio -> módulo de Go de su go.mod más cercano
+}
+
+func newImportGraphBuilder(root string) *importGraphBuilder {
+	return &importGraphBuilder{
+		root:    root,
+		files:   make(map[string]string),
+		imports: make(map[string][]string),
+		modules: make(map[string]string),
+	}
+}
+
+// add registra los imports de un archivo de Go, JavaScript o TypeScript; ignora los tests
+func (b *importGraphBuilder) add(file SourceFile, content []byte) {
+	if IsTestFile(file.Path) {
+		return
+	}
+	switch file.Language {
+	case "Go":
+		parsed, err := parser.ParseFile(token.NewFileSet(), file.Path, content, parser.ImportsOnly)
+		if err != nil {
+			return
+		}
+		var imports []string
+		for _, spec := range parsed.Imports {
+			if importPath, err := strconv.Unquote(spec.Path.Value); err == nil {
+				imports = append(imports, importPath)
+			}
+		}
+		b.files[file.Path] = file.Language
+		b.imports[file.Path] = imports
+	case "JavaScript", "TypeScript":
+		b.files[file.Path] = file.Language
+		b.imports[file.Path] = scriptImports(content)
+	}
+}
+
+// scriptImports extrae los módulos de import ... from, import "x", export ... from, require("x")
+// e import("x")
+func scriptImports(content []byte) []string {
+	tokens := significantTokens(tokenize(content, scriptSyntax))
+	var imports []string
+	for i := 0; i+1 < len(tokens); i++ {
+		token := tokens[i]
+		if token.kind != tokenIdent {
+			continue
+		}
+		next := tokens[i+1]
+		switch {
+		case (token.text == "from" || token.text == "import") && next.kind == tokenString:
+			imports = append(imports, strings.Trim(next.text, "'\"`"))
+		case (token.text == "require" || token.text == "import") && next.text == "(" &&
+			i+3 < len(tokens) && tokens[i+2].kind == tokenString && tokens[i+3].text == ")":
+			imports = append(imports, strings.Trim(tokens[i+2].text, "'\"`"))
+		}
+	}
+	return imports
+}
+
+// goModule devuelve el módulo y el directorio del go.mod más cercano a dir
+func (b *importGraphBuilder) goModule(dir string) (string, string) {
+	for current := dir; ; current = path.Dir(current) {
+		if module, ok := b.modules[current]; ok {
+			if module == "" {
+				if current == "." {
+					return "", ""
+				}
+				continue
+			}
+			return module, current
+		}
+		b.modules[current] = readModulePath(filepath.Join(b.root, filepath.FromSlash(current), "go.mod"))
+		if b.modules[current] != "" {
+			return b.modules[current], current
+		}
+		if current == "." {
+			return "", ""
+		}
+	}
+}
+
+// readModulePath lee la directiva module de un go.mod
+func readModulePath(file string) string {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return ""
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`)
+		}
+	}
+	return ""
+}
+
+// scriptExtensions son las extensiones que se prueban al resolver un import relativo
+var scriptExtensions = []string{".ts", ".tsx", ".js", ".jsx", ".mjs", ".cjs", ".mts", ".cts"}
+
+// resolve devuelve el paquete al que apunta un import de from, o "" si es externo
+func (b *importGraphBuilder) resolve(from, spec string, packages map[string]bool) string {
+	dir := path.Dir(from)
+	if b.files[from] == "Go" {
+		module, moduleDir := b.goModule(dir)
+		if module == "" || (spec != module && !strings.HasPrefix(spec, module+"/")) {
+			return ""
+		}
+		target := path.Join(moduleDir, strings.TrimPrefix(strings.TrimPrefix(spec, module), "/"))
+		if packages[target] {
+			return target
+		}
+		return ""
+	}
+
+	// Alias habituales de bundlers: @/x y ~/x apuntan a src/
+	switch {
+	case strings.HasPrefix(spec, "."):
+		spec = path.Join(dir, spec)
+	case strings.HasPrefix(spec, "@/") || strings.HasPrefix(spec, "~/"):
+		spec = path.Join("src", spec[2:])
+	default:
+		return "" // paquete de node_modules
+	}
+	// En TypeScript se importa ./x.js para referirse a ./x.ts
+	candidates := []string{spec, strings.TrimSuffix(spec, path.Ext(spec))}
+	for _, candidate := range candidates {
+		if _, ok := b.files[candidate]; ok {
+			return path.Dir(candidate)
+		}
+		for _, ext := range scriptExtensions {
+			if _, ok := b.files[candidate+ext]; ok {
+				return path.Dir(candidate)
+			}
+			if _, ok := b.files[candidate+"/index"+ext]; ok {
+				return candidate
+			}
+		}
+	}
+	return ""
+}
+
+// build resuelve los imports y calcula el acoplamiento, los ciclos y las violaciones de capa
+func (b *importGraphBuilder) build() *ImportGraph {
+	graph := &ImportGraph{Root: b.root, Packages: []PackageCoupling{}, Edges: []ImportEdge{}, Cycles: [][]string{}, Violations: []LayerViolation{}}
+	nodes := make(map[string]*PackageCoupling)
+	languages := make(map[string]map[string]int)
+	for file, language := range b.files {
+		dir := path.Dir(file)
+		if nodes[dir] == nil {
+			nodes[dir] = &PackageCoupling{Name: dir}
+			languages[dir] = make(map[string]int)
+		}
+		nodes[dir].Files++
+		languages[dir][language]++
+		graph.Files++
+	}
+	packages := make(map[string]bool, len(nodes))
+	for name, node := range nodes {
+		packages[name] = true
+		node.Language = primaryLanguage(languages[name])
+		node.Layer, _ = packageLayer(name)
+	}
+
+	edges := make(map[[2]string]int)
+	for _, file := range sortedKeys(b.imports) {
+		from := path.Dir(file)
+		seen := make(map[string]bool)
+		for _, spec := range b.imports[file] {
+			to := b.resolve(file, spec, packages)
+			if to == "" || to == from || seen[to] {
+				continue
+			}
+			seen[to] = true
+			edges[[2]string{from, to}]++
+		}
+	}
+
+	adjacency := make(map[string][]string)
+	for edge, count := range edges {
+		graph.Edges = append(graph.Edges, ImportEdge{From: edge[0], To: edge[1], Imports: count})
+		adjacency[edge[0]] = append(adjacency[edge[0]], edge[1])
+		nodes[edge[0]].Efferent++
+		nodes[edge[1]].Afferent++
+
+		fromLayer, fromRank := packageLayer(edge[0])
+		toLayer, toRank := packageLayer(edge[1])
+		if fromRank > toRank && toRank >= 0 {
+			graph.Violations = append(graph.Violations, LayerViolation{From: edge[0], FromLayer: fromLayer, To: edge[1], ToLayer: toLayer})
+		}
+	}
+	sort.Slice(graph.Edges, func(i, j int) bool {
+		if graph.Edges[i].From != graph.Edges[j].From {
+			return graph.Edges[i].From < graph.Edges[j].From
+		}
+		return graph.Edges[i].To < graph.Edges[j].To
+	})
+	sort.Slice(graph.Violations, func(i, j int) bool {
+		return graph.Violations[i].From+graph.Violations[i].To < graph.Violations[j].From+graph.Violations[j].To
+	})
+
+	for _, name := range sortedKeys(nodes) {
+		node := nodes[name]
+		if total := node.Afferent + node.Efferent; total > 0 {
+			node.Instability = round2(float64(node.Efferent) / float64(total))
+		}
+		graph.Packages = append(graph.Packages, *node)
+		sort.Strings(adjacency[name])
+	}
+	graph.Cycles = importCycles(sortedKeys(nodes), adjacency)
+	return graph
+}
+
+func round2(value float64) float64 {
+	return math.Round(value*100) / 100
+}
+
+// importCycles busca las componentes fuertemente conexas (Tarjan) y devuelve un ciclo de cada una
+func importCycles(names []string, adjacency map[string][]string) [][]string {
+	index := make(map[string]int)
+	lowlink := make(map[string]int)
+	onStack := make(map[string]bool)
+	var stack []string
+	var components [][]string
+
+	var connect func(string)
+	connect = func(node string) {
+		index[node] = len(index)
+		lowlink[node] = index[node]
+		stack = append(stack, node)
+		onStack[node] = true
+		for _, next := range adjacency[node] {
+			if _, visited := index[next]; !visited {
+				connect(next)
+				lowlink[node] = min(lowlink[node], lowlink[next])
+			} else if onStack[next] {
+				lowlink[node] = min(lowlink[node], index[next])
+			}
+		}
+		if lowlink[node] != index[node] {
+			return
+		}
+		var component []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == node {
+				break
+			}
+		}
+		if len(component) > 1 {
+			components = append(components, component)
+		}
+	}
+	for _, name := range names {
+		if _, visited := index[name]; !visited {
+			connect(name)
+		}
+	}
+
+	cycles := [][]string{}
+	for _, component := range components {
+		sort.Strings(component)
+		cycles = append(cycles, cycleWithin(component, adjacency))
+	}
+	sort.Slice(cycles, func(i, j int) bool { return cycles[i][0] < cycles[j][0] })
+	return cycles
+}
+
+// cycleWithin devuelve el camino más corto que sale del primer paquete de la componente y vuelve
+// a él (búsqueda en anchura)
+func cycleWithin(component []string, adjacency map[string][]string) []string {
+	start := component[0]
+	members := make(map[string]bool, len(component))
+	for _, name := range component {
+		members[name] = true
+	}
+	parent := map[string]string{}
+	queue := []string{start}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, next := range adjacency[node] {
+			if !members[next] {
+				continue
+			}
+			if next == start {
+				cycle := []string{start}
+				for current := node; current != start; current = parent[current] {
+					cycle = append(cycle, current)
+				}
+				cycle = append(cycle, start)
+				// El camino se ha reconstruido al revés
+				for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+					cycle[i], cycle[j] = cycle[j], cycle[i]
+				}
+				return cycle
+			}
+			if _, seen := parent[next]; !seen {
+				parent[next] = node
+				queue = append(queue, next)
+			}
+		}
+	}
+	return append(component, start)
+}
+
+// BuildImportGraph recorre root y construye el grafo de imports de Go, JavaScript y TypeScript
+func BuildImportGraph(ctx context.Context, root string, limits LocalLimits) (*ImportGraph, error) {
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	if info, err := os.Stat(abs); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("cannot analyze %s: not a directory", root)
+	}
+
+	builder := newImportGraphBuilder(abs)
+	if _, err := WalkSourceFiles(ctx, abs, limits, func(file SourceFile) error {
+		if file.Language != "Go" && file.Language != "JavaScript" && file.Language != "TypeScript" {
+			return nil
+		}
+		content, err := os.ReadFile(file.AbsPath)
+		if err != nil {
+			return err
+		}
+		builder.add(file, content)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to walk %s: %w", root, err)
+	}
+	graph := builder.build()
+	if graph.Files == 0 {
+		return nil, fmt.Errorf("no Go, JavaScript or TypeScript sources found in %s", root)
+	}
+	return graph, nil
+}
+
+// Coupling resume el acoplamiento del proyecto: el mayor acoplamiento aferente y eferente de un
+// paquete y la estabilidad media (1 - inestabilidad) de los paquetes conectados
+func (g *ImportGraph) Coupling() models.CouplingMetrics {
+	var coupling models.CouplingMetrics
+	instability, connected := 0.0, 0
+	for _, pkg := range g.Packages {
+		coupling.Afferent = max(coupling.Afferent, pkg.Afferent)
+		coupling.Efferent = max(coupling.Efferent, pkg.Efferent)
+		if pkg.Afferent+pkg.Efferent > 0 {
+			instability += pkg.Instability
+			connected++
+		}
+	}
+	if connected > 0 {
+		coupling.Stability = round2(1 - instability/float64(connected))
+	}
+	return coupling
+}
+
+// ViolationMessages describe los ciclos y las violaciones de capa para ArchitectureScore.Violations
+func (g *ImportGraph) ViolationMessages() []string {
+	var messages []string
+	for _, cycle := range g.Cycles {
+		messages = append(messages, "Import cycle: "+strings.Join(cycle, " → "))
+	}
+	for _, violation := range g.Violations {
+		messages = append(messages, violation.String())
+	}
+	return messages
+}
+
+// applyImportGraph completa la puntuación de arquitectura con el grafo: acoplamiento, ciclos y
+// violaciones de capa. Cada ciclo resta 10 puntos (hasta 30) y cada violación 4 (hasta 20).
+func applyImportGraph(architecture *models.ArchitectureScore, graph *ImportGraph) {
+	if graph == nil || len(graph.Packages) == 0 {
+		return
+	}
+	architecture.Coupling = graph.Coupling()
+	architecture.Violations = append(architecture.Violations, graph.ViolationMessages()...)
+
+	layers := make(map[string]bool)
+	for _, pkg := range graph.Packages {
+		if pkg.Layer != "" {
+			layers[pkg.Layer] = true
+		}
+	}
+	if len(layers) >= 3 && len(graph.Violations) == 0 {
+		architecture.Patterns = append(architecture.Patterns, "Layered architecture")
+		architecture.Score += 10
+	}
+	architecture.Score -= math.Min(30, float64(len(graph.Cycles))*10)
+	architecture.Score -= math.Min(20, float64(len(graph.Violations))*4)
+	architecture.Score = round1(math.Max(0, math.Min(100, architecture.Score)))
+}
+
+// --- Exportación ---
+
+// DOT exporta el grafo para Graphviz. Los ciclos se marcan en rojo y las violaciones de capa
+// en naranja discontinuo.
+func (g *ImportGraph) DOT() string {
+	cycleEdges, violationEdges := g.highlightedEdges()
+	var out strings.Builder
+	out.WriteString("digraph imports {\n")
+	out.WriteString("  rankdir=LR;\n")
+	out.WriteString("  node [shape=box, style=rounded, fontname=\"Helvetica\"];\n")
+	for _, pkg := range g.Packages {
+		label := fmt.Sprintf("%s\\nCa=%d Ce=%d I=%.2f", pkg.Name, pkg.Afferent, pkg.Efferent, pkg.Instability)
+		if pkg.Layer != "" {
+			label += "\\n[" + pkg.Layer + "]"
+		}
+		fmt.Fprintf(&out, "  %s [label=%s];\n", strconv.Quote(pkg.Name), strconv.Quote(label))
+	}
+	for _, edge := range g.Edges {
+		attributes := ""
+		switch key := [2]string{edge.From, edge.To}; {
+		case cycleEdges[key]:
+			attributes = " [color=red, penwidth=2]"
+		case violationEdges[key]:
+			attributes = " [color=orange, style=dashed]"
+		}
+		fmt.Fprintf(&out, "  %s -> %s%s;\n", strconv.Quote(edge.From), strconv.Quote(edge.To), attributes)
+	}
+	out.WriteString("}\n")
+	// strconv.Quote escapa \n como \\n: Graphviz necesita \n literal en las etiquetas
+	return strings.ReplaceAll(out.String(), `\\n`, `\n`)
+}
+
+// Mermaid exporta el grafo como diagrama de flujo de Mermaid
+func (g *ImportGraph) Mermaid() string {
+	cycleEdges, violationEdges := g.highlightedEdges()
+	ids := make(map[string]string, len(g.Packages))
+	var out strings.Builder
+	out.WriteString("graph LR\n")
+	for i, pkg := range g.Packages {
+		ids[pkg.Name] = fmt.Sprintf("p%d", i)
+		label := strings.ReplaceAll(pkg.Name, `"`, "#quot;")
+		fmt.Fprintf(&out, "  %s[\"%s<br/>Ca=%d Ce=%d I=%.2f\"]\n", ids[pkg.Name], label, pkg.Afferent, pkg.Efferent, pkg.Instability)
+	}
+	var cycleLinks, violationLinks []string
+	for i, edge := range g.Edges {
+		fmt.Fprintf(&out, "  %s --> %s\n", ids[edge.From], ids[edge.To])
+		switch key := [2]string{edge.From, edge.To}; {
+		case cycleEdges[key]:
+			cycleLinks = append(cycleLinks, strconv.Itoa(i))
+		case violationEdges[key]:
+			violationLinks = append(violationLinks, strconv.Itoa(i))
+		}
+	}
+	if len(cycleLinks) > 0 {
+		fmt.Fprintf(&out, "  linkStyle %s stroke:red,stroke-width:2px\n", strings.Join(cycleLinks, ","))
+	}
+	if len(violationLinks) > 0 {
+		fmt.Fprintf(&out, "  linkStyle %s stroke:orange,stroke-dasharray:5\n", strings.Join(violationLinks, ","))
+	}
+	return out.String()
+}
+
+// highlightedEdges indexa las aristas que forman ciclos y las que violan las capas
+func (g *ImportGraph) highlightedEdges() (map[[2]string]bool, map[[2]string]bool) {
+	cycles := make(map[[2]string]bool)
+	for _, cycle := range g.Cycles {
+		for i := 0; i+1 < len(cycle); i++ {
+			cycles[[2]string{cycle[i], cycle[i+1]}] = true
+		}
+	}
+	violations := make(map[[2]string]bool)
+	for _, violation := range g.Violations {
+		violations[[2]string{violation.From, violation.To}] = true
+	}
+	return cycles, violations
+}
+
+// GraphFormatForFile elige el formato de exportación por la extensión: .dot/.gv o .mmd/.mermaid/.md
+func GraphFormatForFile(file string) (string, error) {
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".dot", ".gv":
+		return "dot", nil
+	case ".mmd", ".mermaid", ".md":
+		return "mermaid", nil
+	}
+	return "", fmt.Errorf("unknown graph format for %s: use .dot, .gv, .mmd or .md", file)
+}
+
+// WriteGraph exporta el grafo a file en el formato de su extensión; en .md va en un bloque mermaid
+func (g *ImportGraph) WriteGraph(file string) error {
+	format, err := GraphFormatForFile(file)
+	if err != nil {
+		return err
+	}
+	content := g.DOT()
+	if format == "mermaid" {
+		content = g.Mermaid()
+		if strings.EqualFold(filepath.Ext(file), ".md") {
+			content = "```mermaid\n" + content + "```\n"
+		}
+	}
+	return os.WriteFile(file, []byte(content), 0644)
+}
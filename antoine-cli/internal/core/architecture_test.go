@@ -0,0 +1,112 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBuildImportGraphFixture(t *testing.T) {
+	graph, err := BuildImportGraph(context.Background(), filepath.Join("testdata", "architecture"), LocalLimits{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("edges", func(t *testing.T) {
+		// fmt, react y el otro módulo no son internos; main.js y app.ts llegan a src/lib por
+		// un alias y por ./lib/format.js
+		want := []ImportEdge{
+			{"cmd/shop", "internal/core", 1},
+			{"internal/core", "internal/models", 1},
+			{"internal/core", "internal/storage", 1},
+			{"internal/storage", "internal/core", 1},
+			{"internal/utils", "internal/models", 1},
+			{"src", "src/lib", 2},
+		}
+		if fmt.Sprint(graph.Edges) != fmt.Sprint(want) {
+			t.Errorf("edges = %v\nwant    %v", graph.Edges, want)
+		}
+	})
+
+	t.Run("coupling", func(t *testing.T) {
+		want := map[string][3]float64{ // Ca, Ce, I
+			"cmd/shop":         {0, 1, 1},
+			"internal/core":    {2, 2, 0.5},
+			"internal/models":  {2, 0, 0},
+			"internal/storage": {1, 1, 0.5},
+			"internal/utils":   {0, 1, 1},
+			"src":              {0, 1, 1},
+			"src/lib":          {1, 0, 0},
+			"tools/gen":        {0, 0, 0},
+		}
+		if len(graph.Packages) != len(want) {
+			t.Fatalf("packages = %+v, want %d", graph.Packages, len(want))
+		}
+		for _, pkg := range graph.Packages {
+			got := [3]float64{float64(pkg.Afferent), float64(pkg.Efferent), pkg.Instability}
+			if got != want[pkg.Name] {
+				t.Errorf("%s: Ca, Ce, I = %v, want %v", pkg.Name, got, want[pkg.Name])
+			}
+		}
+		if coupling := graph.Coupling(); coupling.Afferent != 2 || coupling.Efferent != 2 {
+			t.Errorf("coupling = %+v, want max Ca 2 and Ce 2", coupling)
+		}
+	})
+
+	t.Run("cycles", func(t *testing.T) {
+		want := [][]string{{"internal/core", "internal/storage", "internal/core"}}
+		if fmt.Sprint(graph.Cycles) != fmt.Sprint(want) {
+			t.Errorf("cycles = %v, want %v", graph.Cycles, want)
+		}
+	})
+
+	t.Run("layer violations", func(t *testing.T) {
+		want := []LayerViolation{
+			{From: "internal/storage", FromLayer: "data", To: "internal/core", ToLayer: "application"},
+			{From: "internal/utils", FromLayer: "shared", To: "internal/models", ToLayer: "domain"},
+		}
+		if fmt.Sprint(graph.Violations) != fmt.Sprint(want) {
+			t.Errorf("violations = %v\nwant         %v", graph.Violations, want)
+		}
+	})
+
+	t.Run("export", func(t *testing.T) {
+		for name, content := range map[string]string{"architecture.dot": graph.DOT(), "architecture.mmd": graph.Mermaid()} {
+			golden := filepath.Join("testdata", name)
+			if *updateGolden {
+				if err := os.WriteFile(golden, []byte(content), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal([]byte(content), want) {
+				t.Errorf("%s differs from the golden file (run with -update after checking the change):\n%s", name, content)
+			}
+		}
+	})
+}
+
+func TestPackageLayer(t *testing.T) {
+	tests := []struct {
+		name  string
+		layer string
+	}{
+		{"cmd/shop", "presentation"},
+		{"internal/core", "application"},
+		{"internal/storage/sql", "data"},
+		{"pkg/models", "domain"}, // manda el directorio más profundo
+		{"Internal/Utils", "shared"},
+		{"tools/gen", ""},
+	}
+	for _, tt := range tests {
+		if got, _ := packageLayer(tt.name); got != tt.layer {
+			t.Errorf("packageLayer(%q) = %q, want %q", tt.name, got, tt.layer)
+		}
+	}
+}
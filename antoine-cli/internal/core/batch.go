@@ -0,0 +1,431 @@
+package core
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/utils"
+)
+
+// Estados de cada repositorio dentro de un lote
+const (
+	BatchPending   = "pending"
+	BatchRunning   = "running"
+	BatchCompleted = "completed"
+	BatchFailed    = "failed"
+)
+
+// batchStateFile guarda el progreso del lote dentro del directorio de salida
+const batchStateFile = "batch-state.json"
+
+// BatchTarget es un repositorio a analizar dentro de un lote
+type BatchTarget struct {
+	URL  string `json:"url"`
+	Name string `json:"name"`
+}
+
+// BatchEntry es el estado de un repositorio del lote
+type BatchEntry struct {
+	Key        string    `json:"key"` // posición en el lote + hash de la URL; único aunque dos repos se llamen igual
+	URL        string    `json:"url"`
+	Name       string    `json:"name"`
+	Status     string    `json:"status"`
+	Score      float64   `json:"score"`
+	HasScore   bool      `json:"has_score"`
+	Insights   int       `json:"insights"`
+	ResultFile string    `json:"result_file,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	Duration   string    `json:"duration,omitempty"`
+	FinishedAt time.Time `json:"finished_at,omitempty"`
+}
+
+// BatchState es el estado persistido que permite reanudar un lote interrumpido
+type BatchState struct {
+	Source    string                 `json:"source"`
+	Options   models.AnalysisOptions `json:"options"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+	Entries   []*BatchEntry          `json:"entries"`
+}
+
+// BatchProgressFunc recibe las actualizaciones de progreso de cada repositorio (0-1)
+type BatchProgressFunc func(entry *BatchEntry, progress float64)
+
+// BatchRunner analiza una lista de repositorios con concurrencia limitada
+type BatchRunner struct {
+	client      *AntoineClient
+	outputDir   string
+	concurrency int
+	state       BatchState
+	mu          sync.Mutex
+}
+
+// ParseBatchFile lee la lista de repositorios de un archivo de texto (una URL por
+// línea), CSV (columna url/repo/repository o la primera columna) o JSON (lista de
+// URLs o de objetos con campo url)
+func ParseBatchFile(path string) ([]BatchTarget, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	var urls []string
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		urls, err = parseBatchJSON(data)
+	case ".csv":
+		urls, err = parseBatchCSV(data)
+	default:
+		urls = parseBatchLines(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	seen := make(map[string]bool)
+	var targets []BatchTarget
+	for _, raw := range urls {
+		repoURL := normalizeRepoURL(raw)
+		if repoURL == "" || seen[repoURL] {
+			continue
+		}
+		seen[repoURL] = true
+		targets = append(targets, BatchTarget{URL: repoURL, Name: RepoName(repoURL)})
+	}
+
+	if len(targets) == 0 {
+		return nil, fmt.Errorf("no repositories found in %s", path)
+	}
+	return targets, nil
+}
+
+func parseBatchLines(data []byte) []string {
+	var urls []string
+	for _, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		urls = append(urls, strings.Fields(line)[0])
+	}
+	return urls
+}
+
+func parseBatchCSV(data []byte) ([]string, error) {
+	reader := csv.NewReader(strings.NewReader(string(data)))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	// Buscar la columna de URL en la cabecera; si no hay cabecera se usa la primera columna
+	column, start := 0, 0
+	for i, header := range records[0] {
+		switch strings.ToLower(strings.TrimSpace(header)) {
+		case "url", "repo", "repository", "repo_url", "repository_url":
+			column, start = i, 1
+		}
+	}
+
+	var urls []string
+	for _, record := range records[start:] {
+		if column < len(record) {
+			urls = append(urls, record[column])
+		}
+	}
+	return urls, nil
+}
+
+func parseBatchJSON(data []byte) ([]string, error) {
+	var urls []string
+	if err := json.Unmarshal(data, &urls); err == nil {
+		return urls, nil
+	}
+
+	var objects []map[string]interface{}
+	if err := json.Unmarshal(data, &objects); err != nil {
+		return nil, fmt.Errorf("expected a list of URLs or objects with a url field")
+	}
+
+	for _, object := range objects {
+		for _, key := range []string{"url", "repo", "repository"} {
+			if value, ok := object[key].(string); ok {
+				urls = append(urls, value)
+				break
+			}
+		}
+	}
+	return urls, nil
+}
+
+// normalizeRepoURL acepta URLs completas o la forma corta owner/repo
+func normalizeRepoURL(raw string) string {
+	raw = strings.TrimSpace(strings.Trim(raw, `"'`))
+	if raw == "" {
+		return ""
+	}
+	if !strings.Contains(raw, "://") {
+		if strings.Count(raw, "/") == 1 {
+			raw = "https://github.com/" + raw
+		} else {
+			raw = "https://" + raw
+		}
+	}
+	return strings.TrimSuffix(strings.TrimSuffix(raw, "/"), ".git")
+}
+
+// RepoName devuelve la forma corta owner/repo de una URL de repositorio
+func RepoName(repoURL string) string {
+	parsed, err := url.Parse(repoURL)
+	if err != nil || parsed.Path == "" {
+		return repoURL
+	}
+	return strings.Trim(parsed.Path, "/")
+}
+
+// NewBatchRunner crea un runner que escribe en outputDir. Si el directorio ya
+// contiene un lote anterior, se reanuda desde su estado.
+func NewBatchRunner(client *AntoineClient, outputDir string, concurrency int) (*BatchRunner, error) {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create output directory: %w", err)
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	br := &BatchRunner{
+		client:      client,
+		outputDir:   outputDir,
+		concurrency: concurrency,
+	}
+	if _, err := readJSONFile(br.statePath(), &br.state); err != nil {
+		return nil, err
+	}
+
+	return br, nil
+}
+
+// Prepare fusiona los repositorios pedidos con el estado guardado y devuelve las
+// entradas del lote. Los análisis completados no se repiten.
+func (br *BatchRunner) Prepare(source string, targets []BatchTarget, options models.AnalysisOptions) []*BatchEntry {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+
+	existing := make(map[string]*BatchEntry)
+	for _, entry := range br.state.Entries {
+		existing[entry.URL] = entry
+	}
+
+	if br.state.CreatedAt.IsZero() {
+		br.state.CreatedAt = time.Now()
+	}
+	br.state.Source = source
+	br.state.Options = options
+
+	entries := make([]*BatchEntry, 0, len(targets))
+	for i, target := range targets {
+		entry, ok := existing[target.URL]
+		if !ok {
+			entry = &BatchEntry{URL: target.URL, Name: target.Name}
+		}
+		entry.Key = batchEntryKey(i, target.URL)
+		// Lo que quedó a medias o falló se vuelve a intentar
+		if entry.Status != BatchCompleted {
+			entry.Status = BatchPending
+			entry.Error = ""
+		}
+		entries = append(entries, entry)
+	}
+	br.state.Entries = entries
+
+	return entries
+}
+
+// Run analiza las entradas pendientes. Se puede interrumpir cancelando ctx y
+// volver a llamar más tarde con el mismo directorio de salida.
+func (br *BatchRunner) Run(ctx context.Context, onProgress BatchProgressFunc) error {
+	br.mu.Lock()
+	entries := br.state.Entries
+	options := br.state.Options
+	br.mu.Unlock()
+
+	if err := br.save(); err != nil {
+		return err
+	}
+
+	queue := make(chan *BatchEntry)
+	var wg sync.WaitGroup
+
+	for i := 0; i < br.concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for entry := range queue {
+				br.analyze(ctx, entry, options, onProgress)
+			}
+		}()
+	}
+
+	for _, entry := range entries {
+		if entry.Status == BatchCompleted {
+			br.notify(onProgress, entry, 1)
+			continue
+		}
+
+		select {
+		case queue <- entry:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	if err := br.save(); err != nil {
+		return err
+	}
+	if err := writeJSONFile(filepath.Join(br.outputDir, "ranking.json"), br.Ranking()); err != nil {
+		return err
+	}
+
+	return ctx.Err()
+}
+
+// analyze ejecuta el análisis de un repositorio y guarda su archivo de resultado
+func (br *BatchRunner) analyze(ctx context.Context, entry *BatchEntry, options models.AnalysisOptions, onProgress BatchProgressFunc) {
+	if ctx.Err() != nil {
+		return
+	}
+
+	br.update(entry, func() { entry.Status = BatchRunning })
+	br.notify(onProgress, entry, 0.1)
+
+	start := time.Now()
+	result, err := br.client.AnalyzeRepository(ctx, entry.URL, &options)
+
+	// Una interrupción deja la entrada pendiente para la próxima ejecución
+	if ctx.Err() != nil {
+		br.update(entry, func() { entry.Status = BatchPending })
+		return
+	}
+
+	if err != nil {
+		br.update(entry, func() {
+			entry.Status = BatchFailed
+			entry.Error = err.Error()
+			entry.FinishedAt = time.Now()
+		})
+		br.notify(onProgress, entry, 1)
+		return
+	}
+
+	resultFile := filepath.Join(br.outputDir, entry.Key+"-"+utils.SlugifyString(entry.Name)+".json")
+	if err := writeJSONFile(resultFile, result); err != nil {
+		utils.WithError(err).Warn("Failed to write batch result file")
+	}
+
+	score, hasScore := ResultScore(result)
+	br.update(entry, func() {
+		entry.Status = BatchCompleted
+		entry.Score = score
+		entry.HasScore = hasScore
+		entry.Insights = len(result.Insights)
+		entry.ResultFile = resultFile
+		entry.Duration = time.Since(start).Round(time.Millisecond).String()
+		entry.FinishedAt = time.Now()
+	})
+	br.notify(onProgress, entry, 1)
+}
+
+// batchEntryKey identifica una entrada por su posición y por la URL completa: owner/repo y
+// forks con el mismo nombre no comparten archivo de resultado ni barra de progreso
+func batchEntryKey(index int, repoURL string) string {
+	sum := sha256.Sum256([]byte(repoURL))
+	return fmt.Sprintf("%03d-%s", index+1, hex.EncodeToString(sum[:4]))
+}
+
+// notify pasa al callback una copia de la entrada tomada bajo el lock
+func (br *BatchRunner) notify(onProgress BatchProgressFunc, entry *BatchEntry, progress float64) {
+	if onProgress == nil {
+		return
+	}
+
+	br.mu.Lock()
+	snapshot := *entry
+	br.mu.Unlock()
+
+	onProgress(&snapshot, progress)
+}
+
+// update modifica una entrada bajo el lock y persiste el estado
+func (br *BatchRunner) update(entry *BatchEntry, change func()) {
+	br.mu.Lock()
+	change()
+	br.mu.Unlock()
+
+	if err := br.save(); err != nil {
+		utils.WithError(err).Debug("Failed to save batch state")
+	}
+}
+
+// Ranking devuelve las entradas ordenadas por puntuación (las fallidas al final)
+func (br *BatchRunner) Ranking() []*BatchEntry {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+
+	ranking := make([]*BatchEntry, len(br.state.Entries))
+	copy(ranking, br.state.Entries)
+
+	sort.SliceStable(ranking, func(i, j int) bool {
+		a, b := ranking[i], ranking[j]
+		if (a.Status == BatchCompleted) != (b.Status == BatchCompleted) {
+			return a.Status == BatchCompleted
+		}
+		if a.HasScore != b.HasScore {
+			return a.HasScore
+		}
+		if a.Score != b.Score {
+			return a.Score > b.Score
+		}
+		return a.Insights > b.Insights
+	})
+
+	return ranking
+}
+
+// OutputDir devuelve el directorio donde se escriben los resultados
+func (br *BatchRunner) OutputDir() string {
+	return br.outputDir
+}
+
+func (br *BatchRunner) save() error {
+	br.mu.Lock()
+	defer br.mu.Unlock()
+
+	br.state.UpdatedAt = time.Now()
+	return writeJSONFile(br.statePath(), br.state)
+}
+
+func (br *BatchRunner) statePath() string {
+	return filepath.Join(br.outputDir, batchStateFile)
+}
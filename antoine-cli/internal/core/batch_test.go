@@ -0,0 +1,39 @@
+package core
+
+import (
+	"testing"
+
+	"antoine-cli/internal/models"
+)
+
+func TestBatchEntryKeysAreUnique(t *testing.T) {
+	tests := []struct {
+		name string
+		urls []string
+	}{
+		{"slug collision", []string{"https://github.com/acme/web-app", "https://github.com/acme-web/app"}},
+		{"same repo name", []string{"https://github.com/acme/app", "https://github.com/fork/app"}},
+		{"case only", []string{"https://github.com/Acme/App", "https://github.com/acme/app"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			br, err := NewBatchRunner(nil, t.TempDir(), 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			targets := make([]BatchTarget, len(tt.urls))
+			for i, repoURL := range tt.urls {
+				targets[i] = BatchTarget{URL: repoURL, Name: RepoName(repoURL)}
+			}
+
+			seen := make(map[string]bool)
+			for _, entry := range br.Prepare("test", targets, models.AnalysisOptions{}) {
+				if entry.Key == "" || seen[entry.Key] {
+					t.Errorf("duplicate or empty key %q for %s", entry.Key, entry.URL)
+				}
+				seen[entry.Key] = true
+			}
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"antoine-cli/internal/models"
+)
+
+// boardFile es el antiguo tablero separado; LoadBoard lo migra a bookmarks.json
+const boardFile = "board.json"
+
+// Estados del tablero, en el orden en que avanza una participación
+const (
+	BoardInterested = "interested"
+	BoardRegistered = "registered"
+	BoardBuilding   = "building"
+	BoardSubmitted  = "submitted"
+	BoardJudged     = "judged"
+)
+
+// BoardStatuses son las columnas del tablero en orden
+var BoardStatuses = []string{BoardInterested, BoardRegistered, BoardBuilding, BoardSubmitted, BoardJudged}
+
+// ErrBoardEntryNotFound indica que el hackathon no está en el tablero
+var ErrBoardEntryNotFound = errors.New("hackathon not on the board")
+
+// BoardNote es una nota con fecha sobre una participación
+type BoardNote struct {
+	Text      string    `json:"text"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// BoardMove registra un cambio de estado
+type BoardMove struct {
+	From string    `json:"from"`
+	To   string    `json:"to"`
+	At   time.Time `json:"at"`
+}
+
+// BoardEntry es la participación en un hackathon: un marcador con Status
+type BoardEntry = Bookmark
+
+// Board es la vista de tablero sobre los marcadores de bookmarks.json
+type Board struct {
+	Entries   []*BoardEntry // marcadores con estado
+	bookmarks []*Bookmark
+}
+
+// LoadBoard carga el tablero a partir de los marcadores
+func LoadBoard() (*Board, error) {
+	all, err := LoadBookmarks()
+	if err != nil {
+		return nil, err
+	}
+
+	board := &Board{bookmarks: all}
+	for _, bookmark := range all {
+		if bookmark.Status != "" {
+			board.Entries = append(board.Entries, bookmark)
+		}
+	}
+	return board, nil
+}
+
+// SaveBoard guarda el tablero en bookmarks.json
+func SaveBoard(board *Board) error {
+	return writeBookmarks(board.bookmarks)
+}
+
+// migrateBoardFile pasa el antiguo board.json (indexado por Hackathon.ID) a los marcadores
+func migrateBoardFile() error {
+	path, err := DataPath(boardFile)
+	if err != nil {
+		return err
+	}
+
+	var legacy struct {
+		Entries map[string]*Bookmark `json:"entries"`
+	}
+	found, err := readJSONFile(path, &legacy)
+	if err != nil || !found {
+		return err
+	}
+
+	stored, err := readBookmarks()
+	if err != nil {
+		return err
+	}
+	all := stored.Bookmarks
+	byKey := make(map[string]*Bookmark, len(all))
+	for _, bookmark := range all {
+		byKey[hackathonKey(bookmark.Hackathon)] = bookmark
+	}
+
+	for _, entry := range legacy.Entries {
+		if entry.Hackathon == nil {
+			continue
+		}
+		bookmark, ok := byKey[hackathonKey(entry.Hackathon)]
+		if !ok {
+			entry.BoardOnly = true
+			all = append(all, entry)
+			continue
+		}
+		bookmark.Status, bookmark.Notes, bookmark.Projects = entry.Status, entry.Notes, entry.Projects
+		bookmark.History, bookmark.UpdatedAt = entry.History, entry.UpdatedAt
+	}
+
+	if err := writeBookmarks(all); err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// ParseBoardStatus valida un estado; acepta prefijos únicos (p. ej. "reg")
+func ParseBoardStatus(value string) (string, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+	var matches []string
+	for _, status := range BoardStatuses {
+		if status == value {
+			return status, nil
+		}
+		if value != "" && strings.HasPrefix(status, value) {
+			matches = append(matches, status)
+		}
+	}
+	if len(matches) == 1 {
+		return matches[0], nil
+	}
+	return "", fmt.Errorf("invalid status %q: use one of %s", value, strings.Join(BoardStatuses, ", "))
+}
+
+// Add pone un hackathon en el tablero con el estado indicado (interested si se omite).
+// Si ya estaba, actualiza sus datos y solo cambia de estado si se indica uno.
+func (b *Board) Add(hackathon *models.Hackathon, status string) (*BoardEntry, error) {
+	if hackathon.ID == "" && hackathon.URL == "" && strings.TrimSpace(hackathon.Name) == "" {
+		return nil, fmt.Errorf("hackathon has no ID, URL or name and cannot be tracked on the board")
+	}
+
+	// Sin ID, el marcador se identifica por URL o por nombre
+	key := hackathonKey(hackathon)
+	for _, bookmark := range b.bookmarks {
+		if hackathonKey(bookmark.Hackathon) != key {
+			continue
+		}
+		bookmark.Hackathon = hackathon
+		bookmark.UpdatedAt = time.Now()
+		if bookmark.Status == "" {
+			bookmark.Status = BoardInterested
+			b.Entries = append(b.Entries, bookmark)
+		}
+		if status != "" {
+			bookmark.Move(status)
+		}
+		return bookmark, nil
+	}
+
+	if status == "" {
+		status = BoardInterested
+	}
+	now := time.Now()
+	entry := &BoardEntry{Hackathon: hackathon, Status: status, CreatedAt: now, UpdatedAt: now, BoardOnly: true}
+	b.bookmarks = append(b.bookmarks, entry)
+	b.Entries = append(b.Entries, entry)
+	return entry, nil
+}
+
+// Find busca una entrada por ID de hackathon, URL o nombre (parcial si es único)
+func (b *Board) Find(ref string) (*BoardEntry, error) {
+	hackathons := make([]*models.Hackathon, len(b.Entries))
+	for i, entry := range b.Entries {
+		hackathons[i] = entry.Hackathon
+	}
+
+	hackathon, err := findHackathon(hackathons, ref)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrBoardEntryNotFound, ref)
+	}
+	for _, entry := range b.Entries {
+		if entry.Hackathon == hackathon {
+			return entry, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrBoardEntryNotFound, ref)
+}
+
+// Remove quita un hackathon del tablero. Si estaba marcado con 'bookmark add' el
+// marcador se conserva sin el seguimiento.
+func (b *Board) Remove(entry *BoardEntry) {
+	entries := b.Entries[:0]
+	for _, other := range b.Entries {
+		if other != entry {
+			entries = append(entries, other)
+		}
+	}
+	b.Entries = entries
+
+	if !entry.BoardOnly {
+		entry.Status, entry.Notes, entry.Projects, entry.History = "", nil, nil, nil
+		return
+	}
+	bookmarks := b.bookmarks[:0]
+	for _, other := range b.bookmarks {
+		if other != entry {
+			bookmarks = append(bookmarks, other)
+		}
+	}
+	b.bookmarks = bookmarks
+}
+
+// Column devuelve las entradas de un estado ordenadas por fecha de inicio
+func (b *Board) Column(status string) []*BoardEntry {
+	var entries []*BoardEntry
+	for _, entry := range b.Entries {
+		if entry.Status == status {
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		a, c := entries[i].Hackathon, entries[j].Hackathon
+		if !a.StartDate.Equal(c.StartDate) {
+			return a.StartDate.Before(c.StartDate)
+		}
+		return a.Name < c.Name
+	})
+	return entries
+}
+
+// Move cambia el estado de la entrada y lo registra en el historial
+func (e *BoardEntry) Move(status string) {
+	if e.Status == status {
+		return
+	}
+	now := time.Now()
+	e.History = append(e.History, BoardMove{From: e.Status, To: status, At: now})
+	e.Status = status
+	e.UpdatedAt = now
+}
+
+// Step avanza (delta > 0) o retrocede (delta < 0) la entrada en el tablero.
+// Devuelve false si ya está en el primer o el último estado.
+func (e *BoardEntry) Step(delta int) bool {
+	index := boardStatusIndex(e.Status) + delta
+	if index < 0 || index >= len(BoardStatuses) {
+		return false
+	}
+	e.Move(BoardStatuses[index])
+	return true
+}
+
+// AddNote añade una nota a la participación
+func (e *BoardEntry) AddNote(text string) {
+	now := time.Now()
+	e.Notes = append(e.Notes, BoardNote{Text: text, CreatedAt: now})
+	e.UpdatedAt = now
+}
+
+// LinkProject enlaza un proyecto (URL de repo, demo o archivo) a la participación
+func (e *BoardEntry) LinkProject(link string) bool {
+	for _, existing := range e.Projects {
+		if existing == link {
+			return false
+		}
+	}
+	e.Projects = append(e.Projects, link)
+	e.UpdatedAt = time.Now()
+	return true
+}
+
+func boardStatusIndex(status string) int {
+	for i, s := range BoardStatuses {
+		if s == status {
+			return i
+		}
+	}
+	return 0
+}
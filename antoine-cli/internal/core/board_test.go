@@ -0,0 +1,120 @@
+package core
+
+import (
+	"os"
+	"testing"
+
+	"antoine-cli/internal/models"
+)
+
+func TestBoardUsesBookmarks(t *testing.T) {
+	tests := []struct {
+		name       string
+		hackathon  *models.Hackathon
+		bookmarked bool // ya marcado con 'bookmark add' antes de añadirlo al tablero
+		// tras quitarlo del tablero, ¿sigue el marcador?
+		keptAfterRemove bool
+	}{
+		{"with ID", &models.Hackathon{ID: "h1", Name: "Madrid AI Hack"}, false, false},
+		{"URL only", &models.Hackathon{URL: "https://devpost.com/madrid", Name: "Madrid AI Hack"}, false, false},
+		{"name only", &models.Hackathon{Name: "Madrid AI Hack"}, false, false},
+		{"already bookmarked", &models.Hackathon{ID: "h2", Name: "Berlin Hack"}, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("HOME", t.TempDir())
+			if tt.bookmarked {
+				if _, err := AddBookmark(tt.hackathon, "with friends"); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			board, err := LoadBoard()
+			if err != nil {
+				t.Fatal(err)
+			}
+			entry, err := board.Add(tt.hackathon, BoardRegistered)
+			if err != nil {
+				t.Fatal(err)
+			}
+			entry.AddNote("team formed")
+			if err := SaveBoard(board); err != nil {
+				t.Fatal(err)
+			}
+
+			// El tablero y los marcadores comparten un único registro
+			bookmarks, err := LoadBookmarks()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(bookmarks) != 1 || bookmarks[0].Status != BoardRegistered || len(bookmarks[0].Notes) != 1 {
+				t.Fatalf("bookmarks = %+v, want one registered entry with a note", bookmarks)
+			}
+
+			board, err = LoadBoard()
+			if err != nil {
+				t.Fatal(err)
+			}
+			entry, err = board.Find(tt.hackathon.Name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			board.Remove(entry)
+			if err := SaveBoard(board); err != nil {
+				t.Fatal(err)
+			}
+
+			bookmarks, err = LoadBookmarks()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if kept := len(bookmarks) == 1; kept != tt.keptAfterRemove {
+				t.Fatalf("bookmark kept = %v, want %v", kept, tt.keptAfterRemove)
+			}
+			if tt.keptAfterRemove && (bookmarks[0].Status != "" || bookmarks[0].Note != "with friends") {
+				t.Errorf("bookmark after remove = %+v", bookmarks[0])
+			}
+		})
+	}
+}
+
+func TestBoardRejectsUnidentifiableHackathon(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	board, err := LoadBoard()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := board.Add(&models.Hackathon{}, ""); err == nil {
+		t.Error("Add accepted a hackathon without ID, URL or name")
+	}
+}
+
+func TestLegacyBoardFileIsMigrated(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if _, err := AddBookmark(&models.Hackathon{ID: "h1", Name: "Madrid AI Hack"}, "note"); err != nil {
+		t.Fatal(err)
+	}
+	path, err := DataPath(boardFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	legacy := `{"entries": {
+		"h1": {"hackathon": {"id": "h1", "name": "Madrid AI Hack"}, "status": "building"},
+		"h2": {"hackathon": {"id": "h2", "name": "Paris Hack"}, "status": "judged"}
+	}}`
+	if err := os.WriteFile(path, []byte(legacy), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	board, err := LoadBoard()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(board.Entries) != 2 || len(board.Column(BoardBuilding)) != 1 || len(board.Column(BoardJudged)) != 1 {
+		t.Errorf("migrated board = %+v", board.Entries)
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Error("legacy board.json was not removed")
+	}
+}
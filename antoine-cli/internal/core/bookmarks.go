@@ -0,0 +1,133 @@
+package core
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"antoine-cli/internal/models"
+)
+
+// bookmarksFile guarda los hackathons marcados dentro de ~/.antoine
+const bookmarksFile = "bookmarks.json"
+
+// Bookmark es un hackathon marcado para seguirlo y exportarlo al calendario. Los
+// campos de seguimiento los usa el tablero ('antoine board'); Status vacío = solo marcado.
+type Bookmark struct {
+	Hackathon *models.Hackathon `json:"hackathon"`
+	Note      string            `json:"note,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	Status    string            `json:"status,omitempty"`
+	Notes     []BoardNote       `json:"notes,omitempty"`
+	Projects  []string          `json:"projects,omitempty"` // repos, demos o archivos de proyecto
+	History   []BoardMove       `json:"history,omitempty"`
+	UpdatedAt time.Time         `json:"updated_at,omitempty"`
+	BoardOnly bool              `json:"board_only,omitempty"` // lo creó el tablero, no 'bookmark add'
+}
+
+type bookmarks struct {
+	Bookmarks []*Bookmark `json:"bookmarks"`
+}
+
+// LoadBookmarks devuelve los hackathons marcados ordenados por fecha de inicio
+func LoadBookmarks() ([]*Bookmark, error) {
+	if err := migrateBoardFile(); err != nil {
+		return nil, err
+	}
+
+	stored, err := readBookmarks()
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(stored.Bookmarks, func(i, j int) bool {
+		return stored.Bookmarks[i].Hackathon.StartDate.Before(stored.Bookmarks[j].Hackathon.StartDate)
+	})
+	return stored.Bookmarks, nil
+}
+
+// AddBookmark marca un hackathon; si ya estaba marcado actualiza sus datos y la nota
+func AddBookmark(hackathon *models.Hackathon, note string) (*Bookmark, error) {
+	all, err := LoadBookmarks()
+	if err != nil {
+		return nil, err
+	}
+
+	key := hackathonKey(hackathon)
+	for _, bookmark := range all {
+		if hackathonKey(bookmark.Hackathon) == key {
+			bookmark.Hackathon = hackathon
+			bookmark.BoardOnly = false
+			if note != "" {
+				bookmark.Note = note
+			}
+			return bookmark, writeBookmarks(all)
+		}
+	}
+
+	bookmark := &Bookmark{Hackathon: hackathon, Note: note, CreatedAt: time.Now()}
+	return bookmark, writeBookmarks(append(all, bookmark))
+}
+
+// RemoveBookmark quita la marca de un hackathon
+func RemoveBookmark(hackathon *models.Hackathon) error {
+	all, err := LoadBookmarks()
+	if err != nil {
+		return err
+	}
+
+	key := hackathonKey(hackathon)
+	kept := all[:0]
+	for _, bookmark := range all {
+		if hackathonKey(bookmark.Hackathon) != key {
+			kept = append(kept, bookmark)
+		}
+	}
+	if len(kept) == len(all) {
+		return fmt.Errorf("%s is not bookmarked", hackathon.Name)
+	}
+
+	return writeBookmarks(kept)
+}
+
+// FindBookmark busca un hackathon marcado por ID, URL o nombre, sin depender de la caché
+func FindBookmark(ref string) (*Bookmark, error) {
+	all, err := LoadBookmarks()
+	if err != nil {
+		return nil, err
+	}
+
+	hackathons := make([]*models.Hackathon, len(all))
+	for i, bookmark := range all {
+		hackathons[i] = bookmark.Hackathon
+	}
+
+	hackathon, err := findHackathon(hackathons, ref)
+	if err != nil {
+		return nil, fmt.Errorf("%w in your bookmarks", err)
+	}
+	for _, bookmark := range all {
+		if bookmark.Hackathon == hackathon {
+			return bookmark, nil
+		}
+	}
+	return nil, fmt.Errorf("hackathon %q not found in your bookmarks", ref)
+}
+
+func readBookmarks() (bookmarks, error) {
+	var stored bookmarks
+	path, err := DataPath(bookmarksFile)
+	if err != nil {
+		return stored, err
+	}
+	_, err = readJSONFile(path, &stored)
+	return stored, err
+}
+
+func writeBookmarks(all []*Bookmark) error {
+	path, err := DataPath(bookmarksFile)
+	if err != nil {
+		return err
+	}
+	return writeJSONFile(path, bookmarks{Bookmarks: all})
+}
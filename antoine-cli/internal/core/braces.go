@@ -0,0 +1,383 @@
+package core
+
+import "path/filepath"
+
+// braceFrameKind es lo que abre cada llave
+type braceFrameKind int
+
+const (
+	frameBlock    braceFrameKind = iota
+	frameControl                 // cuerpo de if, for, while, switch/match, catch, else
+	frameFunction                // cuerpo de una función o método
+	frameClass                   // cuerpo de una clase, impl o trait
+	frameType                    // cuerpo de un interface o type de TypeScript
+)
+
+type braceFrame struct {
+	kind     braceFrameKind
+	function int    // índice en functions de los frameFunction
+	name     string // nombre de los frameClass
+	line     int
+	parens   int  // profundidad de paréntesis al abrirse
+	do       bool // cuerpo de do { } while
+	match    bool // cuerpo de switch o match
+	test     bool // función o módulo de test (Rust)
+}
+
+// pendingFunction es una cabecera de función cuyo cuerpo empieza en la siguiente llave
+type pendingFunction struct {
+	name   string
+	line   int
+	parens int
+	test   bool
+}
+
+// braceWalker recorre los tokens de un lenguaje de llaves (JavaScript, TypeScript, Rust)
+// y atribuye cada decisión a la función más interna que la contiene. Las funciones
+// anidadas (callbacks, closures con cuerpo) se cuentan por separado.
+type braceWalker struct {
+	file      SourceFile
+	tokens    []sourceToken
+	controls  map[string]bool
+	switchKey string // switch o match
+
+	frames    []braceFrame
+	functions []FunctionComplexity
+	names     []string // nombre simple de cada función, para reconocer la recursión
+	classes   int
+	tests     [][2]int // rangos de líneas de test
+
+	parens          int
+	function        *pendingFunction
+	control         int // profundidad de paréntesis del control pendiente, -1 si no hay
+	controlDo       bool
+	controlMatch    bool
+	class           *braceFrame // clase o tipo pendiente
+	testBlock       bool
+	elseIf          bool
+	skipWhile       bool
+	lastLogical     string
+	lastFunctionKey int // índice del nombre de la última función pendiente
+}
+
+func newBraceWalker(file SourceFile, tokens []sourceToken, controls map[string]bool, switchKey string) *braceWalker {
+	return &braceWalker{file: file, tokens: tokens, controls: controls, switchKey: switchKey, control: -1, lastFunctionKey: -1}
+}
+
+// text devuelve el texto del token i, o "" fuera de rango
+func (w *braceWalker) text(i int) string {
+	if i < 0 || i >= len(w.tokens) {
+		return ""
+	}
+	return w.tokens[i].text
+}
+
+func (w *braceWalker) isIdent(i int) bool {
+	return i >= 0 && i < len(w.tokens) && w.tokens[i].kind == tokenIdent
+}
+
+// matching devuelve el índice del cierre del grupo que abre el token i ((, [ o <)
+func (w *braceWalker) matching(i int) int {
+	open := w.text(i)
+	closing := map[string]string{"(": ")", "[": "]", "<": ">", "{": "}"}[open]
+	depth := 0
+	for j := i; j < len(w.tokens); j++ {
+		switch w.tokens[j].text {
+		case open:
+			depth++
+		case closing:
+			depth--
+			if depth == 0 {
+				return j
+			}
+		case ">>":
+			if open == "<" {
+				depth -= 2
+				if depth <= 0 {
+					return j
+				}
+			}
+		case ";", "{", "}":
+			if open == "<" { // no era una lista genérica
+				return -1
+			}
+		}
+	}
+	return -1
+}
+
+// matchingBackwards devuelve el índice del ( que abre el ) del token i
+func (w *braceWalker) matchingBackwards(i int) int {
+	depth := 0
+	for j := i; j >= 0; j-- {
+		switch w.tokens[j].text {
+		case ")":
+			depth++
+		case "(":
+			depth--
+			if depth == 0 {
+				return j
+			}
+		}
+	}
+	return -1
+}
+
+// expectFunction anota que la siguiente llave al nivel actual abre el cuerpo de la función
+// nombrada en el token i
+func (w *braceWalker) expectFunction(i int, name string, test bool) {
+	w.function = &pendingFunction{name: name, line: w.tokens[i].line, parens: w.parens, test: test}
+	w.lastFunctionKey = i
+}
+
+// expectClass anota que la siguiente llave abre una clase (o un tipo si kind es frameType)
+func (w *braceWalker) expectClass(name string, kind braceFrameKind) {
+	w.class = &braceFrame{kind: kind, name: name, parens: w.parens}
+}
+
+// addFunction registra una función y devuelve su índice
+func (w *braceWalker) addFunction(name string, line int) int {
+	qualified := name
+	if owner := w.enclosingClass(); owner != "" {
+		qualified = owner + w.separator() + name
+	}
+	w.functions = append(w.functions, FunctionComplexity{
+		Name: qualified, File: filepath.ToSlash(w.file.Path), Line: line, Lines: 1, Cyclomatic: 1,
+	})
+	w.names = append(w.names, name)
+	return len(w.functions) - 1
+}
+
+func (w *braceWalker) separator() string {
+	if w.file.Language == "Rust" {
+		return "::"
+	}
+	return "."
+}
+
+// enclosingClass es el nombre de la clase si la llave más interna es su cuerpo
+func (w *braceWalker) enclosingClass() string {
+	if n := len(w.frames); n > 0 && w.frames[n-1].kind == frameClass {
+		return w.frames[n-1].name
+	}
+	return ""
+}
+
+// inType indica si el token actual está dentro de un interface o type de TypeScript
+func (w *braceWalker) inType() bool {
+	for _, frame := range w.frames {
+		if frame.kind == frameType {
+			return true
+		}
+	}
+	return false
+}
+
+// inTest indica si el token actual está dentro de una función o módulo de test
+func (w *braceWalker) inTest() bool {
+	for _, frame := range w.frames {
+		if frame.test {
+			return true
+		}
+	}
+	return false
+}
+
+// current devuelve la función más interna (-1 si es código de nivel superior) y el
+// anidamiento de estructuras de control dentro de ella
+func (w *braceWalker) current() (int, int) {
+	nesting := 0
+	for i := len(w.frames) - 1; i >= 0; i-- {
+		switch w.frames[i].kind {
+		case frameFunction:
+			return w.frames[i].function, nesting
+		case frameControl:
+			nesting++
+		}
+	}
+	return -1, nesting
+}
+
+// add suma a la complejidad de la función actual; cognitive recibe 1 + anidamiento si nested
+func (w *braceWalker) add(cyclomatic int, cognitive int, nested bool) {
+	function, nesting := w.current()
+	if function < 0 {
+		return
+	}
+	w.functions[function].Cyclomatic += cyclomatic
+	if nested && cognitive > 0 {
+		cognitive += nesting
+	}
+	w.functions[function].Cognitive += cognitive
+}
+
+// visit procesa lo común a todos los lenguajes de llaves: bloques, estructuras de control,
+// operadores lógicos y recursión
+func (w *braceWalker) visit(i int) {
+	t := w.tokens[i]
+	if w.skipWhile && t.text != "while" {
+		w.skipWhile = false
+	}
+
+	if t.kind == tokenIdent && w.controls[t.text] {
+		w.visitControl(i)
+		w.lastLogical = ""
+		return
+	}
+
+	switch t.text {
+	case "(", "[":
+		if t.kind == tokenPunct {
+			w.parens++
+		}
+	case ")", "]":
+		if t.kind == tokenPunct && w.parens > 0 {
+			w.parens--
+		}
+	case "{":
+		w.openBrace(t)
+	case "}":
+		w.closeBrace(t)
+	case ";":
+		if w.function != nil && w.function.parens == w.parens {
+			w.function = nil // declaración sin cuerpo
+		}
+		if w.control == w.parens {
+			w.control = -1 // cuerpo sin llaves
+		}
+		w.lastLogical = ""
+	case ",":
+		w.lastLogical = ""
+	case "&&", "||", "??":
+		if t.kind != tokenPunct {
+			return
+		}
+		cognitive := 0
+		if t.text != w.lastLogical {
+			cognitive = 1
+		}
+		w.add(1, cognitive, false)
+		w.lastLogical = t.text
+	default:
+		if t.kind == tokenIdent && w.text(i+1) == "(" && w.isRecursive(i) {
+			w.add(0, 1, false)
+		}
+	}
+}
+
+func (w *braceWalker) visitControl(i int) {
+	switch text := w.tokens[i].text; text {
+	case "else":
+		w.add(0, 1, false)
+		w.elseIf = w.text(i+1) == "if"
+		w.control = w.parens
+	case "if":
+		if w.elseIf {
+			w.add(1, 0, false)
+			w.elseIf = false
+		} else {
+			w.add(1, 1, true)
+		}
+		w.control = w.parens
+	case "case":
+		w.add(1, 0, false)
+	case "loop":
+		w.add(0, 1, true)
+		w.control = w.parens
+	case "while":
+		if w.skipWhile { // el while de un do { } while ya se contó en el do
+			w.skipWhile = false
+			return
+		}
+		w.add(1, 1, true)
+		w.control = w.parens
+	default: // for, do, catch, switch, match
+		cyclomatic := 1
+		if text == w.switchKey {
+			cyclomatic = 0 // cuentan los case o brazos
+		}
+		w.add(cyclomatic, 1, true)
+		w.control = w.parens
+		w.controlDo = text == "do"
+		w.controlMatch = text == w.switchKey
+	}
+}
+
+func (w *braceWalker) openBrace(t sourceToken) {
+	frame := braceFrame{kind: frameBlock, line: t.line, parens: w.parens}
+	switch {
+	case w.function != nil && w.function.parens == w.parens:
+		frame.kind = frameFunction
+		frame.test = w.function.test && !w.inTest()
+		frame.function = w.addFunction(w.function.name, w.function.line)
+		w.function = nil
+	case w.class != nil && w.class.parens == w.parens:
+		frame.kind = w.class.kind
+		frame.name = w.class.name
+		w.class = nil
+	case w.control == w.parens:
+		frame.kind = frameControl
+		frame.do, frame.match = w.controlDo, w.controlMatch
+		w.control, w.controlDo, w.controlMatch = -1, false, false
+	case w.testBlock:
+		frame.test = !w.inTest()
+	}
+	w.testBlock = false
+	w.lastLogical = ""
+	w.frames = append(w.frames, frame)
+}
+
+func (w *braceWalker) closeBrace(t sourceToken) {
+	w.lastLogical = ""
+	if len(w.frames) == 0 {
+		return
+	}
+	frame := w.frames[len(w.frames)-1]
+	w.frames = w.frames[:len(w.frames)-1]
+
+	if frame.kind == frameFunction {
+		function := &w.functions[frame.function]
+		function.Lines = t.line - function.Line + 1
+	}
+	if frame.test {
+		w.tests = append(w.tests, [2]int{frame.line, t.line})
+	}
+	if frame.do {
+		w.skipWhile = true
+	}
+}
+
+// topMatch indica si el token está directamente en el cuerpo de un switch o match
+func (w *braceWalker) topMatch() bool {
+	n := len(w.frames)
+	return n > 0 && w.frames[n-1].match && w.frames[n-1].parens == w.parens
+}
+
+// isRecursive reconoce una llamada a la función actual: name(), this.name(), self.name() o Self::name()
+func (w *braceWalker) isRecursive(i int) bool {
+	function, _ := w.current()
+	if function < 0 || w.names[function] != w.tokens[i].text || i == w.lastFunctionKey {
+		return false
+	}
+	switch w.text(i - 1) {
+	case ".":
+		return w.text(i-2) == "this" || w.text(i-2) == "self"
+	case "::":
+		return w.text(i-2) == "Self"
+	case "function", "fn":
+		return false
+	}
+	return true
+}
+
+// metrics convierte el recorrido en FileMetrics
+func (w *braceWalker) metrics(lines lineClasses) FileMetrics {
+	var metrics FileMetrics
+	lines.apply(&metrics)
+	metrics.Classes = w.classes
+	for _, tests := range w.tests {
+		metrics.TestLines += lines.codeLinesBetween(tests[0], tests[1])
+	}
+	summarizeFunctions(&metrics, w.functions)
+	return metrics
+}
@@ -0,0 +1,481 @@
+package core
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/utils"
+)
+
+// DefaultCalendarAlarms son los avisos por defecto antes de plazos y hackathons marcados
+var DefaultCalendarAlarms = []time.Duration{24 * time.Hour}
+
+// CalendarOptions configura la exportación iCalendar (RFC 5545)
+type CalendarOptions struct {
+	Name      string          // X-WR-CALNAME
+	Alarms    []time.Duration // VALARM antes de cada plazo y de los hackathons marcados
+	Bookmarks []*Bookmark
+	Now       time.Time // DTSTAMP
+}
+
+// CalendarHackathons reúne los hackathons a exportar: los marcados y, si se pide, los de la caché.
+// Los marcados se actualizan con los datos de la caché cuando están allí.
+func CalendarHackathons(bookmarks []*Bookmark, includeCached, includePast bool, now time.Time) ([]*models.Hackathon, error) {
+	cache, err := LoadHackathonCache()
+	if err != nil {
+		return nil, err
+	}
+
+	cached := make(map[string]*models.Hackathon, len(cache.Hackathons))
+	for _, hackathon := range cache.Hackathons {
+		cached[hackathonKey(hackathon)] = hackathon
+	}
+
+	var hackathons []*models.Hackathon
+	seen := make(map[string]bool)
+	add := func(hackathon *models.Hackathon) {
+		key := hackathonKey(hackathon)
+		if seen[key] || (!includePast && hackathonFinished(hackathon, now)) {
+			return
+		}
+		seen[key] = true
+		hackathons = append(hackathons, hackathon)
+	}
+
+	for _, bookmark := range bookmarks {
+		if fresh, ok := cached[hackathonKey(bookmark.Hackathon)]; ok {
+			bookmark.Hackathon = fresh
+		}
+		add(bookmark.Hackathon)
+	}
+	if includeCached {
+		for _, hackathon := range cache.Hackathons {
+			add(hackathon)
+		}
+	}
+
+	SortHackathons(hackathons, "start_date", "asc")
+	return hackathons, nil
+}
+
+// WriteCalendar escribe los hackathons como VCALENDAR: un VEVENT por hackathon, otro por cada
+// plazo de inscripción o entrega con sus VALARM, y VALARM en los hackathons marcados
+func WriteCalendar(w io.Writer, hackathons []*models.Hackathon, options CalendarOptions) error {
+	if options.Now.IsZero() {
+		options.Now = time.Now()
+	}
+	if options.Name == "" {
+		options.Name = "Antoine Hackathons"
+	}
+
+	bookmarked := make(map[string]*Bookmark, len(options.Bookmarks))
+	for _, bookmark := range options.Bookmarks {
+		bookmarked[hackathonKey(bookmark.Hackathon)] = bookmark
+	}
+
+	ics := &icsWriter{w: bufio.NewWriter(w)}
+	ics.line("BEGIN", "VCALENDAR")
+	ics.line("VERSION", "2.0")
+	ics.line("PRODID", "-//Antoine//Antoine CLI//EN")
+	ics.line("CALSCALE", "GREGORIAN")
+	ics.line("METHOD", "PUBLISH")
+	ics.text("X-WR-CALNAME", options.Name)
+
+	for _, zone := range calendarZones(hackathons) {
+		ics.timezone(zone.location, zone.from, zone.to)
+	}
+
+	stamp := options.Now.UTC().Format(icsUTCFormat)
+	for _, hackathon := range hackathons {
+		bookmark := bookmarked[hackathonKey(hackathon)]
+		loc := hackathonLocation(hackathon)
+		uid := calendarUID(hackathon)
+
+		if !hackathon.StartDate.IsZero() {
+			ics.line("BEGIN", "VEVENT")
+			ics.line("UID", uid+"@antoine-cli")
+			ics.line("DTSTAMP", stamp)
+			ics.datetime("DTSTART", hackathon.StartDate, loc)
+			if hackathon.EndDate.After(hackathon.StartDate) {
+				ics.datetime("DTEND", hackathon.EndDate, loc)
+			}
+			ics.text("SUMMARY", hackathon.Name)
+			ics.text("DESCRIPTION", calendarDescription(hackathon, bookmark))
+			if where := calendarLocation(hackathon.Location); where != "" {
+				ics.text("LOCATION", where)
+			}
+			if coords := hackathon.Location.Coordinates; coords != nil {
+				ics.line("GEO", fmt.Sprintf("%.6f;%.6f", coords.Latitude, coords.Longitude))
+			}
+			if hackathon.URL != "" {
+				ics.line("URL", hackathon.URL)
+			}
+			if email := hackathon.Organizer.Contact.Email; email != "" {
+				ics.line("ORGANIZER;CN="+icsParam(hackathon.Organizer.Name), "mailto:"+email)
+			}
+			ics.categories(hackathon, bookmark != nil)
+			if bookmark != nil {
+				for _, before := range options.Alarms {
+					ics.alarm(before, fmt.Sprintf("%s starts in %s", hackathon.Name, alarmLabel(before)))
+				}
+			}
+			ics.line("END", "VEVENT")
+		}
+
+		deadlines := []struct {
+			kind, summary, url string
+			at                 time.Time
+		}{
+			{"registration", "Registration closes: ", hackathon.RegistrationURL, hackathon.RegistrationDeadline},
+			{"submission", "Submissions due: ", hackathon.URL, hackathon.SubmissionDeadline},
+		}
+		for _, deadline := range deadlines {
+			if deadline.at.IsZero() {
+				continue
+			}
+			ics.line("BEGIN", "VEVENT")
+			ics.line("UID", uid+"-"+deadline.kind+"@antoine-cli")
+			ics.line("DTSTAMP", stamp)
+			ics.datetime("DTSTART", deadline.at, loc)
+			ics.text("SUMMARY", deadline.summary+hackathon.Name)
+			ics.line("TRANSP", "TRANSPARENT")
+			if deadline.url == "" {
+				deadline.url = hackathon.URL
+			}
+			if deadline.url != "" {
+				ics.text("DESCRIPTION", deadline.url)
+				ics.line("URL", deadline.url)
+			}
+			ics.line("CATEGORIES", "Deadline")
+			for _, before := range options.Alarms {
+				ics.alarm(before, fmt.Sprintf("%s%s (in %s)", deadline.summary, hackathon.Name, alarmLabel(before)))
+			}
+			ics.line("END", "VEVENT")
+		}
+	}
+
+	ics.line("END", "VCALENDAR")
+	if ics.err != nil {
+		return ics.err
+	}
+	return ics.w.Flush()
+}
+
+// Formatos de fecha de RFC 5545
+const (
+	icsUTCFormat   = "20060102T150405Z"
+	icsLocalFormat = "20060102T150405"
+)
+
+// icsWriter escribe propiedades con CRLF, escapado de texto y plegado a 75 octetos
+type icsWriter struct {
+	w   *bufio.Writer
+	err error
+}
+
+func (ics *icsWriter) line(name, value string) {
+	if ics.err != nil {
+		return
+	}
+
+	content := name + ":" + value
+	// Plegado: ninguna línea supera 75 octetos y no se parten caracteres UTF-8
+	for len(content) > 75 {
+		cut := 75
+		for cut > 0 && !utf8.RuneStart(content[cut]) {
+			cut--
+		}
+		if _, ics.err = ics.w.WriteString(content[:cut] + "\r\n"); ics.err != nil {
+			return
+		}
+		content = " " + content[cut:]
+	}
+	_, ics.err = ics.w.WriteString(content + "\r\n")
+}
+
+func (ics *icsWriter) text(name, value string) {
+	ics.line(name, icsEscape(value))
+}
+
+// datetime escribe la fecha con TZID de la zona del hackathon, o en UTC si no tiene
+func (ics *icsWriter) datetime(name string, t time.Time, loc *time.Location) {
+	if loc == time.UTC {
+		ics.line(name, t.UTC().Format(icsUTCFormat))
+		return
+	}
+	ics.line(name+";TZID="+loc.String(), t.In(loc).Format(icsLocalFormat))
+}
+
+func (ics *icsWriter) categories(hackathon *models.Hackathon, bookmarked bool) {
+	categories := []string{"Hackathon"}
+	if bookmarked {
+		categories = append(categories, "Bookmarked")
+	}
+	categories = append(categories, hackathon.Themes...)
+
+	escaped := make([]string, 0, len(categories))
+	for _, category := range utils.UniqueStrings(categories) {
+		escaped = append(escaped, icsEscape(category))
+	}
+	ics.line("CATEGORIES", strings.Join(escaped, ","))
+}
+
+func (ics *icsWriter) alarm(before time.Duration, description string) {
+	ics.line("BEGIN", "VALARM")
+	ics.line("ACTION", "DISPLAY")
+	ics.text("DESCRIPTION", description)
+	ics.line("TRIGGER", "-"+icsDuration(before))
+	ics.line("END", "VALARM")
+}
+
+// timezone escribe un VTIMEZONE con las transiciones de la zona entre from y to.
+// Go no expone las reglas de la base tz, así que se localizan buscando cambios de offset.
+func (ics *icsWriter) timezone(loc *time.Location, from, to time.Time) {
+	ics.line("BEGIN", "VTIMEZONE")
+	ics.line("TZID", loc.String())
+
+	observance := func(at time.Time, offsetFrom int) {
+		local := at.In(loc)
+		name, offsetTo := local.Zone()
+		kind := "STANDARD"
+		if local.IsDST() {
+			kind = "DAYLIGHT"
+		}
+
+		ics.line("BEGIN", kind)
+		// DTSTART de la observancia va en hora local según el offset anterior
+		ics.line("DTSTART", at.UTC().Add(time.Duration(offsetFrom)*time.Second).Format(icsLocalFormat))
+		ics.line("TZOFFSETFROM", icsOffset(offsetFrom))
+		ics.line("TZOFFSETTO", icsOffset(offsetTo))
+		ics.text("TZNAME", name)
+		ics.line("END", kind)
+	}
+
+	_, offset := from.In(loc).Zone()
+	observance(from, offset)
+
+	for day := from; day.Before(to); day = day.Add(24 * time.Hour) {
+		next := day.Add(24 * time.Hour)
+		if _, nextOffset := next.In(loc).Zone(); nextOffset != offset {
+			// Búsqueda binaria del segundo exacto de la transición
+			lo, hi := day, next
+			for hi.Sub(lo) > time.Second {
+				mid := lo.Add(hi.Sub(lo) / 2)
+				if _, midOffset := mid.In(loc).Zone(); midOffset == offset {
+					lo = mid
+				} else {
+					hi = mid
+				}
+			}
+			observance(hi, offset)
+			offset = nextOffset
+		}
+	}
+
+	ics.line("END", "VTIMEZONE")
+}
+
+// calendarZone es una zona horaria usada en el calendario y el rango de años que cubre
+type calendarZone struct {
+	location *time.Location
+	from, to time.Time
+}
+
+// calendarZones devuelve las zonas con TZID que necesitan VTIMEZONE, por nombre
+func calendarZones(hackathons []*models.Hackathon) []calendarZone {
+	zones := make(map[string]*calendarZone)
+
+	for _, hackathon := range hackathons {
+		loc := hackathonLocation(hackathon)
+		if loc == time.UTC {
+			continue
+		}
+
+		for _, t := range []time.Time{hackathon.StartDate, hackathon.EndDate, hackathon.RegistrationDeadline, hackathon.SubmissionDeadline} {
+			if t.IsZero() {
+				continue
+			}
+			// Años completos alrededor de cada fecha, para incluir las transiciones del año
+			from := time.Date(t.In(loc).Year(), 1, 1, 0, 0, 0, 0, loc)
+			to := from.AddDate(1, 0, 0)
+
+			zone, ok := zones[loc.String()]
+			if !ok {
+				zones[loc.String()] = &calendarZone{location: loc, from: from, to: to}
+				continue
+			}
+			if from.Before(zone.from) {
+				zone.from = from
+			}
+			if to.After(zone.to) {
+				zone.to = to
+			}
+		}
+	}
+
+	result := make([]calendarZone, 0, len(zones))
+	for _, zone := range zones {
+		result = append(result, *zone)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].location.String() < result[j].location.String()
+	})
+	return result
+}
+
+// hackathonLocation carga Location.Timezone; si falta o no es válida se usa UTC
+func hackathonLocation(hackathon *models.Hackathon) *time.Location {
+	name := hackathon.Location.Timezone
+	if name == "" || strings.EqualFold(name, "UTC") {
+		return time.UTC
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		utils.WithError(err).Debug("Unknown hackathon timezone, exporting in UTC")
+		return time.UTC
+	}
+	return loc
+}
+
+// calendarUID es estable entre exportaciones para que los calendarios actualicen y no dupliquen
+func calendarUID(hackathon *models.Hackathon) string {
+	sum := sha1.Sum([]byte(hackathonKey(hackathon)))
+	return "hackathon-" + hex.EncodeToString(sum[:8])
+}
+
+func calendarDescription(hackathon *models.Hackathon, bookmark *Bookmark) string {
+	var lines []string
+	if bookmark != nil && bookmark.Note != "" {
+		lines = append(lines, "📌 "+bookmark.Note, "")
+	}
+	if hackathon.Description != "" {
+		lines = append(lines, hackathon.Description, "")
+	}
+	if hackathon.PrizePool.Total > 0 {
+		line := fmt.Sprintf("Prize pool: %d %s", hackathon.PrizePool.Total, hackathon.PrizePool.Currency)
+		// Equivalente en la moneda base si el premio viene en otra
+		if prize := Prizes().Normalize(hackathon.PrizePool); prize.Known && hackathon.PrizePool.Currency != "" &&
+			!strings.EqualFold(hackathon.PrizePool.Currency, prize.Currency) {
+			line += fmt.Sprintf(" (≈ %s)", FormatMoney(prize.Amount, prize.Currency))
+		}
+		lines = append(lines, line)
+	}
+	if len(hackathon.Technologies) > 0 {
+		lines = append(lines, "Technologies: "+strings.Join(hackathon.Technologies, ", "))
+	}
+	if hackathon.Organizer.Name != "" {
+		lines = append(lines, "Organizer: "+hackathon.Organizer.Name)
+	}
+	if hackathon.RegistrationURL != "" {
+		lines = append(lines, "Register: "+hackathon.RegistrationURL)
+	}
+	if hackathon.URL != "" {
+		lines = append(lines, hackathon.URL)
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+func calendarLocation(location models.Location) string {
+	if location.Type == "online" {
+		return "Online"
+	}
+
+	var parts []string
+	for _, part := range []string{location.Venue, location.Address, location.City, location.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	where := strings.Join(parts, ", ")
+	if location.Type == "hybrid" {
+		if where == "" {
+			return "Hybrid (online)"
+		}
+		where += " + online"
+	}
+	return where
+}
+
+// icsEscape escapa TEXT según RFC 5545 §3.3.11
+func icsEscape(value string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+	).Replace(value)
+}
+
+// icsParam entrecomilla un valor de parámetro; las comillas no están permitidas dentro
+func icsParam(value string) string {
+	return `"` + strings.ReplaceAll(value, `"`, "'") + `"`
+}
+
+// icsOffset formatea un offset UTC en segundos como +HHMM
+func icsOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	offset := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds%3600/60)
+	if s := seconds % 60; s != 0 {
+		offset += fmt.Sprintf("%02d", s)
+	}
+	return offset
+}
+
+// alarmLabel describe la antelación de un aviso (1 day, 3 days, 2h, 30m0s)
+func alarmLabel(d time.Duration) string {
+	switch {
+	case d == 24*time.Hour:
+		return "1 day"
+	case d%(24*time.Hour) == 0:
+		return fmt.Sprintf("%d days", d/(24*time.Hour))
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	default:
+		return d.String()
+	}
+}
+
+// icsDuration formatea una duración como dur-value de RFC 5545 (P1D, PT1H30M)
+func icsDuration(d time.Duration) string {
+	if d <= 0 {
+		return "PT0S"
+	}
+
+	days := d / (24 * time.Hour)
+	d -= days * 24 * time.Hour
+
+	duration := "P"
+	if days > 0 {
+		duration += fmt.Sprintf("%dD", days)
+	}
+	if d > 0 {
+		duration += "T"
+		if h := d / time.Hour; h > 0 {
+			duration += fmt.Sprintf("%dH", h)
+		}
+		if m := d % time.Hour / time.Minute; m > 0 {
+			duration += fmt.Sprintf("%dM", m)
+		}
+		if s := d % time.Minute / time.Second; s > 0 {
+			duration += fmt.Sprintf("%dS", s)
+		}
+	}
+	if duration == "PT" {
+		return "PT0S"
+	}
+	return duration
+}
@@ -0,0 +1,153 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+	_ "time/tzdata" // America/New_York sin depender de la base tz del sistema
+	"unicode/utf8"
+
+	"antoine-cli/internal/models"
+)
+
+var updateGolden = flag.Bool("update", false, "rewrite the golden files in testdata")
+
+func TestWriteCalendarGolden(t *testing.T) {
+	newYork := &models.Hackathon{
+		ID:   "nyc-2024",
+		Name: "Spring Hack NYC",
+		// Multibyte cerca del corte de 75 octetos y todos los caracteres que TEXT escapa
+		Description: "Café, código y café; traed portátil. Rutas C:\\hack\\2024 y ñandúes 🦙🦙🦙 para todos los equipos.\nSegunda línea",
+		URL:         "https://example.com/nyc",
+		// El fin de semana del cambio de hora: empieza en EST y termina en EDT
+		StartDate:            time.Date(2024, 3, 9, 15, 0, 0, 0, time.UTC),
+		EndDate:              time.Date(2024, 3, 11, 22, 0, 0, 0, time.UTC),
+		RegistrationDeadline: time.Date(2024, 3, 1, 4, 59, 0, 0, time.UTC),
+		Location:             models.Location{Type: "in-person", Venue: "Pier 57", City: "New York", Country: "USA", Timezone: "America/New_York"},
+		Technologies:         []string{"Go", "Rust"},
+		Themes:               []string{"AI, agents"},
+		Organizer:            models.Organizer{Name: `The "Hack" Club`, Contact: models.Contact{Email: "team@example.com"}},
+	}
+	online := &models.Hackathon{
+		ID:                 "online-2024",
+		Name:               "Global Online Jam",
+		URL:                "https://example.com/jam",
+		StartDate:          time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:            time.Date(2024, 6, 3, 0, 0, 0, 0, time.UTC),
+		SubmissionDeadline: time.Date(2024, 6, 2, 23, 30, 0, 0, time.UTC),
+		Location:           models.Location{Type: "online", Timezone: "UTC"},
+	}
+
+	tests := []struct {
+		name       string
+		hackathons []*models.Hackathon
+		bookmarks  []*Bookmark
+	}{
+		{
+			name:       "dst",
+			hackathons: []*models.Hackathon{newYork},
+			bookmarks:  []*Bookmark{{Hackathon: newYork, Note: "Reservar hotel"}},
+		},
+		{
+			name:       "utc",
+			hackathons: []*models.Hackathon{online},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := WriteCalendar(&buf, tt.hackathons, CalendarOptions{
+				Alarms:    []time.Duration{24 * time.Hour, 90 * time.Minute},
+				Bookmarks: tt.bookmarks,
+				Now:       time.Date(2024, 2, 1, 12, 0, 0, 0, time.UTC),
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			for _, line := range strings.Split(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n") {
+				if len(line) > 75 || !utf8.ValidString(line) {
+					t.Errorf("line of %d octets or split rune: %q", len(line), line)
+				}
+			}
+
+			golden := filepath.Join("testdata", "calendar_"+tt.name+".ics")
+			if *updateGolden {
+				if err := os.WriteFile(golden, buf.Bytes(), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(buf.Bytes(), want) {
+				t.Errorf("calendar differs from %s (run with -update after checking the change):\n%s", golden, buf.String())
+			}
+		})
+	}
+}
+
+func TestICSFoldingUnfoldsToEscapedText(t *testing.T) {
+	value := strings.Repeat("día; años, C:\\ruta\n", 8)
+	var buf bytes.Buffer
+	ics := &icsWriter{w: bufio.NewWriter(&buf)}
+	ics.text("DESCRIPTION", value)
+	if err := ics.w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Desplegar (RFC 5545 §3.1) debe devolver la propiedad completa
+	unfolded := strings.ReplaceAll(strings.TrimSuffix(buf.String(), "\r\n"), "\r\n ", "")
+	if want := "DESCRIPTION:" + icsEscape(value); unfolded != want {
+		t.Errorf("unfolded = %q, want %q", unfolded, want)
+	}
+	if escaped := icsEscape("a;b,c\\d\r\ne\nf"); escaped != `a\;b\,c\\d\ne\nf` {
+		t.Errorf("icsEscape = %q", escaped)
+	}
+}
+
+func TestICSDuration(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want string
+	}{
+		{0, "PT0S"},
+		{-time.Hour, "PT0S"},
+		{24 * time.Hour, "P1D"},
+		{72 * time.Hour, "P3D"},
+		{90 * time.Minute, "PT1H30M"},
+		{26*time.Hour + 15*time.Minute, "P1DT2H15M"},
+		{45 * time.Second, "PT45S"},
+	}
+	for _, tt := range tests {
+		if got := icsDuration(tt.in); got != tt.want {
+			t.Errorf("icsDuration(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestICSOffset(t *testing.T) {
+	tests := []struct {
+		seconds int
+		want    string
+	}{
+		{0, "+0000"},
+		{-5 * 3600, "-0500"},
+		{-4 * 3600, "-0400"},
+		{5*3600 + 30*60, "+0530"},
+		{-(3*3600 + 30*60), "-0330"},
+		{-(17*60 + 30), "-001730"}, // offsets históricos con segundos
+	}
+	for _, tt := range tests {
+		if got := icsOffset(tt.seconds); got != tt.want {
+			t.Errorf("icsOffset(%d) = %q, want %q", tt.seconds, got, tt.want)
+		}
+	}
+}
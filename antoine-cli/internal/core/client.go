@@ -3,12 +3,14 @@ package core
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"antoine-cli/internal/config"
 	"antoine-cli/internal/mcp"
 	"antoine-cli/internal/models"
+	"antoine-cli/internal/utils"
 )
 
 type AntoineClient struct {
@@ -52,6 +54,16 @@ func NewAntoineClient(cfg *config.Config) (*AntoineClient, error) {
 		analytics: NewAnalyticsManager(),
 	}
 
+	// Exportar métricas solo si el usuario lo aceptó con 'antoine analytics opt-in'
+	if cfg.Analytics.Enabled {
+		if options, err := TelemetryOptionsFromConfig(cfg); err == nil {
+			if pipeline, err := NewTelemetryPipeline(options); err == nil && pipeline.Consented() {
+				client.analytics.SetExporter(pipeline)
+				pipeline.Start()
+			}
+		}
+	}
+
 	return client, nil
 }
 
@@ -100,24 +112,52 @@ func (c *AntoineClient) SearchHackathons(ctx context.Context, query string, filt
 	defer c.mu.RUnlock()
 
 	// Verificar caché primero
-	cacheKey := fmt.Sprintf("hackathons:%s:%v", query, filters)
-	if cached, found := c.cache.Get(cacheKey); found {
+	if cached, found := c.cache.Get(hackathonsCacheKey(query, filters)); found {
 		if hackathons, ok := cached.([]*models.Hackathon); ok {
+			c.analytics.RecordCacheLookup("hackathons", true)
 			return hackathons, nil
 		}
 	}
+	c.analytics.RecordCacheLookup("hackathons", false)
+
+	return c.searchHackathonsLive(ctx, query, filters)
+}
+
+// RefreshHackathons repite la búsqueda sin mirar la caché (la actualiza con el resultado).
+// Lo usa watch: con intervalos más cortos que el TTL vería siempre el mismo resultado.
+func (c *AntoineClient) RefreshHackathons(ctx context.Context, query string, filters map[string]interface{}) ([]*models.Hackathon, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return c.searchHackathonsLive(ctx, query, filters)
+}
+
+func hackathonsCacheKey(query string, filters map[string]interface{}) string {
+	return fmt.Sprintf("hackathons:%s:%v", query, filters)
+}
 
+func (c *AntoineClient) searchHackathonsLive(ctx context.Context, query string, filters map[string]interface{}) ([]*models.Hackathon, error) {
 	// Buscar usando Exa
 	hackathons, err := c.mcp.exa.SearchHackathons(ctx, query, filters)
 	if err != nil {
 		return nil, fmt.Errorf("search failed: %w", err)
 	}
 
+	// Un mismo evento aparece en varias webs: fusionar duplicados guardando su procedencia.
+	// Exa solo es el buscador; el origen de cada registro es la web de la que sale
+	if resolved := ResolveHackathons(hackathons, ""); len(resolved) < len(hackathons) {
+		utils.WithField("merged", len(hackathons)-len(resolved)).Debug("Merged duplicate hackathons")
+		hackathons = resolved
+	}
+
 	// Guardar en caché
-	c.cache.Set(cacheKey, hackathons, 30*time.Minute)
+	c.cache.Set(hackathonsCacheKey(query, filters), hackathons, 30*time.Minute)
+	if err := storeHackathons(hackathons); err != nil {
+		utils.WithError(err).Debug("Failed to update local hackathon cache")
+	}
 
 	// Registrar métricas
-	c.analytics.RecordSearch("hackathons", len(hackathons))
+	c.analytics.RecordSearch("hackathons", query, len(hackathons))
 
 	return hackathons, nil
 }
@@ -130,9 +170,11 @@ func (c *AntoineClient) SearchProjects(ctx context.Context, query string, filter
 	cacheKey := fmt.Sprintf("projects:%s:%v", query, filters)
 	if cached, found := c.cache.Get(cacheKey); found {
 		if projects, ok := cached.([]*models.Project); ok {
+			c.analytics.RecordCacheLookup("projects", true)
 			return projects, nil
 		}
 	}
+	c.analytics.RecordCacheLookup("projects", false)
 
 	projects, err := c.mcp.exa.SearchProjects(ctx, query, filters)
 	if err != nil {
@@ -140,7 +182,7 @@ func (c *AntoineClient) SearchProjects(ctx context.Context, query string, filter
 	}
 
 	c.cache.Set(cacheKey, projects, 30*time.Minute)
-	c.analytics.RecordSearch("projects", len(projects))
+	c.analytics.RecordSearch("projects", query, len(projects))
 
 	return projects, nil
 }
@@ -164,6 +206,18 @@ func (c *AntoineClient) AnalyzeRepository(ctx context.Context, repoURL string, o
 
 	// Combinar resultados
 	analysis.Summary = overview
+	if options != nil && options.IncludeDependencies {
+		deps, errors := c.RemoteDependencies(ctx, repoURL)
+		attachDependencies(analysis, repoURL, deps, errors)
+	}
+	hackathon := licenseHackathon(options)
+	if project, err := c.RemoteProjectLicense(ctx, repoURL); err == nil {
+		var deps models.DependencyAnalysis
+		if repo, ok := RepositoryFromResult(analysis); ok {
+			deps = repo.CodeQuality.Security.Dependencies
+		}
+		attachLicenses(analysis, BuildLicenseReport(project, deps, hackathon))
+	}
 
 	c.analytics.RecordAnalysis("repository", repoURL)
 
@@ -171,16 +225,20 @@ func (c *AntoineClient) AnalyzeRepository(ctx context.Context, repoURL string, o
 }
 
 // GetTrends obtiene tendencias de tecnologías
-func (c *AntoineClient) GetTrends(ctx context.Context, technologies []string, timeframe string) (interface{}, error) {
+func (c *AntoineClient) GetTrends(ctx context.Context, technologies []string, timeframe, market string) (*models.TrendReport, error) {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	cacheKey := fmt.Sprintf("trends:%v:%s", technologies, timeframe)
+	cacheKey := fmt.Sprintf("trends:%v:%s:%s", technologies, timeframe, strings.ToLower(market))
 	if cached, found := c.cache.Get(cacheKey); found {
-		return cached, nil
+		if trends, ok := cached.(*models.TrendReport); ok {
+			c.analytics.RecordCacheLookup("trends", true)
+			return trends, nil
+		}
 	}
+	c.analytics.RecordCacheLookup("trends", false)
 
-	trends, err := c.mcp.exa.SearchTrends(ctx, technologies, timeframe)
+	trends, err := c.mcp.exa.SearchTrends(ctx, technologies, timeframe, market)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get trends: %w", err)
 	}
@@ -204,6 +262,11 @@ func (c *AntoineClient) ExecuteAnalysisScript(ctx context.Context, script string
 	return result, nil
 }
 
+// Analytics devuelve el gestor de métricas de uso
+func (c *AntoineClient) Analytics() *AnalyticsManager {
+	return c.analytics
+}
+
 // Health verifica el estado de todos los servicios
 func (c *AntoineClient) Health(ctx context.Context) map[string]bool {
 	status := make(map[string]bool)
@@ -224,6 +287,14 @@ func (c *AntoineClient) Close() error {
 
 	var errors []error
 
+	// Si toca enviar, el envío corre en otro proceso: salir no espera a la red
+	if exporter := c.analytics.Exporter(); exporter != nil {
+		exporter.Stop()
+		if err := exporter.FlushInBackground(); err != nil {
+			utils.WithError(err).Debug("Failed to start background analytics flush")
+		}
+	}
+
 	if err := c.mcp.exa.Disconnect(); err != nil {
 		errors = append(errors, err)
 	}
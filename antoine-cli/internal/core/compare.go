@@ -0,0 +1,327 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"antoine-cli/internal/models"
+)
+
+// Secciones de la matriz de comparación
+const (
+	SectionQuality      = "Code Quality"
+	SectionComplexity   = "Complexity"
+	SectionDependencies = "Dependencies"
+	SectionSecurity     = "Security"
+	SectionLanguages    = "Languages"
+	SectionActivity     = "Activity"
+)
+
+// ComparisonTarget es un repositorio comparado junto con su análisis
+type ComparisonTarget struct {
+	URL         string                 `json:"url"`
+	Name        string                 `json:"name"`
+	Result      *models.AnalysisResult `json:"result,omitempty"`
+	Repository  *models.Repository     `json:"repository,omitempty"`
+	CompareWith []string               `json:"compare_with,omitempty"` // el resto de repositorios comparados
+	Error       string                 `json:"error,omitempty"`
+}
+
+// ComparisonRow es una métrica de la matriz con un valor por repositorio.
+// Values es nil donde el repositorio no tiene dato; Best contiene los índices ganadores.
+type ComparisonRow struct {
+	Section string     `json:"section"`
+	Metric  string     `json:"metric"`
+	Display []string   `json:"display"`
+	Values  []*float64 `json:"values,omitempty"`
+	Best    []int      `json:"best"`
+}
+
+// RepositoryComparison es el resultado de comparar varios repositorios
+type RepositoryComparison struct {
+	Targets     []*ComparisonTarget `json:"targets"`
+	Matrix      []ComparisonRow     `json:"matrix"`
+	GeneratedAt time.Time           `json:"generated_at"`
+}
+
+// CompareRepositories analiza todos los repositorios (cada uno con el resto en
+// CompareWith) y construye la matriz de comparación
+func (c *AntoineClient) CompareRepositories(ctx context.Context, repoURLs []string, options *models.AnalysisOptions) (*RepositoryComparison, error) {
+	if len(repoURLs) < 2 {
+		return nil, fmt.Errorf("at least two repositories are required to compare")
+	}
+
+	normalized := make([]string, 0, len(repoURLs))
+	for _, repoURL := range repoURLs {
+		normalized = append(normalized, normalizeRepoURL(repoURL))
+	}
+	repoURLs = normalized
+
+	concurrency := c.config.Analysis.MaxConcurrentJobs
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	targets := make([]*ComparisonTarget, len(repoURLs))
+	semaphore := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+
+	for i, repoURL := range repoURLs {
+		targets[i] = &ComparisonTarget{URL: repoURL, Name: RepoName(repoURL), CompareWith: otherRepositories(repoURLs, repoURL)}
+
+		wg.Add(1)
+		go func(target *ComparisonTarget) {
+			defer wg.Done()
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			targetOptions := *options
+			targetOptions.CompareWith = target.CompareWith
+			c.analyzeComparisonTarget(ctx, target, &targetOptions)
+		}(targets[i])
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, target := range targets {
+		if target.Error != "" {
+			failed++
+		}
+	}
+	if failed == len(targets) {
+		return nil, fmt.Errorf("all repositories failed to analyze: %s", targets[0].Error)
+	}
+
+	return &RepositoryComparison{
+		Targets:     targets,
+		Matrix:      BuildComparisonMatrix(targets),
+		GeneratedAt: time.Now(),
+	}, nil
+}
+
+// analyzeComparisonTarget completa el análisis y los datos del repositorio de un objetivo
+func (c *AntoineClient) analyzeComparisonTarget(ctx context.Context, target *ComparisonTarget, options *models.AnalysisOptions) {
+	result, err := c.AnalyzeRepository(ctx, target.URL, options)
+	if err != nil {
+		target.Error = err.Error()
+		return
+	}
+	target.Result = result
+
+	if repo, ok := RepositoryFromResult(result); ok {
+		target.Repository = repo
+		return
+	}
+
+	// El análisis no trae métricas del repositorio: pedirlas directamente
+	repo, err := c.mcp.github.GetRepositoryInfo(ctx, target.URL)
+	if err != nil {
+		target.Error = fmt.Sprintf("failed to get repository info: %v", err)
+		return
+	}
+	target.Repository = repo
+}
+
+// otherRepositories devuelve los repositorios de all distintos de current
+func otherRepositories(all []string, current string) []string {
+	others := make([]string, 0, len(all)-1)
+	for _, repoURL := range all {
+		if repoURL != current {
+			others = append(others, repoURL)
+		}
+	}
+	return others
+}
+
+// comparisonMetric describe cómo extraer y comparar una métrica
+type comparisonMetric struct {
+	section string
+	name    string
+	// value devuelve el valor numérico; ok=false si el repositorio no lo tiene
+	value func(repo *models.Repository) (float64, bool)
+	// higherIsBetter indica el sentido de la comparación; nil = no hay ganador
+	higherIsBetter *bool
+	format         func(value float64) string
+}
+
+// BuildComparisonMatrix construye las filas de la matriz para los objetivos dados
+func BuildComparisonMatrix(targets []*ComparisonTarget) []ComparisonRow {
+	higher, lower := true, false
+
+	score := func(v float64) string { return fmt.Sprintf("%.1f", v) }
+	percent := func(v float64) string { return fmt.Sprintf("%.0f%%", v) }
+	count := func(v float64) string { return fmt.Sprintf("%d", int(v)) }
+	days := func(v float64) string { return fmt.Sprintf("%dd ago", int(v)) }
+	positive := func(v float64) (float64, bool) { return v, v > 0 }
+
+	metrics := []comparisonMetric{
+		{SectionQuality, "Overall score", func(r *models.Repository) (float64, bool) { return positive(r.CodeQuality.Score) }, &higher, score},
+		{SectionQuality, "Documentation", func(r *models.Repository) (float64, bool) { return positive(r.CodeQuality.Documentation) }, &higher, percent},
+		{SectionQuality, "Test coverage", func(r *models.Repository) (float64, bool) { return positive(r.CodeQuality.TestCoverage) }, &higher, percent},
+		{SectionQuality, "Test code ratio", func(r *models.Repository) (float64, bool) { return positive(r.CodeQuality.TestRatio) }, &higher, percent},
+		{SectionQuality, "Maintainability", func(r *models.Repository) (float64, bool) { return positive(r.CodeQuality.Maintainability) }, &higher, score},
+		{SectionQuality, "Architecture", func(r *models.Repository) (float64, bool) { return positive(r.CodeQuality.Architecture.Score) }, &higher, score},
+		{SectionQuality, "Modularity", func(r *models.Repository) (float64, bool) { return positive(r.CodeQuality.Architecture.Modularity) }, &higher, score},
+
+		{SectionComplexity, "Cyclomatic", func(r *models.Repository) (float64, bool) { return positive(r.CodeQuality.Complexity.Cyclomatic) }, &lower, score},
+		{SectionComplexity, "Cognitive", func(r *models.Repository) (float64, bool) { return positive(r.CodeQuality.Complexity.Cognitive) }, &lower, score},
+		{SectionComplexity, "Lines of code", func(r *models.Repository) (float64, bool) { return positive(float64(r.CodeQuality.Complexity.Lines)) }, nil, count},
+		{SectionComplexity, "Files", func(r *models.Repository) (float64, bool) { return positive(float64(r.CodeQuality.Complexity.Files)) }, nil, count},
+		{SectionComplexity, "Functions", func(r *models.Repository) (float64, bool) {
+			return positive(float64(r.CodeQuality.Complexity.Functions))
+		}, nil, count},
+
+		{SectionDependencies, "Total", func(r *models.Repository) (float64, bool) {
+			return float64(r.CodeQuality.Security.Dependencies.Total), r.CodeQuality.Security.Dependencies.Total > 0
+		}, nil, count},
+		{SectionDependencies, "Outdated", func(r *models.Repository) (float64, bool) {
+			deps := r.CodeQuality.Security.Dependencies
+			return float64(deps.Outdated), deps.Total > 0
+		}, &lower, count},
+		{SectionDependencies, "Vulnerable", func(r *models.Repository) (float64, bool) {
+			deps := r.CodeQuality.Security.Dependencies
+			return float64(deps.Vulnerable), deps.Total > 0
+		}, &lower, count},
+
+		{SectionSecurity, "Security score", func(r *models.Repository) (float64, bool) { return positive(r.CodeQuality.Security.Score) }, &higher, score},
+		{SectionSecurity, "Findings", func(r *models.Repository) (float64, bool) {
+			return float64(len(r.CodeQuality.Security.Vulnerabilities)), r.CodeQuality.Security.Score > 0 || len(r.CodeQuality.Security.Vulnerabilities) > 0
+		}, &lower, count},
+		{SectionSecurity, "High/critical", func(r *models.Repository) (float64, bool) {
+			severe := 0
+			for _, vuln := range r.CodeQuality.Security.Vulnerabilities {
+				switch strings.ToLower(vuln.Severity) {
+				case "high", "critical":
+					severe++
+				}
+			}
+			return float64(severe), r.CodeQuality.Security.Score > 0 || len(r.CodeQuality.Security.Vulnerabilities) > 0
+		}, &lower, count},
+
+		{SectionLanguages, "Languages", func(r *models.Repository) (float64, bool) {
+			return float64(len(r.Languages)), len(r.Languages) > 0
+		}, nil, count},
+
+		{SectionActivity, "Stars", func(r *models.Repository) (float64, bool) { return float64(r.Stars), r.URL != "" || r.Stars > 0 }, &higher, count},
+		{SectionActivity, "Forks", func(r *models.Repository) (float64, bool) { return float64(r.Forks), r.URL != "" || r.Forks > 0 }, &higher, count},
+		{SectionActivity, "Commits", func(r *models.Repository) (float64, bool) { return positive(float64(r.Commits)) }, &higher, count},
+		{SectionActivity, "Last commit", func(r *models.Repository) (float64, bool) {
+			if r.LastCommit.IsZero() {
+				return 0, false
+			}
+			return time.Since(r.LastCommit).Hours() / 24, true
+		}, &lower, days},
+	}
+
+	var rows []ComparisonRow
+	for _, metric := range metrics {
+		if metric.section == SectionLanguages {
+			rows = append(rows, primaryLanguageRow(targets))
+		}
+		rows = append(rows, buildComparisonRow(targets, metric))
+	}
+
+	return rows
+}
+
+func buildComparisonRow(targets []*ComparisonTarget, metric comparisonMetric) ComparisonRow {
+	row := ComparisonRow{
+		Section: metric.section,
+		Metric:  metric.name,
+		Display: make([]string, len(targets)),
+		Values:  make([]*float64, len(targets)),
+		Best:    []int{},
+	}
+
+	var best *float64
+	for i, target := range targets {
+		row.Display[i] = "-"
+		if target.Repository == nil {
+			continue
+		}
+
+		value, ok := metric.value(target.Repository)
+		if !ok {
+			continue
+		}
+
+		v := value
+		row.Values[i] = &v
+		row.Display[i] = metric.format(value)
+
+		if metric.higherIsBetter == nil {
+			continue
+		}
+		if best == nil || (*metric.higherIsBetter && v > *best) || (!*metric.higherIsBetter && v < *best) {
+			best = &v
+		}
+	}
+
+	// Solo hay ganador si al menos dos repositorios tienen el dato
+	present := 0
+	for _, value := range row.Values {
+		if value != nil {
+			present++
+		}
+	}
+	if best == nil || present < 2 {
+		return row
+	}
+
+	for i, value := range row.Values {
+		if value != nil && *value == *best {
+			row.Best = append(row.Best, i)
+		}
+	}
+	// Si todos empatan no se destaca a nadie
+	if len(row.Best) == present {
+		row.Best = []int{}
+	}
+
+	return row
+}
+
+// primaryLanguageRow muestra el lenguaje principal y los más usados de cada repositorio
+func primaryLanguageRow(targets []*ComparisonTarget) ComparisonRow {
+	row := ComparisonRow{
+		Section: SectionLanguages,
+		Metric:  "Primary",
+		Display: make([]string, len(targets)),
+		Best:    []int{},
+	}
+
+	for i, target := range targets {
+		row.Display[i] = "-"
+		if target.Repository == nil {
+			continue
+		}
+
+		repo := target.Repository
+		if repo.Language != "" {
+			row.Display[i] = repo.Language
+			continue
+		}
+
+		// Sin lenguaje principal, usar el que más bytes tenga
+		languages := make([]string, 0, len(repo.Languages))
+		for language := range repo.Languages {
+			languages = append(languages, language)
+		}
+		// A igualdad de bytes, por nombre: el resultado no depende del orden del map
+		sort.Slice(languages, func(a, b int) bool {
+			if repo.Languages[languages[a]] != repo.Languages[languages[b]] {
+				return repo.Languages[languages[a]] > repo.Languages[languages[b]]
+			}
+			return languages[a] < languages[b]
+		})
+		if len(languages) > 0 {
+			row.Display[i] = languages[0]
+		}
+	}
+
+	return row
+}
@@ -0,0 +1,62 @@
+package core
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"antoine-cli/internal/models"
+)
+
+func TestPrimaryLanguageRow(t *testing.T) {
+	tests := []struct {
+		name string
+		repo *models.Repository
+		want string
+	}{
+		{"no repository", nil, "-"},
+		{"declared language", &models.Repository{Language: "Go", Languages: map[string]int{"Python": 900}}, "Go"},
+		{"most bytes", &models.Repository{Languages: map[string]int{"Go": 100, "Rust": 300}}, "Rust"},
+		{"tie breaks by name", &models.Repository{Languages: map[string]int{"TypeScript": 200, "Go": 200, "Rust": 200}}, "Go"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// El map se recorre en orden aleatorio: repetir para detectar resultados inestables
+			for i := 0; i < 20; i++ {
+				row := primaryLanguageRow([]*ComparisonTarget{{Repository: tt.repo}})
+				if got := row.Display[0]; got != tt.want {
+					t.Fatalf("language = %q, want %q", got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestOtherRepositories(t *testing.T) {
+	all := []string{"https://github.com/a/one", "https://github.com/b/two", "https://github.com/c/three"}
+	tests := []struct {
+		current string
+		want    []string
+	}{
+		{all[0], []string{all[1], all[2]}},
+		{all[1], []string{all[0], all[2]}},
+		{all[2], []string{all[0], all[1]}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.current, func(t *testing.T) {
+			if got := otherRepositories(all, tt.current); !slices.Equal(got, tt.want) {
+				t.Errorf("otherRepositories(%s) = %v, want %v", tt.current, got, tt.want)
+			}
+		})
+	}
+
+	data, err := json.Marshal(&ComparisonTarget{URL: all[0], CompareWith: otherRepositories(all, all[0])})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"compare_with":["https://github.com/b/two","https://github.com/c/three"]`) {
+		t.Errorf("json = %s, want compare_with", data)
+	}
+}
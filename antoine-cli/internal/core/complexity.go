@@ -0,0 +1,378 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+)
+
+// Umbrales a partir de los que una función se considera demasiado compleja
+const (
+	CyclomaticThreshold = 10
+	CognitiveThreshold  = 15
+)
+
+// FunctionComplexity es la complejidad de una función
+type FunctionComplexity struct {
+	Name       string `json:"name"` // Func, (T).Method en Go; Clase.método en el resto
+	File       string `json:"file"`
+	Line       int    `json:"line"`
+	Lines      int    `json:"lines"`
+	Cyclomatic int    `json:"cyclomatic"`
+	Cognitive  int    `json:"cognitive"`
+}
+
+// TooComplex indica si la función supera alguno de los umbrales
+func (f FunctionComplexity) TooComplex() bool {
+	return f.Cyclomatic > CyclomaticThreshold || f.Cognitive > CognitiveThreshold
+}
+
+// ComplexityReport es la complejidad de todas las funciones de un árbol
+type ComplexityReport struct {
+	Root       string               `json:"root"`
+	Files      int                  `json:"files"`
+	Functions  []FunctionComplexity `json:"functions"`
+	Cyclomatic float64              `json:"average_cyclomatic"`
+	Cognitive  float64              `json:"average_cognitive"`
+	OverLimit  int                  `json:"over_limit"` // funciones por encima de algún umbral
+	Errors     []string             `json:"errors,omitempty"`
+}
+
+// AnalyzeComplexity calcula la complejidad de los archivos de root (un directorio o un archivo)
+// cuyos lenguajes tienen SourceAnalyzer
+func AnalyzeComplexity(ctx context.Context, root string, limits LocalLimits) (*ComplexityReport, error) {
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(abs)
+	if err != nil {
+		return nil, fmt.Errorf("cannot analyze %s: %w", root, err)
+	}
+
+	report := &ComplexityReport{Root: abs}
+	analyze := func(file SourceFile) error {
+		analyzer, ok := sourceAnalyzers[file.Language]
+		if !ok {
+			return nil
+		}
+		content, err := os.ReadFile(file.AbsPath)
+		if err != nil {
+			return err
+		}
+		metrics, err := analyzer.Analyze(file, content)
+		if err != nil {
+			report.Errors = append(report.Errors, err.Error())
+			return nil
+		}
+		report.Files++
+		report.Functions = append(report.Functions, metrics.FunctionComplexity...)
+		return nil
+	}
+
+	if info.IsDir() {
+		if _, err := WalkSourceFiles(ctx, abs, limits, analyze); err != nil {
+			return nil, fmt.Errorf("failed to walk %s: %w", root, err)
+		}
+	} else {
+		file := SourceFile{Path: filepath.Base(abs), AbsPath: abs, Language: LanguageForPath(abs), Size: info.Size()}
+		if err := analyze(file); err != nil {
+			return nil, err
+		}
+	}
+
+	if report.Files == 0 && len(report.Errors) == 0 {
+		return nil, fmt.Errorf("no supported source files (Go, JavaScript, TypeScript, Python, Rust) found in %s", root)
+	}
+
+	cyclomatic, cognitive := 0, 0
+	for _, function := range report.Functions {
+		cyclomatic += function.Cyclomatic
+		cognitive += function.Cognitive
+		if function.TooComplex() {
+			report.OverLimit++
+		}
+	}
+	if n := len(report.Functions); n > 0 {
+		report.Cyclomatic = round1(float64(cyclomatic) / float64(n))
+		report.Cognitive = round1(float64(cognitive) / float64(n))
+	}
+	return report, nil
+}
+
+// Top devuelve las n funciones más complejas según sortBy (cognitive o cyclomatic)
+func (r *ComplexityReport) Top(n int, sortBy string) []FunctionComplexity {
+	functions := append([]FunctionComplexity(nil), r.Functions...)
+	SortFunctionComplexity(functions, sortBy)
+	if n > 0 && len(functions) > n {
+		functions = functions[:n]
+	}
+	return functions
+}
+
+// SortFunctionComplexity ordena de más a menos compleja; el otro criterio desempata
+func SortFunctionComplexity(functions []FunctionComplexity, sortBy string) {
+	sort.SliceStable(functions, func(i, j int) bool {
+		a, b := functions[i], functions[j]
+		primaryA, primaryB, secondaryA, secondaryB := a.Cognitive, b.Cognitive, a.Cyclomatic, b.Cyclomatic
+		if sortBy == "cyclomatic" {
+			primaryA, primaryB, secondaryA, secondaryB = secondaryA, secondaryB, primaryA, primaryB
+		}
+		if primaryA != primaryB {
+			return primaryA > primaryB
+		}
+		if secondaryA != secondaryB {
+			return secondaryA > secondaryB
+		}
+		return a.File+a.Name < b.File+b.Name
+	})
+}
+
+// GoFunctionComplexity parsea un archivo Go y calcula la complejidad de cada función o método.
+// Las funciones anónimas cuentan dentro de la función que las contiene.
+func GoFunctionComplexity(filename string, src []byte) ([]FunctionComplexity, error) {
+	functions, _, err := goFileComplexity(filename, src)
+	return functions, err
+}
+
+// goFileComplexity devuelve además el número de tipos struct e interface declarados
+func goFileComplexity(filename string, src []byte) ([]FunctionComplexity, int, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.SkipObjectResolution)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to parse %s: %w", filename, err)
+	}
+
+	var functions []FunctionComplexity
+	types := 0
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE {
+			for _, spec := range gen.Specs {
+				switch spec.(*ast.TypeSpec).Type.(type) {
+				case *ast.StructType, *ast.InterfaceType:
+					types++
+				}
+			}
+			continue
+		}
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+		start, end := fset.Position(fn.Pos()), fset.Position(fn.End())
+		functions = append(functions, FunctionComplexity{
+			Name:       goFuncName(fn),
+			File:       filepath.ToSlash(filename),
+			Line:       start.Line,
+			Lines:      end.Line - start.Line + 1,
+			Cyclomatic: goCyclomatic(fn),
+			Cognitive:  goCognitive(fn),
+		})
+	}
+	return functions, types, nil
+}
+
+// goFuncName devuelve Func, (T).Method o (*T).Method
+func goFuncName(fn *ast.FuncDecl) string {
+	if fn.Recv == nil || len(fn.Recv.List) == 0 {
+		return fn.Name.Name
+	}
+	return "(" + goTypeName(fn.Recv.List[0].Type) + ")." + fn.Name.Name
+}
+
+func goTypeName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return "*" + goTypeName(t.X)
+	case *ast.Ident:
+		return t.Name
+	case *ast.IndexExpr: // tipos genéricos: T[K]
+		return goTypeName(t.X)
+	case *ast.IndexListExpr:
+		return goTypeName(t.X)
+	case *ast.SelectorExpr:
+		return goTypeName(t.X) + "." + t.Sel.Name
+	}
+	return "?"
+}
+
+// goCyclomatic es 1 + cada if, for, case y operador && o ||
+func goCyclomatic(fn *ast.FuncDecl) int {
+	complexity := 1
+	ast.Inspect(fn.Body, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.IfStmt, *ast.ForStmt, *ast.RangeStmt:
+			complexity++
+		case *ast.CaseClause:
+			if n.List != nil { // default no suma
+				complexity++
+			}
+		case *ast.CommClause:
+			if n.Comm != nil {
+				complexity++
+			}
+		case *ast.BinaryExpr:
+			if n.Op == token.LAND || n.Op == token.LOR {
+				complexity++
+			}
+		}
+		return true
+	})
+	return complexity
+}
+
+// cognitiveVisitor calcula la complejidad cognitiva (SonarSource): cada estructura de control
+// suma 1 más su nivel de anidamiento; else, etiquetas, secuencias de operadores lógicos y
+// la recursión suman 1 sin anidamiento
+type cognitiveVisitor struct {
+	name       string
+	receiver   string
+	complexity int
+	nesting    int
+	seen       map[ast.Expr]bool // expresiones lógicas ya contadas como parte de una secuencia
+}
+
+func goCognitive(fn *ast.FuncDecl) int {
+	visitor := &cognitiveVisitor{name: fn.Name.Name, seen: make(map[ast.Expr]bool)}
+	if fn.Recv != nil && len(fn.Recv.List) > 0 && len(fn.Recv.List[0].Names) > 0 {
+		visitor.receiver = fn.Recv.List[0].Names[0].Name
+	}
+	ast.Walk(visitor, fn.Body)
+	return visitor.complexity
+}
+
+func (v *cognitiveVisitor) Visit(node ast.Node) ast.Visitor {
+	switch n := node.(type) {
+	case *ast.IfStmt:
+		v.complexity += 1 + v.nesting
+		v.visitIf(n)
+		return nil
+
+	case *ast.SwitchStmt:
+		v.complexity += 1 + v.nesting
+		v.walk(n.Init)
+		v.walk(n.Tag)
+		v.nested(n.Body)
+		return nil
+
+	case *ast.TypeSwitchStmt:
+		v.complexity += 1 + v.nesting
+		v.walk(n.Init)
+		v.walk(n.Assign)
+		v.nested(n.Body)
+		return nil
+
+	case *ast.SelectStmt:
+		v.complexity += 1 + v.nesting
+		v.nested(n.Body)
+		return nil
+
+	case *ast.ForStmt:
+		v.complexity += 1 + v.nesting
+		v.walk(n.Init)
+		v.walk(n.Cond)
+		v.walk(n.Post)
+		v.nested(n.Body)
+		return nil
+
+	case *ast.RangeStmt:
+		v.complexity += 1 + v.nesting
+		v.walk(n.X)
+		v.nested(n.Body)
+		return nil
+
+	case *ast.FuncLit:
+		// Las funciones anónimas no suman, pero anidan lo que contienen
+		v.nested(n.Body)
+		return nil
+
+	case *ast.BranchStmt:
+		if n.Label != nil { // break/continue con etiqueta y goto
+			v.complexity++
+		}
+
+	case *ast.BinaryExpr:
+		if (n.Op == token.LAND || n.Op == token.LOR) && !v.seen[n] {
+			v.complexity += v.logicalSequences(n)
+		}
+
+	case *ast.CallExpr:
+		if v.isRecursive(n) {
+			v.complexity++
+		}
+	}
+	return v
+}
+
+// visitIf recorre un if y su cadena de else if / else, que suman 1 sin anidamiento
+func (v *cognitiveVisitor) visitIf(n *ast.IfStmt) {
+	v.walk(n.Init)
+	v.walk(n.Cond)
+	v.nested(n.Body)
+
+	switch elseNode := n.Else.(type) {
+	case *ast.IfStmt:
+		v.complexity++
+		v.visitIf(elseNode)
+	case *ast.BlockStmt:
+		v.complexity++
+		v.nested(elseNode)
+	}
+}
+
+// walk ignora los campos opcionales vacíos, que llegan como punteros nil dentro de ast.Node
+func (v *cognitiveVisitor) walk(node ast.Node) {
+	if node != nil && !reflect.ValueOf(node).IsNil() {
+		ast.Walk(v, node)
+	}
+}
+
+func (v *cognitiveVisitor) nested(node ast.Node) {
+	v.nesting++
+	v.walk(node)
+	v.nesting--
+}
+
+// logicalSequences cuenta las secuencias de operadores lógicos iguales: a && b && c suma 1,
+// a && b || c suma 2. Marca las subexpresiones para no contarlas otra vez.
+func (v *cognitiveVisitor) logicalSequences(expr *ast.BinaryExpr) int {
+	var operators []token.Token
+	var flatten func(ast.Expr)
+	flatten = func(e ast.Expr) {
+		binary, ok := e.(*ast.BinaryExpr)
+		if !ok || (binary.Op != token.LAND && binary.Op != token.LOR) {
+			return
+		}
+		v.seen[binary] = true
+		flatten(binary.X)
+		operators = append(operators, binary.Op)
+		flatten(binary.Y)
+	}
+	flatten(expr)
+
+	sequences := 0
+	for i, op := range operators {
+		if i == 0 || op != operators[i-1] {
+			sequences++
+		}
+	}
+	return sequences
+}
+
+// isRecursive reconoce llamadas a la propia función, o al propio método a través del receptor
+func (v *cognitiveVisitor) isRecursive(call *ast.CallExpr) bool {
+	switch fn := call.Fun.(type) {
+	case *ast.Ident:
+		return v.receiver == "" && fn.Name == v.name
+	case *ast.SelectorExpr:
+		receiver, ok := fn.X.(*ast.Ident)
+		return ok && v.receiver != "" && receiver.Name == v.receiver && fn.Sel.Name == v.name
+	}
+	return false
+}
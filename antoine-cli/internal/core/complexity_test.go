@@ -0,0 +1,180 @@
+package core
+
+import "testing"
+
+func TestGoFunctionComplexity(t *testing.T) {
+	tests := []struct {
+		name       string
+		src        string
+		function   string
+		cyclomatic int
+		cognitive  int
+	}{
+		{
+			name:       "straight line",
+			src:        `func f() int { return 1 }`,
+			function:   "f",
+			cyclomatic: 1,
+			cognitive:  0,
+		},
+		{
+			name: "else if chain",
+			src: `func sign(x int) int {
+	if x > 0 {
+		return 1
+	} else if x < 0 {
+		return -1
+	} else {
+		return 0
+	}
+}`,
+			function:   "sign",
+			cyclomatic: 3, // cada if
+			cognitive:  3, // if, else if y else suman 1 sin anidamiento
+		},
+		{
+			name:       "same logical operator",
+			src:        `func all(a, b, c bool) bool { return a && b && c }`,
+			function:   "all",
+			cyclomatic: 3,
+			cognitive:  1,
+		},
+		{
+			name:       "mixed logical operators",
+			src:        `func mixed(a, b, c, d bool) bool { return a && b || c && d }`,
+			function:   "mixed",
+			cyclomatic: 4,
+			cognitive:  3, // && || && cambia de operador dos veces
+		},
+		{
+			name: "parenthesized sequence",
+			src: `func guard(a, b, c bool) int {
+	if a && (b || c) {
+		return 1
+	}
+	return 0
+}`,
+			function:   "guard",
+			cyclomatic: 4,
+			cognitive:  3,
+		},
+		{
+			name: "nesting increments",
+			src: `func positive(items [][]int) int {
+	total := 0
+	for _, row := range items {
+		for _, v := range row {
+			if v > 0 {
+				total += v
+			}
+		}
+	}
+	return total
+}`,
+			function:   "positive",
+			cyclomatic: 4,
+			cognitive:  6, // 1 + 2 + 3
+		},
+		{
+			name: "switch inside if",
+			src: `func label(x int) string {
+	if x > 0 {
+		switch x {
+		case 1:
+			return "one"
+		case 2, 3:
+			return "few"
+		default:
+		}
+	}
+	return ""
+}`,
+			function:   "label",
+			cyclomatic: 4, // default no suma
+			cognitive:  3,
+		},
+		{
+			name: "labeled break and continue",
+			src: `func scan(grid [][]int) int {
+outer:
+	for _, row := range grid {
+		for _, v := range row {
+			if v < 0 {
+				continue outer
+			}
+			if v == 0 {
+				break outer
+			}
+			if v > 9 {
+				break
+			}
+		}
+	}
+	return 0
+}`,
+			function:   "scan",
+			cyclomatic: 6,
+			cognitive:  14, // 1 + 2 + 3×3 + 1 por cada salto con etiqueta
+		},
+		{
+			name: "recursion",
+			src: `func fact(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	return n * fact(n-1)
+}`,
+			function:   "fact",
+			cyclomatic: 2,
+			cognitive:  2,
+		},
+		{
+			name: "method recursion through the receiver",
+			src: `type counter struct{ other *counter }
+
+func (c *counter) count(n int) int {
+	if n == 0 {
+		return c.other.count(0)
+	}
+	return 1 + c.count(n-1)
+}`,
+			function:   "(*counter).count",
+			cyclomatic: 2,
+			cognitive:  2, // c.other.count es otro receptor
+		},
+		{
+			name: "func literal nests its body",
+			src: `func summer(values []int) func() int {
+	return func() int {
+		total := 0
+		for _, v := range values {
+			if v > 0 {
+				total += v
+			}
+		}
+		return total
+	}
+}`,
+			function:   "summer",
+			cyclomatic: 3,
+			cognitive:  5, // 2 + 3
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			functions, err := GoFunctionComplexity("f.go", []byte("package p\n\n"+tt.src+"\n"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(functions) != 1 {
+				t.Fatalf("functions = %+v, want one", functions)
+			}
+			got := functions[0]
+			if got.Name != tt.function || got.Cyclomatic != tt.cyclomatic || got.Cognitive != tt.cognitive {
+				t.Errorf("%s: cyclomatic %d, cognitive %d; want %s: %d, %d",
+					got.Name, got.Cyclomatic, got.Cognitive, tt.function, tt.cyclomatic, tt.cognitive)
+			}
+		})
+	}
+}
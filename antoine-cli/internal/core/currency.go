@@ -0,0 +1,354 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"antoine-cli/internal/config"
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/utils"
+)
+
+// ratesFile guarda la tabla de cambios editable dentro de ~/.antoine
+const ratesFile = "rates.json"
+
+// RateTable son los tipos de cambio: unidades de cada moneda por una unidad de Base
+type RateTable struct {
+	Base      string             `json:"base"`
+	Rates     map[string]float64 `json:"rates"`
+	Source    string             `json:"source"` // bundled, manual o la URL de la última actualización
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// DefaultRateTable devuelve la tabla incluida en el binario, para trabajar sin conexión.
+// Incluye los tokens más habituales en premios de hackathons web3.
+func DefaultRateTable() *RateTable {
+	return &RateTable{
+		Base: "USD",
+		Rates: map[string]float64{
+			"USD": 1, "EUR": 0.92, "GBP": 0.79, "CHF": 0.88, "CAD": 1.36, "AUD": 1.52,
+			"NZD": 1.65, "JPY": 150, "CNY": 7.2, "HKD": 7.8, "SGD": 1.34, "KRW": 1350,
+			"INR": 83, "BRL": 5.0, "MXN": 17, "ARS": 870, "CLP": 950, "COP": 3900,
+			"SEK": 10.5, "NOK": 10.6, "DKK": 6.9, "PLN": 4.0, "CZK": 23, "HUF": 360,
+			"RON": 4.6, "TRY": 32, "ILS": 3.7, "AED": 3.67, "SAR": 3.75, "ZAR": 18.5,
+			"NGN": 1500, "KES": 130, "EGP": 48, "IDR": 15700, "MYR": 4.7, "THB": 36,
+			"PHP": 56, "VND": 25000, "TWD": 32,
+			// Stablecoins y tokens
+			"USDC": 1, "USDT": 1, "DAI": 1, "ETH": 0.00031, "BTC": 0.000016, "SOL": 0.0067,
+			"MATIC": 1.4, "POL": 1.4, "OP": 0.4, "ARB": 1.0, "NEAR": 0.18, "AVAX": 0.028,
+			"DOT": 0.14, "ATOM": 0.1, "APT": 0.11, "SUI": 0.6,
+		},
+		Source:    "bundled",
+		UpdatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+// LoadRateTable carga ~/.antoine/rates.json, o la tabla incluida si no existe
+func LoadRateTable() (*RateTable, error) {
+	path, err := DataPath(ratesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	table := &RateTable{}
+	found, err := readJSONFile(path, table)
+	if err != nil {
+		return nil, err
+	}
+	if !found || len(table.Rates) == 0 {
+		return DefaultRateTable(), nil
+	}
+
+	table.Base = strings.ToUpper(table.Base)
+	table.Rates = normalizeRateKeys(table.Rates)
+	table.Rates[table.Base] = 1
+	return table, nil
+}
+
+// SaveRateTable guarda la tabla y la activa para las siguientes conversiones
+func SaveRateTable(table *RateTable) error {
+	path, err := DataPath(ratesFile)
+	if err != nil {
+		return err
+	}
+	if err := writeJSONFile(path, table); err != nil {
+		return err
+	}
+	resetPrizeNormalizer()
+	return nil
+}
+
+// ResetRateTable borra la tabla local y vuelve a la incluida
+func ResetRateTable() error {
+	path, err := DataPath(ratesFile)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to remove %s: %w", path, err)
+	}
+	resetPrizeNormalizer()
+	return nil
+}
+
+// Convert pasa amount de la moneda from a la moneda to
+func (t *RateTable) Convert(amount float64, from, to string) (float64, bool) {
+	fromRate, ok := t.Rates[strings.ToUpper(from)]
+	if !ok || fromRate <= 0 {
+		return 0, false
+	}
+	toRate, ok := t.Rates[strings.ToUpper(to)]
+	if !ok || toRate <= 0 {
+		return 0, false
+	}
+	return amount / fromRate * toRate, true
+}
+
+// Set fija el cambio de una moneda (unidades por una unidad de Base)
+func (t *RateTable) Set(currency string, rate float64) error {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if currency == "" || rate <= 0 || math.IsInf(rate, 0) || math.IsNaN(rate) {
+		return fmt.Errorf("invalid rate %v for %q: must be a positive number", rate, currency)
+	}
+	if currency == t.Base && rate != 1 {
+		return fmt.Errorf("%s is the table base: its rate is always 1", t.Base)
+	}
+	t.Rates[currency] = rate
+	t.Source = "manual"
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// Currencies devuelve las monedas de la tabla ordenadas
+func (t *RateTable) Currencies() []string {
+	currencies := make([]string, 0, len(t.Rates))
+	for currency := range t.Rates {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+	return currencies
+}
+
+// RefreshRates descarga los cambios de url ({"base": ..., "rates": {...}}, formato de
+// frankfurter.app, exchangerate.host u open.er-api.com) y los combina con la tabla local.
+// Las monedas que la fuente no incluye (p. ej. tokens) se convierten a la nueva base y se mantienen.
+func RefreshRates(ctx context.Context, url string) (*RateTable, error) {
+	if url == "" {
+		return nil, fmt.Errorf("no rates URL: set search.prizes.rates_url or pass --url")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rates request: %w", err)
+	}
+
+	start := time.Now()
+	resp, err := (&http.Client{Timeout: 15 * time.Second}).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch rates: %w", err)
+	}
+	defer resp.Body.Close()
+	utils.LogHTTPRequest(http.MethodGet, url, resp.StatusCode, time.Since(start))
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("rates source returned %s", resp.Status)
+	}
+
+	var payload struct {
+		Base     string             `json:"base"`
+		BaseCode string             `json:"base_code"`
+		Rates    map[string]float64 `json:"rates"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&payload); err != nil {
+		return nil, fmt.Errorf("failed to decode rates: %w", err)
+	}
+
+	base := strings.ToUpper(payload.Base)
+	if base == "" {
+		base = strings.ToUpper(payload.BaseCode)
+	}
+	if base == "" || len(payload.Rates) == 0 {
+		return nil, fmt.Errorf("rates source returned no base or rates")
+	}
+
+	current, err := LoadRateTable()
+	if err != nil {
+		return nil, err
+	}
+
+	table := &RateTable{Base: base, Rates: normalizeRateKeys(payload.Rates), Source: url, UpdatedAt: time.Now()}
+	table.Rates[base] = 1
+	for currency := range current.Rates {
+		if _, ok := table.Rates[currency]; ok {
+			continue
+		}
+		if rate, ok := current.Convert(1, base, currency); ok {
+			table.Rates[currency] = rate
+		}
+	}
+
+	if err := SaveRateTable(table); err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+func normalizeRateKeys(rates map[string]float64) map[string]float64 {
+	normalized := make(map[string]float64, len(rates))
+	for currency, rate := range rates {
+		if rate > 0 {
+			normalized[strings.ToUpper(currency)] = rate
+		}
+	}
+	return normalized
+}
+
+// PrizeNormalizer convierte los premios a la moneda base configurada
+type PrizeNormalizer struct {
+	table *RateTable
+	base  string
+}
+
+// NormalizedPrize es un premio expresado en la moneda base
+type NormalizedPrize struct {
+	Amount      float64 `json:"amount"`
+	Currency    string  `json:"currency"`
+	Known       bool    `json:"known"` // false si la moneda original no está en la tabla
+	NonMonetary int     `json:"non_monetary"`
+}
+
+var (
+	prizeMu         sync.Mutex
+	prizeNormalizer *PrizeNormalizer
+)
+
+// NewPrizeNormalizer crea un normalizador a base con la tabla dada
+func NewPrizeNormalizer(table *RateTable, base string) *PrizeNormalizer {
+	base = strings.ToUpper(base)
+	if base == "" {
+		base = table.Base
+	}
+	return &PrizeNormalizer{table: table, base: base}
+}
+
+// Prizes devuelve el normalizador con search.prizes.base_currency y la tabla local
+func Prizes() *PrizeNormalizer {
+	prizeMu.Lock()
+	defer prizeMu.Unlock()
+
+	if prizeNormalizer == nil {
+		table, err := LoadRateTable()
+		if err != nil {
+			utils.WithError(err).Debug("Failed to load rate table, using bundled rates")
+			table = DefaultRateTable()
+		}
+		prizeNormalizer = NewPrizeNormalizer(table, config.Get().Search.Prizes.BaseCurrency)
+	}
+	return prizeNormalizer
+}
+
+func resetPrizeNormalizer() {
+	prizeMu.Lock()
+	prizeNormalizer = nil
+	prizeMu.Unlock()
+}
+
+// Base devuelve la moneda a la que se normaliza
+func (n *PrizeNormalizer) Base() string {
+	return n.base
+}
+
+// Normalize convierte el premio a la moneda base; sin moneda se asume USD
+func (n *PrizeNormalizer) Normalize(prize models.PrizeInfo) NormalizedPrize {
+	normalized := NormalizedPrize{Currency: n.base, NonMonetary: len(prize.NonMonetary)}
+	if prize.Total <= 0 {
+		normalized.Known = true
+		return normalized
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(prize.Currency))
+	if currency == "" || currency == "$" {
+		currency = "USD"
+	}
+	normalized.Amount, normalized.Known = n.table.Convert(float64(prize.Total), currency, n.base)
+	return normalized
+}
+
+// Amount devuelve el premio en la moneda base (0 si la moneda es desconocida)
+func (n *PrizeNormalizer) Amount(hackathon *models.Hackathon) float64 {
+	return n.Normalize(hackathon.PrizePool).Amount
+}
+
+// Format muestra el premio normalizado de forma compacta ($12.5K, €800, 3 non-cash)
+func (n *PrizeNormalizer) Format(hackathon *models.Hackathon) string {
+	prize := n.Normalize(hackathon.PrizePool)
+
+	var parts []string
+	switch {
+	case !prize.Known:
+		parts = append(parts, fmt.Sprintf("%d %s?", hackathon.PrizePool.Total, strings.ToUpper(hackathon.PrizePool.Currency)))
+	case prize.Amount > 0:
+		parts = append(parts, FormatMoney(prize.Amount, prize.Currency))
+	}
+	if prize.NonMonetary > 0 {
+		parts = append(parts, fmt.Sprintf("+%d", prize.NonMonetary))
+	}
+	if len(parts) == 0 {
+		return "N/A"
+	}
+	return strings.Join(parts, " ")
+}
+
+// FilterByPrize deja los hackathons cuyo premio en la moneda base llega a minimum.
+// Los de moneda desconocida no se pueden comparar y se descartan.
+func (n *PrizeNormalizer) FilterByPrize(hackathons []*models.Hackathon, minimum float64) []*models.Hackathon {
+	if minimum <= 0 {
+		return hackathons
+	}
+
+	var kept []*models.Hackathon
+	for _, hackathon := range hackathons {
+		prize := n.Normalize(hackathon.PrizePool)
+		if !prize.Known {
+			utils.WithField("currency", hackathon.PrizePool.Currency).Debug("Unknown prize currency, excluded by prize filter")
+			continue
+		}
+		if prize.Amount >= minimum {
+			kept = append(kept, hackathon)
+		}
+	}
+	return kept
+}
+
+// currencySymbols son los símbolos que se muestran en lugar del código
+var currencySymbols = map[string]string{"USD": "$", "EUR": "€", "GBP": "£", "JPY": "¥", "INR": "₹"}
+
+// FormatMoney abrevia una cantidad en la moneda dada ($1.2M, €800, 12K CHF)
+func FormatMoney(amount float64, currency string) string {
+	var value string
+	switch {
+	case amount >= 1e9:
+		value = fmt.Sprintf("%.1fB", amount/1e9)
+	case amount >= 1e6:
+		value = fmt.Sprintf("%.1fM", amount/1e6)
+	case amount >= 1e4:
+		value = fmt.Sprintf("%.0fK", amount/1e3)
+	case amount >= 1e3:
+		value = fmt.Sprintf("%.1fK", amount/1e3)
+	default:
+		value = fmt.Sprintf("%.0f", amount)
+	}
+
+	if symbol, ok := currencySymbols[strings.ToUpper(currency)]; ok {
+		return symbol + value
+	}
+	return value + " " + strings.ToUpper(currency)
+}
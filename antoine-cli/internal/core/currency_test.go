@@ -0,0 +1,207 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"antoine-cli/internal/models"
+)
+
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-6*math.Max(1, math.Abs(b))
+}
+
+func TestRateTableConvert(t *testing.T) {
+	table := DefaultRateTable()
+	tests := []struct {
+		name     string
+		amount   float64
+		from, to string
+		want     float64
+		ok       bool
+	}{
+		{"to base", 920, "EUR", "USD", 1000, true},
+		{"from base", 1000, "USD", "JPY", 150000, true},
+		{"cross rate through the base", 100, "EUR", "GBP", 100 / 0.92 * 0.79, true},
+		{"zero-decimal to zero-decimal", 150000, "JPY", "KRW", 1350000, true},
+		{"lowercase codes", 1, "usdc", "usd", 1, true},
+		{"token", 1, "ETH", "USD", 1 / 0.00031, true},
+		{"unknown source", 1, "XYZ", "USD", 0, false},
+		{"unknown target", 1, "USD", "XYZ", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := table.Convert(tt.amount, tt.from, tt.to)
+			if ok != tt.ok || !approxEqual(got, tt.want) {
+				t.Errorf("Convert(%v, %s, %s) = %v, %v; want %v, %v", tt.amount, tt.from, tt.to, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestRefreshRatesNormalizesKeys(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Cleanup(resetPrizeNormalizer)
+
+	// Algunas fuentes devuelven códigos en minúsculas; los cambios no positivos se descartan
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"base_code": "eur", "rates": {"usd": 1.1, "Gbp": 0.85, "bad": 0}}`)
+	}))
+	defer server.Close()
+
+	table, err := RefreshRates(context.Background(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]float64{
+		"EUR": 1,
+		"USD": 1.1,
+		"GBP": 0.85,
+		// Lo que la fuente no trae se convierte desde la tabla anterior a la nueva base
+		"JPY": 150 / 0.92,
+		"ETH": 0.00031 / 0.92,
+	}
+	if table.Base != "EUR" {
+		t.Errorf("base = %q, want EUR", table.Base)
+	}
+	for currency, rate := range want {
+		if got := table.Rates[currency]; !approxEqual(got, rate) {
+			t.Errorf("rate %s = %v, want %v", currency, got, rate)
+		}
+	}
+	for _, currency := range []string{"usd", "BAD", "bad"} {
+		if _, ok := table.Rates[currency]; ok {
+			t.Errorf("rate %q kept", currency)
+		}
+	}
+
+	// La tabla guardada se vuelve a cargar igual
+	loaded, err := LoadRateTable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Base != "EUR" || !approxEqual(loaded.Rates["GBP"], 0.85) || loaded.Source != server.URL {
+		t.Errorf("loaded table = %+v", loaded)
+	}
+}
+
+func TestLoadRateTableNormalizesKeys(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	// rates.json es editable a mano
+	path := filepath.Join(home, ".antoine", ratesFile)
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(`{"base": "gbp", "rates": {"usd": 1.27, "jpy": 190}}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	table, err := LoadRateTable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if table.Base != "GBP" || table.Rates["GBP"] != 1 || table.Rates["USD"] != 1.27 || table.Rates["JPY"] != 190 {
+		t.Errorf("table = %+v", table)
+	}
+	if got, ok := table.Convert(1.27, "usd", "jpy"); !ok || !approxEqual(got, 190) {
+		t.Errorf("Convert(1.27, usd, jpy) = %v, %v", got, ok)
+	}
+}
+
+func TestFormatMoney(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		currency string
+		want     string
+	}{
+		{12000, "USD", "$12K"},
+		{1500, "eur", "€1.5K"},
+		{2500000, "GBP", "£2.5M"},
+		// Monedas sin decimales: nunca se muestran fracciones
+		{500, "JPY", "¥500"},
+		{150000, "JPY", "¥150K"},
+		{1350000, "KRW", "1.4M KRW"},
+		{999, "CLP", "999 CLP"},
+		{0, "USD", "$0"},
+		{3e9, "IDR", "3.0B IDR"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatMoney(tt.amount, tt.currency); got != tt.want {
+			t.Errorf("FormatMoney(%v, %s) = %q, want %q", tt.amount, tt.currency, got, tt.want)
+		}
+	}
+}
+
+func TestPrizeNormalizer(t *testing.T) {
+	normalizer := NewPrizeNormalizer(DefaultRateTable(), "eur")
+	if normalizer.Base() != "EUR" {
+		t.Fatalf("base = %q, want EUR", normalizer.Base())
+	}
+
+	tests := []struct {
+		name  string
+		prize models.PrizeInfo
+		want  NormalizedPrize
+	}{
+		{"usd", models.PrizeInfo{Total: 1000, Currency: "USD"}, NormalizedPrize{Amount: 920, Currency: "EUR", Known: true}},
+		{"no currency is usd", models.PrizeInfo{Total: 1000}, NormalizedPrize{Amount: 920, Currency: "EUR", Known: true}},
+		{"dollar sign", models.PrizeInfo{Total: 1000, Currency: "$"}, NormalizedPrize{Amount: 920, Currency: "EUR", Known: true}},
+		{"lowercase yen", models.PrizeInfo{Total: 150000, Currency: " jpy "}, NormalizedPrize{Amount: 920, Currency: "EUR", Known: true}},
+		{"unknown currency", models.PrizeInfo{Total: 500, Currency: "XYZ"}, NormalizedPrize{Currency: "EUR"}},
+		{"no cash prize", models.PrizeInfo{NonMonetary: []string{"mentoring", "swag"}}, NormalizedPrize{Currency: "EUR", Known: true, NonMonetary: 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := normalizer.Normalize(tt.prize)
+			if !approxEqual(got.Amount, tt.want.Amount) || got.Currency != tt.want.Currency ||
+				got.Known != tt.want.Known || got.NonMonetary != tt.want.NonMonetary {
+				t.Errorf("Normalize(%+v) = %+v, want %+v", tt.prize, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFilterByPrize(t *testing.T) {
+	hackathons := []*models.Hackathon{
+		{Name: "dollars", PrizePool: models.PrizeInfo{Total: 5000, Currency: "USD"}},
+		{Name: "euros", PrizePool: models.PrizeInfo{Total: 4000, Currency: "EUR"}},
+		{Name: "yen", PrizePool: models.PrizeInfo{Total: 100000, Currency: "JPY"}},
+		{Name: "unknown", PrizePool: models.PrizeInfo{Total: 1000000, Currency: "XYZ"}},
+		{Name: "no prize"},
+	}
+	normalizer := NewPrizeNormalizer(DefaultRateTable(), "USD")
+
+	tests := []struct {
+		name    string
+		minimum float64
+		want    []string
+	}{
+		{"no minimum keeps everything", 0, []string{"dollars", "euros", "yen", "unknown", "no prize"}},
+		{"compared in the base currency", 1000, []string{"dollars", "euros"}},
+		{"inclusive minimum", 5000, []string{"dollars"}},
+		{"nothing reaches it", 1e6, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, hackathon := range normalizer.FilterByPrize(hackathons, tt.minimum) {
+				got = append(got, hackathon.Name)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("FilterByPrize(%v) = %v, want %v", tt.minimum, got, tt.want)
+			}
+		})
+	}
+}
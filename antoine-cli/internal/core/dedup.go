@@ -0,0 +1,539 @@
+package core
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"antoine-cli/internal/models"
+)
+
+// Claves de Metadata que escribe la resolución de duplicados
+const (
+	MetadataSources = "sources" // []HackathonSource: de dónde salió cada registro fusionado
+	MetadataSource  = "source"  // origen de un registro suelto, si el servidor lo indica
+)
+
+// HackathonSource es la procedencia de uno de los registros fusionados en un hackathon
+type HackathonSource struct {
+	Source string `json:"source"` // exa, devpost, mlh... o el dominio de la URL
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name"`
+	URL    string `json:"url,omitempty"`
+}
+
+// nameStopWords no ayudan a distinguir eventos ("ETHGlobal Hackathon" = "ETHGlobal Hack")
+var nameStopWords = map[string]bool{
+	"hackathon": true, "hack": true, "hacks": true, "the": true, "a": true, "of": true,
+	"and": true, "edition": true, "event": true, "online": true, "virtual": true,
+}
+
+// trackingParams se eliminan al canonicalizar URLs
+var trackingParams = []string{"utm_", "ref", "fbclid", "gclid", "mc_", "source"}
+
+// listingHosts publican muchos eventos bajo la misma URL (listados, portadas, formularios),
+// así que compartirla no identifica un evento. Los subdominios propios (junction.devpost.com) sí cuentan.
+var listingHosts = map[string]bool{
+	"devpost.com": true, "mlh.io": true, "lu.ma": true, "eventbrite.com": true, "meetup.com": true,
+	"devfolio.co": true, "dorahacks.io": true, "taikai.network": true, "hackathon.com": true,
+	"hackerearth.com": true, "unstop.com": true, "linkedin.com": true, "twitter.com": true, "x.com": true,
+	"facebook.com": true, "instagram.com": true, "docs.google.com": true, "forms.gle": true,
+}
+
+// ResolveHackathons agrupa los registros que describen el mismo evento (nombre parecido,
+// misma URL canónica, fechas que se solapan, mismo organizador) y los fusiona en uno.
+// source es el origen por defecto de los registros sin Metadata["source"]; vacío usa el dominio
+// de cada URL. Conserva el orden.
+func ResolveHackathons(hackathons []*models.Hackathon, source string) []*models.Hackathon {
+	if len(hackathons) < 2 {
+		for _, hackathon := range hackathons {
+			recordProvenance(hackathon, []*models.Hackathon{hackathon}, source)
+		}
+		return hackathons
+	}
+
+	keys := make([]resolutionKey, len(hackathons))
+	for i, hackathon := range hackathons {
+		keys[i] = newResolutionKey(hackathon)
+	}
+
+	// Cada registro se une al primer grupo en el que se parece a alguno de sus miembros y no
+	// contradice a ninguno: así un registro puente no junta dos ediciones con fechas distintas
+	var clusters [][]int
+	for i := range hackathons {
+		joined := false
+		for c, members := range clusters {
+			matches, conflict := false, false
+			for _, j := range members {
+				if conflictingHackathons(hackathons[i], hackathons[j], keys[i], keys[j]) {
+					conflict = true
+					break
+				}
+				matches = matches || sameHackathon(hackathons[i], hackathons[j], keys[i], keys[j])
+			}
+			if matches && !conflict {
+				clusters[c] = append(clusters[c], i)
+				joined = true
+				break
+			}
+		}
+		if !joined {
+			clusters = append(clusters, []int{i})
+		}
+	}
+
+	resolved := make([]*models.Hackathon, 0, len(clusters))
+	for _, members := range clusters {
+		group := make([]*models.Hackathon, len(members))
+		for k, i := range members {
+			group[k] = hackathons[i]
+		}
+		resolved = append(resolved, mergeHackathons(group, source))
+	}
+	return resolved
+}
+
+// HackathonSources devuelve la procedencia guardada en Metadata (también tras leerla de JSON)
+func HackathonSources(hackathon *models.Hackathon) []HackathonSource {
+	raw, ok := hackathon.Metadata[MetadataSources]
+	if !ok {
+		return nil
+	}
+	if sources, ok := raw.([]HackathonSource); ok {
+		return sources
+	}
+
+	var sources []HackathonSource
+	if data, err := json.Marshal(raw); err == nil {
+		_ = json.Unmarshal(data, &sources)
+	}
+	return sources
+}
+
+// resolutionKey son los datos normalizados de un registro para compararlo con otros
+type resolutionKey struct {
+	tokens    []string
+	compact   string
+	urls      []string
+	organizer string
+	city      string
+}
+
+func newResolutionKey(hackathon *models.Hackathon) resolutionKey {
+	key := resolutionKey{
+		tokens:    nameTokens(hackathon.Name),
+		organizer: normalizePlace(hackathon.Organizer.Name),
+		city:      normalizePlace(hackathon.Location.City),
+	}
+	key.compact = strings.Join(key.tokens, "")
+
+	for _, raw := range []string{hackathon.URL, hackathon.RegistrationURL} {
+		if canonical := CanonicalURL(raw); canonical != "" && !listingHosts[strings.SplitN(canonical, "/", 2)[0]] {
+			key.urls = append(key.urls, canonical)
+		}
+	}
+	return key
+}
+
+// conflictingHackathons indica si dos registros no pueden ser el mismo evento, se parezcan o no
+func conflictingHackathons(a, b *models.Hackathon, ka, kb resolutionKey) bool {
+	// Fechas que no se solapan son ediciones distintas aunque compartan web
+	if overlap, known := datesOverlap(a, b); known && !overlap {
+		return true
+	}
+	// Dos eventos presenciales en ciudades distintas tampoco son el mismo
+	return ka.city != "" && kb.city != "" && ka.city != kb.city &&
+		!strings.EqualFold(a.Location.Type, "online") && !strings.EqualFold(b.Location.Type, "online")
+}
+
+// sameHackathon decide si dos registros son el mismo evento
+func sameHackathon(a, b *models.Hackathon, ka, kb resolutionKey) bool {
+	if conflictingHackathons(a, b, ka, kb) {
+		return false
+	}
+	overlap, _ := datesOverlap(a, b)
+
+	for _, u := range ka.urls {
+		for _, v := range kb.urls {
+			if u == v {
+				return true
+			}
+		}
+	}
+
+	similarity := nameSimilarity(ka, kb)
+	sameOrganizer := ka.organizer != "" && ka.organizer == kb.organizer
+
+	switch {
+	case similarity >= 0.9:
+		return true
+	case similarity >= 0.75:
+		return overlap || sameOrganizer
+	case similarity >= 0.6:
+		return overlap && sameOrganizer
+	}
+	return false
+}
+
+// datesOverlap indica si los rangos de fechas se solapan (con un día de margen por zonas horarias).
+// known es false si a alguno le falta la fecha de inicio.
+func datesOverlap(a, b *models.Hackathon) (overlap, known bool) {
+	if a.StartDate.IsZero() || b.StartDate.IsZero() {
+		return false, false
+	}
+	endOf := func(h *models.Hackathon) time.Time {
+		if h.EndDate.After(h.StartDate) {
+			return h.EndDate
+		}
+		return h.StartDate
+	}
+	margin := 24 * time.Hour
+	return !a.StartDate.After(endOf(b).Add(margin)) && !b.StartDate.After(endOf(a).Add(margin)), true
+}
+
+// nameTokens normaliza un nombre: minúsculas, sin acentos ni puntuación ni palabras vacías
+func nameTokens(name string) []string {
+	name = normalizePlace(name)
+	fields := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	var tokens []string
+	for _, field := range fields {
+		if !nameStopWords[field] {
+			tokens = append(tokens, field)
+		}
+	}
+	return tokens
+}
+
+// nameSimilarity combina solapamiento de palabras y distancia de edición sobre el nombre compacto,
+// para que "ETH Global London" y "ETHGlobal London 2026" se parezcan
+func nameSimilarity(a, b resolutionKey) float64 {
+	if a.compact == "" || b.compact == "" {
+		return 0
+	}
+	if a.compact == b.compact {
+		return 1
+	}
+	// Números distintos en ambos nombres son eventos distintos ("Season 3" y "Season 4")
+	if numbers, other := nameNumbers(a.tokens), nameNumbers(b.tokens); len(numbers) > 0 && len(other) > 0 &&
+		strings.Join(numbers, " ") != strings.Join(other, " ") {
+		return 0
+	}
+
+	set := make(map[string]bool, len(a.tokens))
+	for _, token := range a.tokens {
+		set[token] = true
+	}
+	shared := 0
+	union := len(set)
+	for _, token := range uniqueStrings(b.tokens) {
+		if set[token] {
+			shared++
+		} else {
+			union++
+		}
+	}
+	jaccard := float64(shared) / float64(union)
+
+	distance := levenshtein(a.compact, b.compact)
+	edit := 1 - float64(distance)/float64(max(len([]rune(a.compact)), len([]rune(b.compact))))
+
+	// Un nombre contenido en el otro ("Junction" en "Junction 2026") cuenta como muy parecido
+	shorter := min(len(a.compact), len(b.compact))
+	if shorter >= 5 && (strings.Contains(a.compact, b.compact) || strings.Contains(b.compact, a.compact)) {
+		edit = max(edit, 0.8)
+	}
+	return max(jaccard, edit)
+}
+
+// levenshtein calcula la distancia de edición entre dos cadenas
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	previous := make([]int, len(rb)+1)
+	current := make([]int, len(rb)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		current[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(rb)]
+}
+
+// CanonicalURL normaliza una URL para comparar: sin esquema, www, fragmento,
+// parámetros de seguimiento ni barra final. Devuelve "" si no es una URL válida.
+func CanonicalURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return ""
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host == "" {
+		return ""
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www.")
+	path := strings.TrimRight(parsed.EscapedPath(), "/")
+	for _, suffix := range []string{"/index.html", "/index.htm"} {
+		path = strings.TrimSuffix(path, suffix)
+	}
+
+	query := parsed.Query()
+	for param := range query {
+		lower := strings.ToLower(param)
+		for _, tracking := range trackingParams {
+			if lower == tracking || (strings.HasSuffix(tracking, "_") && strings.HasPrefix(lower, tracking)) {
+				query.Del(param)
+				break
+			}
+		}
+	}
+
+	canonical := host + path
+	if encoded := query.Encode(); encoded != "" {
+		canonical += "?" + encoded
+	}
+	return canonical
+}
+
+// mergeHackathons fusiona un grupo de duplicados. El registro más completo es la base
+// (conserva su ID) y los demás rellenan los campos vacíos y amplían las listas.
+func mergeHackathons(group []*models.Hackathon, source string) *models.Hackathon {
+	primary := group[0]
+	for _, hackathon := range group[1:] {
+		if hackathonCompleteness(hackathon) > hackathonCompleteness(primary) {
+			primary = hackathon
+		}
+	}
+
+	merged := *primary
+	merged.Metadata = make(map[string]interface{}, len(primary.Metadata)+1)
+
+	for _, other := range group {
+		if other == primary {
+			continue
+		}
+		fillString(&merged.Name, other.Name)
+		fillString(&merged.URL, other.URL)
+		fillString(&merged.RegistrationURL, other.RegistrationURL)
+		fillString(&merged.Difficulty, other.Difficulty)
+		fillString(&merged.Status, other.Status)
+		if len(other.Description) > len(merged.Description) {
+			merged.Description = other.Description
+		}
+
+		fillTime(&merged.StartDate, other.StartDate)
+		fillTime(&merged.EndDate, other.EndDate)
+		fillTime(&merged.RegistrationDeadline, other.RegistrationDeadline)
+		fillTime(&merged.SubmissionDeadline, other.SubmissionDeadline)
+
+		mergeLocation(&merged.Location, other.Location)
+		mergeOrganizer(&merged.Organizer, other.Organizer)
+		mergePrize(&merged.PrizePool, other.PrizePool)
+
+		if merged.TeamSize.Min == 0 && merged.TeamSize.Max == 0 {
+			merged.TeamSize = other.TeamSize
+		}
+		merged.ParticipantCount = max(merged.ParticipantCount, other.ParticipantCount)
+		merged.ProjectCount = max(merged.ProjectCount, other.ProjectCount)
+
+		merged.Technologies = unionStrings(merged.Technologies, other.Technologies)
+		merged.Categories = unionStrings(merged.Categories, other.Categories)
+		merged.Requirements = unionStrings(merged.Requirements, other.Requirements)
+		merged.Themes = unionStrings(merged.Themes, other.Themes)
+		merged.Tags = unionStrings(merged.Tags, other.Tags)
+
+		for key, value := range other.Metadata {
+			if key != MetadataSource {
+				merged.Metadata[key] = value
+			}
+		}
+	}
+	// Los metadatos de la base tienen prioridad
+	for key, value := range primary.Metadata {
+		merged.Metadata[key] = value
+	}
+
+	recordProvenance(&merged, group, source)
+	return &merged
+}
+
+// recordProvenance guarda en Metadata de dónde viene cada registro del grupo.
+// Si ya se había resuelto antes (p. ej. desde la caché), conserva esa procedencia.
+func recordProvenance(target *models.Hackathon, group []*models.Hackathon, source string) {
+	var sources []HackathonSource
+	seen := make(map[HackathonSource]bool)
+	add := func(s HackathonSource) {
+		if !seen[s] {
+			seen[s] = true
+			sources = append(sources, s)
+		}
+	}
+
+	for _, hackathon := range group {
+		if previous := HackathonSources(hackathon); len(previous) > 0 {
+			for _, s := range previous {
+				add(s)
+			}
+			continue
+		}
+		add(HackathonSource{
+			Source: recordSource(hackathon, source),
+			ID:     hackathon.ID,
+			Name:   hackathon.Name,
+			URL:    hackathon.URL,
+		})
+	}
+
+	if target.Metadata == nil {
+		target.Metadata = make(map[string]interface{})
+	}
+	target.Metadata[MetadataSources] = sources
+}
+
+// recordSource es Metadata["source"] si el servidor lo indica; si no, el origen de la búsqueda
+// o, en su defecto, el dominio de la URL
+func recordSource(hackathon *models.Hackathon, fallback string) string {
+	if value, ok := hackathon.Metadata[MetadataSource].(string); ok && value != "" {
+		return value
+	}
+	if fallback != "" {
+		return fallback
+	}
+	if canonical := CanonicalURL(hackathon.URL); canonical != "" {
+		return strings.SplitN(canonical, "/", 2)[0]
+	}
+	return "unknown"
+}
+
+// hackathonCompleteness cuenta los campos informados de un registro
+func hackathonCompleteness(h *models.Hackathon) int {
+	score := 0
+	for _, filled := range []bool{
+		h.ID != "", h.Name != "", h.Description != "", h.URL != "", h.RegistrationURL != "",
+		!h.StartDate.IsZero(), !h.EndDate.IsZero(), !h.RegistrationDeadline.IsZero(), !h.SubmissionDeadline.IsZero(),
+		h.Location.Type != "", h.Location.City != "", h.Location.Coordinates != nil,
+		len(h.Technologies) > 0, len(h.Themes) > 0, h.PrizePool.Total > 0, h.Organizer.Name != "",
+		h.ParticipantCount > 0, h.Difficulty != "",
+	} {
+		if filled {
+			score++
+		}
+	}
+	return score
+}
+
+func fillString(target *string, value string) {
+	if *target == "" {
+		*target = value
+	}
+}
+
+func fillTime(target *time.Time, value time.Time) {
+	if target.IsZero() {
+		*target = value
+	}
+}
+
+func mergeLocation(target *models.Location, other models.Location) {
+	// Presencial + online del mismo evento es un híbrido
+	if target.Type != "" && other.Type != "" && !strings.EqualFold(target.Type, other.Type) {
+		target.Type = "hybrid"
+	}
+	fillString(&target.Type, other.Type)
+	fillString(&target.City, other.City)
+	fillString(&target.Country, other.Country)
+	fillString(&target.Venue, other.Venue)
+	fillString(&target.Address, other.Address)
+	fillString(&target.Timezone, other.Timezone)
+	if target.Coordinates == nil {
+		target.Coordinates = other.Coordinates
+	}
+}
+
+func mergeOrganizer(target *models.Organizer, other models.Organizer) {
+	fillString(&target.Name, other.Name)
+	fillString(&target.Type, other.Type)
+	fillString(&target.Website, other.Website)
+	fillString(&target.Social.Twitter, other.Social.Twitter)
+	fillString(&target.Social.LinkedIn, other.Social.LinkedIn)
+	fillString(&target.Social.Discord, other.Social.Discord)
+	fillString(&target.Social.Telegram, other.Social.Telegram)
+	fillString(&target.Contact.Email, other.Contact.Email)
+	fillString(&target.Contact.Phone, other.Contact.Phone)
+}
+
+func mergePrize(target *models.PrizeInfo, other models.PrizeInfo) {
+	if target.Total == 0 && other.Total > 0 {
+		target.Total = other.Total
+		target.Currency = other.Currency
+	}
+	if len(target.Breakdown) == 0 {
+		target.Breakdown = other.Breakdown
+	}
+	target.Sponsors = unionStrings(target.Sponsors, other.Sponsors)
+	target.NonMonetary = unionStrings(target.NonMonetary, other.NonMonetary)
+}
+
+// unionStrings añade a base los valores de extra que no tenga (sin distinguir mayúsculas)
+func unionStrings(base, extra []string) []string {
+	if len(extra) == 0 {
+		return base
+	}
+	seen := make(map[string]bool, len(base)+len(extra))
+	for _, value := range base {
+		seen[strings.ToLower(strings.TrimSpace(value))] = true
+	}
+
+	result := append([]string(nil), base...)
+	for _, value := range extra {
+		key := strings.ToLower(strings.TrimSpace(value))
+		if key != "" && !seen[key] {
+			seen[key] = true
+			result = append(result, value)
+		}
+	}
+	return result
+}
+
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]bool, len(values))
+	var unique []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+// nameNumbers devuelve los números de un nombre (años, ediciones) ordenados
+func nameNumbers(tokens []string) []string {
+	var numbers []string
+	for _, token := range uniqueStrings(tokens) {
+		if strings.IndexFunc(token, func(r rune) bool { return !unicode.IsDigit(r) }) < 0 {
+			numbers = append(numbers, token)
+		}
+	}
+	sort.Strings(numbers)
+	return numbers
+}
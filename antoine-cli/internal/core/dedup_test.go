@@ -0,0 +1,123 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"antoine-cli/internal/models"
+)
+
+func TestSameHackathonThresholds(t *testing.T) {
+	start := time.Date(2026, 3, 14, 9, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name          string
+		a, b          string
+		overlap       bool // ambos con las mismas fechas; si no, sin fechas
+		sameOrganizer bool
+		want          bool
+	}{
+		{"near identical name", "Bitcoin Builders", "Bitcoin Builder", false, false, true},
+		{"similar name alone", "Quantum Weekend", "Quantum Week", false, false, false},
+		{"similar name and dates", "Quantum Weekend", "Quantum Week", true, false, true},
+		{"similar name and organizer", "Quantum Weekend", "Quantum Week", false, true, true},
+		{"loose name and dates", "Data Science Marathon", "Data Science Meetup", true, false, false},
+		{"loose name and organizer", "Data Science Marathon", "Data Science Meetup", false, true, false},
+		{"loose name, dates and organizer", "Data Science Marathon", "Data Science Meetup", true, true, true},
+		{"different names", "Web3 Summit", "Climate Jam", true, true, false},
+		{"different editions", "Cal Hacks 11", "Cal Hacks 12", true, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &models.Hackathon{Name: tt.a}
+			b := &models.Hackathon{Name: tt.b}
+			if tt.overlap {
+				a.StartDate, b.StartDate = start, start
+			}
+			if tt.sameOrganizer {
+				a.Organizer.Name, b.Organizer.Name = "Acme Labs", "ACME Labs"
+			}
+
+			if got := sameHackathon(a, b, newResolutionKey(a), newResolutionKey(b)); got != tt.want {
+				t.Errorf("sameHackathon(%q, %q) = %v, want %v (similarity %.2f)", tt.a, tt.b, got, tt.want,
+					nameSimilarity(newResolutionKey(a), newResolutionKey(b)))
+			}
+		})
+	}
+}
+
+func TestResolveHackathons(t *testing.T) {
+	day := func(year int) time.Time { return time.Date(year, 11, 1, 9, 0, 0, 0, time.UTC) }
+	tests := []struct {
+		name        string
+		hackathons  []*models.Hackathon
+		want        int
+		wantSources []string // procedencia del primer resultado
+	}{
+		{
+			name: "undated record does not bridge two editions",
+			hackathons: []*models.Hackathon{
+				{Name: "Junction", StartDate: day(2025)},
+				{Name: "Junction"},
+				{Name: "Junction", StartDate: day(2026)},
+			},
+			want: 2,
+		},
+		{
+			name: "listing page is not an identity",
+			hackathons: []*models.Hackathon{
+				{Name: "Web3 Summit", URL: "https://devpost.com/hackathons"},
+				{Name: "Climate Jam", URL: "https://devpost.com/hackathons/"},
+			},
+			want: 2,
+		},
+		{
+			name: "shared event page",
+			hackathons: []*models.Hackathon{
+				{Name: "Web3 Summit", URL: "https://web3summit.com/?utm_source=newsletter"},
+				{Name: "W3S Berlin", URL: "http://www.web3summit.com/"},
+			},
+			want:        1,
+			wantSources: []string{"web3summit.com", "web3summit.com"},
+		},
+		{
+			name: "in person in different cities",
+			hackathons: []*models.Hackathon{
+				{Name: "Hack Day", Location: models.Location{Type: "in-person", City: "Madrid"}},
+				{Name: "Hack Day", Location: models.Location{Type: "in-person", City: "Paris"}},
+			},
+			want: 2,
+		},
+		{
+			name: "same event on two sites",
+			hackathons: []*models.Hackathon{
+				{Name: "Bitcoin Builders", URL: "https://bitcoinbuilders.devpost.com"},
+				{Name: "Bitcoin Builder", URL: "https://mlh.io/events/bitcoin-builder"},
+			},
+			want:        1,
+			wantSources: []string{"bitcoinbuilders.devpost.com", "mlh.io"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved := ResolveHackathons(tt.hackathons, "")
+			if len(resolved) != tt.want {
+				t.Fatalf("resolved = %d, want %d", len(resolved), tt.want)
+			}
+			if tt.wantSources == nil {
+				return
+			}
+
+			sources := HackathonSources(resolved[0])
+			if len(sources) != len(tt.wantSources) {
+				t.Fatalf("sources = %+v, want %v", sources, tt.wantSources)
+			}
+			for i, source := range sources {
+				if source.Source != tt.wantSources[i] {
+					t.Errorf("source %d = %s, want %s", i, source.Source, tt.wantSources[i])
+				}
+			}
+		})
+	}
+}
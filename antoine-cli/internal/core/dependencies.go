@@ -0,0 +1,469 @@
+package core
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+
+	"antoine-cli/internal/models"
+)
+
+// UnknownLicense agrupa en DependencyAnalysis.Licenses las dependencias sin licencia conocida
+const UnknownLicense = "Unknown"
+
+// manifestMaxDepth limita la búsqueda de manifiestos: raíz y dos niveles (frontend/, apps/web/)
+const manifestMaxDepth = 2
+
+// manifestSkipDirs no contienen manifiestos del proyecto
+var manifestSkipDirs = map[string]bool{
+	".git": true, "node_modules": true, "vendor": true, "target": true, "dist": true, "build": true,
+	".venv": true, "venv": true, "env": true, "__pycache__": true, ".next": true, ".cargo": true,
+}
+
+// dependencyFileReader lee un archivo del repositorio por su ruta relativa
+type dependencyFileReader func(filePath string) ([]byte, error)
+
+// DependencyCollector acumula las dependencias de los manifiestos de un repositorio. En un
+// mismo directorio el lockfile fija la versión de las directas y añade las transitivas.
+type DependencyCollector struct {
+	entries map[string]*models.DependencyDetail // directorio|ecosistema|nombre, o |ruta para las copias anidadas
+	order   []string
+	Errors  []string
+}
+
+func NewDependencyCollector() *DependencyCollector {
+	return &DependencyCollector{entries: make(map[string]*models.DependencyDetail)}
+}
+
+// Add incorpora las dependencias de un manifiesto ya parseado
+func (c *DependencyCollector) Add(manifest string, deps []models.DependencyDetail) {
+	dir := path.Dir(manifest)
+	lockfile := lockfiles[path.Base(manifest)]
+	for _, dep := range deps {
+		key := dir + "|" + dep.Ecosystem + "|" + dep.Name
+		if lockfile && nestedLockPath(dep.Path) {
+			// Una copia anidada (node_modules/a/node_modules/b) no es la versión que usa el
+			// proyecto: va aparte con su ruta completa
+			key = dir + "|" + dep.Ecosystem + "|" + dep.Path
+		}
+		existing, ok := c.entries[key]
+		if !ok {
+			dep := dep
+			c.entries[key] = &dep
+			c.order = append(c.order, key)
+			continue
+		}
+		if lockfile && existing.Manifest == dep.Manifest {
+			// Otra versión de la misma dependencia dentro del lockfile (node_modules anidados)
+			if _, ok := c.entries[key+"|"+dep.Version]; !ok && existing.Version != dep.Version {
+				dep := dep
+				c.entries[key+"|"+dep.Version] = &dep
+				c.order = append(c.order, key+"|"+dep.Version)
+			}
+			continue
+		}
+		if lockfile {
+			// La versión resuelta sustituye al rango del manifiesto
+			if dep.Checksum != "" || existing.Version != dep.Version {
+				existing.Checksum = dep.Checksum
+			}
+			existing.Version = dep.Version
+			existing.Manifest = dep.Manifest
+			existing.Path = dep.Path
+			existing.Requires = dep.Requires
+			if existing.License == "" {
+				existing.License = dep.License
+			}
+			continue
+		}
+		existing.Direct = existing.Direct || dep.Direct
+		existing.Dev = existing.Dev && dep.Dev
+	}
+}
+
+// nestedLockPath indica si la ruta del lockfile está dentro del node_modules de otro paquete
+func nestedLockPath(lockPath string) bool {
+	return strings.Count(lockPath, "node_modules/") > 1
+}
+
+// Collect lee y parsea los manifiestos con read, en el orden de dependencyManifests por directorio
+func (c *DependencyCollector) Collect(manifests []string, read dependencyFileReader) {
+	sort.SliceStable(manifests, func(i, j int) bool {
+		if di, dj := path.Dir(manifests[i]), path.Dir(manifests[j]); di != dj {
+			return di < dj
+		}
+		return manifestOrder(manifests[i]) < manifestOrder(manifests[j])
+	})
+	for _, manifest := range manifests {
+		content, err := read(manifest)
+		if err != nil {
+			continue
+		}
+		deps, err := ParseManifest(manifest, content)
+		if err != nil {
+			c.Errors = append(c.Errors, err.Error())
+			continue
+		}
+		c.Add(manifest, deps)
+	}
+}
+
+// Analysis devuelve la lista completa, sin repetir la misma versión de distintos directorios,
+// y los agregados Total y Licenses
+func (c *DependencyCollector) Analysis() models.DependencyAnalysis {
+	analysis := models.DependencyAnalysis{Licenses: make(map[string]int), Details: []models.DependencyDetail{}}
+	seen := make(map[string]int)
+	for _, key := range c.order {
+		dep := *c.entries[key]
+		id := dep.Ecosystem + "|" + dep.Name + "|" + dep.Version
+		if i, ok := seen[id]; ok {
+			analysis.Details[i].Direct = analysis.Details[i].Direct || dep.Direct
+			if analysis.Details[i].License == "" {
+				analysis.Details[i].License = dep.License
+			}
+			if analysis.Details[i].Checksum == "" {
+				analysis.Details[i].Checksum = dep.Checksum
+			}
+			if len(dep.Requires) > 0 {
+				requires := append(slices.Clone(analysis.Details[i].Requires), dep.Requires...)
+				analysis.Details[i].Requires = slices.Compact(slices.Sorted(slices.Values(requires)))
+			}
+			continue
+		}
+		seen[id] = len(analysis.Details)
+		analysis.Details = append(analysis.Details, dep)
+	}
+
+	sort.SliceStable(analysis.Details, func(i, j int) bool {
+		a, b := analysis.Details[i], analysis.Details[j]
+		if a.Direct != b.Direct {
+			return a.Direct
+		}
+		if a.Ecosystem != b.Ecosystem {
+			return a.Ecosystem < b.Ecosystem
+		}
+		return a.Name < b.Name
+	})
+	for i := range analysis.Details {
+		analysis.Details[i].License = NormalizeLicense(analysis.Details[i].License)
+		license := analysis.Details[i].License
+		if license == "" {
+			license = UnknownLicense
+		}
+		analysis.Licenses[license]++
+	}
+	analysis.Total = len(analysis.Details)
+	return analysis
+}
+
+// LocalDependencies parsea los manifiestos de un árbol de trabajo y resuelve las licencias
+// con los paquetes instalados (node_modules, vendor, cachés de Go, Cargo, Maven y virtualenvs)
+func LocalDependencies(root string) (models.DependencyAnalysis, []string) {
+	collector := NewDependencyCollector()
+	collector.Collect(findLocalManifests(root), func(manifest string) ([]byte, error) {
+		return os.ReadFile(filepath.Join(root, filepath.FromSlash(manifest)))
+	})
+
+	analysis := collector.Analysis()
+	for i := range analysis.Details {
+		dep := &analysis.Details[i]
+		if dep.License != "" {
+			continue
+		}
+		if dep.License = NormalizeLicense(localDependencyLicense(root, path.Dir(dep.Manifest), *dep)); dep.License != "" {
+			analysis.Licenses[UnknownLicense]--
+			analysis.Licenses[dep.License]++
+		}
+	}
+	if analysis.Licenses[UnknownLicense] == 0 {
+		delete(analysis.Licenses, UnknownLicense)
+	}
+	return analysis, collector.Errors
+}
+
+// findLocalManifests busca manifiestos hasta manifestMaxDepth respetando el .gitignore raíz
+func findLocalManifests(root string) []string {
+	ignore := &GitIgnore{}
+	_ = ignore.LoadGitIgnore(filepath.Join(root, ".gitignore"), "")
+
+	var manifests []string
+	_ = filepath.WalkDir(root, func(current string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+		rel, _ := filepath.Rel(root, current)
+		rel = filepath.ToSlash(rel)
+		if entry.IsDir() {
+			if rel == "." {
+				return nil
+			}
+			if manifestSkipDirs[entry.Name()] || strings.Count(rel, "/") >= manifestMaxDepth || ignore.Ignored(rel, true) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if IsDependencyManifest(rel) && !ignore.Ignored(rel, false) {
+			manifests = append(manifests, rel)
+		}
+		return nil
+	})
+	return manifests
+}
+
+// RemoteDependencies lee los manifiestos de un repositorio remoto con GitHubClient.ReadFile.
+// Si no se puede listar el árbol, lo anota en los errores y prueba los manifiestos de la raíz.
+func (c *AntoineClient) RemoteDependencies(ctx context.Context, repoURL string) (models.DependencyAnalysis, []string) {
+	collector := NewDependencyCollector()
+	var manifests []string
+	if files, err := c.mcp.github.ListFiles(ctx, repoURL, ""); err != nil {
+		collector.Errors = append(collector.Errors, fmt.Sprintf("failed to list files: %v", err))
+	} else {
+		for _, file := range files {
+			file = strings.TrimPrefix(filepath.ToSlash(file), "/")
+			if IsDependencyManifest(file) && strings.Count(file, "/") <= manifestMaxDepth && !inSkippedDir(file) {
+				manifests = append(manifests, file)
+			}
+		}
+	}
+	if len(manifests) == 0 {
+		manifests = append(manifests, dependencyManifests...)
+	}
+
+	collector.Collect(manifests, func(manifest string) ([]byte, error) {
+		content, err := c.mcp.github.ReadFile(ctx, repoURL, manifest)
+		return []byte(content), err
+	})
+	analysis := collector.Analysis()
+	if analysis.Licenses[UnknownLicense] == 0 {
+		delete(analysis.Licenses, UnknownLicense)
+	}
+	return analysis, collector.Errors
+}
+
+func inSkippedDir(file string) bool {
+	for _, dir := range strings.Split(path.Dir(file), "/") {
+		if manifestSkipDirs[dir] {
+			return true
+		}
+	}
+	return false
+}
+
+// attachDependencies guarda las dependencias en el repositorio del resultado y añade el insight
+func attachDependencies(result *models.AnalysisResult, repoURL string, deps models.DependencyAnalysis, errors []string) {
+	if deps.Total == 0 && len(errors) == 0 {
+		return // sin manifiestos legibles
+	}
+	repo, ok := RepositoryFromResult(result)
+	if !ok {
+		repo = &models.Repository{URL: repoURL}
+	}
+	repo.CodeQuality.Security.Dependencies = deps
+	result.Results = repo
+
+	if result.Metadata == nil {
+		result.Metadata = make(map[string]interface{})
+	}
+	if len(errors) > 0 {
+		result.Metadata["manifest_errors"] = errors
+	}
+	if insight, ok := dependencyInsight(deps); ok {
+		result.Insights = append(result.Insights, insight)
+	}
+	if insight, ok := applyVulnerabilities(&repo.CodeQuality.Security); ok {
+		result.Insights = append(result.Insights, insight)
+	}
+}
+
+// dependencyInsight resume el número de dependencias por ecosistema y las licencias más comunes
+func dependencyInsight(deps models.DependencyAnalysis) (models.Insight, bool) {
+	if deps.Total == 0 {
+		return models.Insight{}, false
+	}
+	direct := 0
+	ecosystems := make(map[string]int)
+	for _, dep := range deps.Details {
+		if dep.Direct {
+			direct++
+		}
+		ecosystems[dep.Ecosystem]++
+	}
+	var parts []string
+	for _, ecosystem := range sortedKeys(ecosystems) {
+		parts = append(parts, fmt.Sprintf("%s %d", ecosystem, ecosystems[ecosystem]))
+	}
+
+	licenses := sortedKeys(deps.Licenses)
+	sort.SliceStable(licenses, func(i, j int) bool { return deps.Licenses[licenses[i]] > deps.Licenses[licenses[j]] })
+	var licenseParts []string
+	for _, license := range licenses[:min(len(licenses), 5)] {
+		licenseParts = append(licenseParts, fmt.Sprintf("%s %d", license, deps.Licenses[license]))
+	}
+
+	description := fmt.Sprintf("%d dependencies (%d direct): %s.", deps.Total, direct, strings.Join(parts, ", "))
+	if len(licenseParts) > 0 {
+		description += " Licenses: " + strings.Join(licenseParts, ", ") + "."
+	}
+	impact := "low"
+	if deps.Licenses[UnknownLicense] > deps.Total/2 {
+		impact = "medium"
+	}
+	return models.Insight{
+		Type:        "dependencies",
+		Title:       "Dependencies",
+		Description: description,
+		Impact:      impact,
+		Confidence:  0.9,
+		Data:        map[string]interface{}{"total": deps.Total, "direct": direct, "ecosystems": ecosystems, "licenses": deps.Licenses},
+	}, true
+}
+
+// localDependencyLicense busca la licencia de una dependencia instalada
+func localDependencyLicense(root, dir string, dep models.DependencyDetail) string {
+	base := filepath.Join(root, filepath.FromSlash(dir))
+	home, _ := os.UserHomeDir()
+
+	switch dep.Ecosystem {
+	case EcosystemNpm:
+		for _, modules := range []string{filepath.Join(base, "node_modules"), filepath.Join(root, "node_modules")} {
+			var pkg struct {
+				License  interface{}   `json:"license"`
+				Licenses []interface{} `json:"licenses"`
+			}
+			if found, _ := readJSONFile(filepath.Join(modules, filepath.FromSlash(dep.Name), "package.json"), &pkg); found {
+				if license := licenseString(pkg.License); license != "" {
+					return license
+				}
+				return licenseString(pkg.Licenses)
+			}
+		}
+
+	case EcosystemGo:
+		dirs := []string{filepath.Join(root, "vendor", filepath.FromSlash(dep.Name))}
+		if cache := goModCache(home); cache != "" {
+			dirs = append(dirs, filepath.Join(cache, filepath.FromSlash(escapeModulePath(dep.Name))+"@"+dep.Version))
+		}
+		for _, moduleDir := range dirs {
+			if license := detectLicenseFile(moduleDir); license != "" {
+				return license
+			}
+		}
+
+	case EcosystemCargo:
+		if home == "" {
+			break
+		}
+		matches, _ := filepath.Glob(filepath.Join(home, ".cargo", "registry", "src", "*", dep.Name+"-"+dep.Version, "Cargo.toml"))
+		for _, manifest := range matches {
+			var crate struct {
+				Package struct {
+					License string `toml:"license"`
+				} `toml:"package"`
+			}
+			if data, err := os.ReadFile(manifest); err == nil && toml.Unmarshal(data, &crate) == nil && crate.Package.License != "" {
+				return crate.Package.License
+			}
+		}
+
+	case EcosystemPyPI:
+		name := strings.ReplaceAll(dep.Name, "-", "_")
+		for _, venv := range []string{".venv", "venv", "env"} {
+			pattern := filepath.Join(base, venv, "lib", "python*", "site-packages", "*.dist-info", "METADATA")
+			matches, _ := filepath.Glob(pattern)
+			for _, metadata := range matches {
+				distInfo := filepath.Base(filepath.Dir(metadata))
+				if !strings.EqualFold(strings.SplitN(distInfo, "-", 2)[0], name) {
+					continue
+				}
+				if license := pythonMetadataLicense(metadata); license != "" {
+					return license
+				}
+			}
+		}
+
+	case EcosystemMaven:
+		group, artifact, ok := strings.Cut(dep.Name, ":")
+		if !ok || home == "" || dep.Version == "" {
+			break
+		}
+		pom := filepath.Join(home, ".m2", "repository", filepath.FromSlash(strings.ReplaceAll(group, ".", "/")),
+			artifact, dep.Version, artifact+"-"+dep.Version+".pom")
+		if data, err := os.ReadFile(pom); err == nil {
+			var project struct {
+				Licenses []struct {
+					Name string `xml:"name"`
+				} `xml:"licenses>license"`
+			}
+			if xml.Unmarshal(data, &project) == nil && len(project.Licenses) > 0 {
+				return strings.TrimSpace(project.Licenses[0].Name)
+			}
+		}
+	}
+	return ""
+}
+
+func goModCache(home string) string {
+	if cache := os.Getenv("GOMODCACHE"); cache != "" {
+		return cache
+	}
+	if gopath := os.Getenv("GOPATH"); gopath != "" {
+		return filepath.Join(filepath.SplitList(gopath)[0], "pkg", "mod")
+	}
+	if home != "" {
+		return filepath.Join(home, "go", "pkg", "mod")
+	}
+	return ""
+}
+
+// escapeModulePath aplica el escape de la caché de módulos: las mayúsculas pasan a !minúscula
+func escapeModulePath(module string) string {
+	var escaped strings.Builder
+	for _, r := range module {
+		if r >= 'A' && r <= 'Z' {
+			escaped.WriteByte('!')
+			r += 'a' - 'A'
+		}
+		escaped.WriteRune(r)
+	}
+	return escaped.String()
+}
+
+// pythonMetadataLicense lee License-Expression o License de un METADATA, o el clasificador de licencia
+func pythonMetadataLicense(file string) string {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return ""
+	}
+	headers, _, _ := strings.Cut(string(data), "\n\n")
+	classifier := ""
+	for _, line := range strings.Split(headers, "\n") {
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		switch key {
+		case "License-Expression":
+			return value
+		case "License":
+			if value != "" && value != "UNKNOWN" && len(value) < 60 {
+				return value
+			}
+		case "Classifier":
+			if strings.HasPrefix(value, "License ::") && classifier == "" {
+				parts := strings.Split(value, "::")
+				classifier = strings.TrimSpace(parts[len(parts)-1])
+			}
+		}
+	}
+	return classifier
+}
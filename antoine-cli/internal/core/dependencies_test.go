@@ -0,0 +1,79 @@
+package core
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDependencyCollectorPackageLock(t *testing.T) {
+	packageJSON := `{"dependencies": {"foo": "^1.0.0", "bar": "^2.0.0"}}`
+	tests := []struct {
+		name string
+		lock string
+		want []string // nombre@versión (direct) en el orden de Analysis
+	}{
+		{
+			name: "nested copy sorts before the top-level entry",
+			lock: `{"lockfileVersion": 3, "packages": {
+				"": {"dependencies": {"foo": "^1.0.0", "bar": "^2.0.0"}},
+				"node_modules/bar": {"version": "2.1.0", "dependencies": {"foo": "^0.9.0"}},
+				"node_modules/bar/node_modules/foo": {"version": "0.9.4"},
+				"node_modules/foo": {"version": "1.4.2", "dependencies": {"baz": "*"}},
+				"node_modules/baz": {"version": "3.0.0"}
+			}}`,
+			want: []string{"bar@2.1.0 (direct)", "foo@1.4.2 (direct)", "baz@3.0.0", "foo@0.9.4"},
+		},
+		{
+			name: "nested copy with the same version",
+			lock: `{"lockfileVersion": 2, "packages": {
+				"node_modules/bar": {"version": "2.1.0"},
+				"node_modules/bar/node_modules/foo": {"version": "1.4.2"},
+				"node_modules/foo": {"version": "1.4.2"}
+			}}`,
+			want: []string{"bar@2.1.0 (direct)", "foo@1.4.2 (direct)"},
+		},
+		{
+			name: "two nested copies of a transitive dependency",
+			lock: `{"lockfileVersion": 3, "packages": {
+				"node_modules/bar": {"version": "2.1.0"},
+				"node_modules/bar/node_modules/qux": {"version": "1.0.0"},
+				"node_modules/foo": {"version": "1.4.2"},
+				"node_modules/foo/node_modules/qux": {"version": "2.0.0"}
+			}}`,
+			want: []string{"bar@2.1.0 (direct)", "foo@1.4.2 (direct)", "qux@1.0.0", "qux@2.0.0"},
+		},
+		{
+			name: "lockfile version 1",
+			lock: `{"lockfileVersion": 1, "dependencies": {
+				"bar": {"version": "2.1.0", "dependencies": {"foo": {"version": "0.9.4"}}},
+				"foo": {"version": "1.4.2"}
+			}}`,
+			want: []string{"bar@2.1.0 (direct)", "foo@1.4.2 (direct)", "foo@0.9.4"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			files := map[string]string{"package.json": packageJSON, "package-lock.json": tt.lock}
+			collector := NewDependencyCollector()
+			collector.Collect([]string{"package-lock.json", "package.json"}, func(name string) ([]byte, error) {
+				return []byte(files[name]), nil
+			})
+			if len(collector.Errors) > 0 {
+				t.Fatalf("errors: %v", collector.Errors)
+			}
+
+			var got []string
+			for _, dep := range collector.Analysis().Details {
+				entry := dep.Name + "@" + dep.Version
+				if dep.Direct {
+					entry += " (direct)"
+				}
+				got = append(got, entry)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("dependencies = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,379 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+)
+
+// Tamaño mínimo de un bloque para contarlo como duplicado
+const (
+	DuplicationMinTokens = 50
+	DuplicationMinLines  = 5
+)
+
+// CloneLocation es una de las copias de un bloque duplicado
+type CloneLocation struct {
+	File      string `json:"file"`
+	StartLine int    `json:"start_line"`
+	EndLine   int    `json:"end_line"`
+}
+
+// DuplicateBlock es un bloque de código que aparece en varios sitios
+type DuplicateBlock struct {
+	Tokens    int             `json:"tokens"`
+	Lines     int             `json:"lines"`
+	Locations []CloneLocation `json:"locations"` // la primera es la copia más antigua en el recorrido
+}
+
+// DuplicationReport es el código duplicado de un árbol
+type DuplicationReport struct {
+	Root            string           `json:"root"`
+	Files           int              `json:"files"`
+	Lines           int              `json:"lines"` // líneas con código de los archivos comparados
+	DuplicatedLines int              `json:"duplicated_lines"`
+	Percentage      float64          `json:"percentage"`
+	MinTokens       int              `json:"min_tokens"`
+	Blocks          []DuplicateBlock `json:"blocks"`
+}
+
+// cloneSyntaxes son los lenguajes que se comparan y el lexer con el que se tokenizan
+var cloneSyntaxes = map[string]lexerSyntax{
+	"Go":         {lineComments: []string{"//"}, blockStart: "/*", blockEnd: "*/", templates: true}, // `raw` como plantilla
+	"JavaScript": scriptSyntax,
+	"TypeScript": scriptSyntax,
+	"Python":     pythonSyntax,
+	"Rust":       rustSyntax,
+	"Java":       cFamilySyntax,
+	"Kotlin":     cFamilySyntax,
+	"Scala":      cFamilySyntax,
+	"Swift":      cFamilySyntax,
+	"C":          cFamilySyntax,
+	"C++":        cFamilySyntax,
+	"C#":         cFamilySyntax,
+	"Dart":       cFamilySyntax,
+	"Solidity":   cFamilySyntax,
+	"PHP":        {lineComments: []string{"//", "#"}, blockStart: "/*", blockEnd: "*/"},
+	"Ruby":       {lineComments: []string{"#"}},
+}
+
+var cFamilySyntax = lexerSyntax{lineComments: []string{"//"}, blockStart: "/*", blockEnd: "*/"}
+
+// cloneKeywords se conservan al normalizar: el resto de identificadores se comparan como "id"
+var cloneKeywords = map[string]bool{}
+
+func init() {
+	for _, keyword := range []string{
+		// Comunes
+		"if", "else", "for", "while", "do", "switch", "case", "default", "break", "continue", "return",
+		"try", "catch", "finally", "throw", "new", "class", "struct", "enum", "interface", "import",
+		"true", "false", "null", "nil", "this", "self", "super", "static", "const", "var", "let",
+		// Go
+		"func", "go", "defer", "chan", "select", "range", "map", "type", "package", "fallthrough", "goto",
+		// JavaScript y TypeScript
+		"function", "async", "await", "yield", "export", "from", "extends", "implements", "typeof",
+		"instanceof", "in", "of", "delete", "void", "undefined",
+		// Python
+		"def", "elif", "except", "with", "as", "lambda", "pass", "raise", "and", "or", "not", "is",
+		"None", "True", "False", "global", "nonlocal",
+		// Rust
+		"fn", "mut", "impl", "trait", "match", "pub", "use", "mod", "Self", "where", "loop", "move",
+		"dyn", "unsafe", "ref",
+		// Java, C# y familia
+		"public", "private", "protected", "final", "abstract", "override", "throws",
+	} {
+		cloneKeywords[keyword] = true
+	}
+}
+
+// importKeywords abren sentencias de import que no cuentan como duplicación
+var importKeywords = map[string]bool{"import": true, "package": true, "use": true, "using": true, "from": true, "require": true}
+
+// cloneFile es el flujo de tokens normalizados de un archivo
+type cloneFile struct {
+	path     string
+	tokens   []uint64 // hash del texto normalizado
+	lines    []int    // línea en la que empieza cada token
+	endLines []int
+	covered  []bool // líneas duplicadas, indexadas por número de línea
+	code     int    // líneas con código
+}
+
+type cloneRef struct {
+	file, pos int
+}
+
+// cloneDetector busca bloques repetidos con Rabin-Karp sobre ventanas de minTokens tokens
+type cloneDetector struct {
+	minTokens int
+	files     []*cloneFile
+}
+
+func newCloneDetector(minTokens int) *cloneDetector {
+	if minTokens <= 0 {
+		minTokens = DuplicationMinTokens
+	}
+	return &cloneDetector{minTokens: minTokens}
+}
+
+// add tokeniza un archivo de código; ignora los tests y los lenguajes sin lexer
+func (d *cloneDetector) add(file SourceFile, content []byte) {
+	syntax, ok := cloneSyntaxes[file.Language]
+	if !ok || IsTestFile(file.Path) {
+		return
+	}
+	tokens := significantTokens(tokenize(content, syntax))
+	clone := &cloneFile{path: file.Path}
+	lastLine := 0
+	for i := 0; i < len(tokens); i++ {
+		if tokens[i].kind == tokenIdent && importKeywords[tokens[i].text] && (i == 0 || tokens[i-1].endLine < tokens[i].line) {
+			i = skipImportStatement(tokens, i)
+			continue
+		}
+		clone.tokens = append(clone.tokens, hashCloneToken(normalizeCloneToken(tokens, i)))
+		clone.lines = append(clone.lines, tokens[i].line)
+		clone.endLines = append(clone.endLines, tokens[i].endLine)
+		// Las líneas con código se cuentan una vez aunque tengan varios tokens
+		for line := max(tokens[i].line, lastLine+1); line <= tokens[i].endLine; line++ {
+			clone.code++
+			lastLine = line
+		}
+	}
+	clone.covered = make([]bool, lastLine+1)
+	d.files = append(d.files, clone)
+}
+
+// skipImportStatement devuelve el último token de la sentencia de import que empieza en start:
+// termina en ; o al acabar la línea con los paréntesis y llaves cerrados
+func skipImportStatement(tokens []sourceToken, start int) int {
+	depth := 0
+	for i := start; i < len(tokens); i++ {
+		switch tokens[i].text {
+		case "(", "{", "[":
+			depth++
+		case ")", "}", "]":
+			depth--
+		case ";":
+			if depth <= 0 {
+				return i
+			}
+		}
+		if depth <= 0 && (i+1 == len(tokens) || tokens[i+1].line > tokens[i].endLine) {
+			return i
+		}
+	}
+	return len(tokens) - 1
+}
+
+// normalizeCloneToken cambia los identificadores por un marcador, de forma que un bloque copiado
+// y con variables renombradas sigue coincidiendo. Se conservan las palabras clave, los nombres
+// tras . o :: (métodos y campos) y los literales: con ellos se distingue una tabla de datos de otra.
+func normalizeCloneToken(tokens []sourceToken, i int) string {
+	token := tokens[i]
+	if token.kind != tokenIdent || cloneKeywords[token.text] || (i > 0 && (tokens[i-1].text == "." || tokens[i-1].text == "::")) {
+		return token.text
+	}
+	return "\x00id"
+}
+
+func hashCloneToken(text string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(text))
+	return h.Sum64()
+}
+
+// cloneHashBase es la base del hash rodante; la aritmética es módulo 2^64
+const cloneHashBase = 1099511628211
+
+// windowHashes calcula el hash de cada ventana de size tokens en O(n)
+func windowHashes(tokens []uint64, size int) []uint64 {
+	if len(tokens) < size {
+		return nil
+	}
+	var hash, power uint64 = 0, 1
+	for i := 0; i < size; i++ {
+		hash = hash*cloneHashBase + tokens[i]
+		if i > 0 {
+			power *= cloneHashBase
+		}
+	}
+	hashes := make([]uint64, 0, len(tokens)-size+1)
+	hashes = append(hashes, hash)
+	for i := size; i < len(tokens); i++ {
+		hash = (hash-tokens[i-size]*power)*cloneHashBase + tokens[i]
+		hashes = append(hashes, hash)
+	}
+	return hashes
+}
+
+// cloneCandidates limita las comprobaciones por ventana cuando un hash se repite mucho
+const cloneCandidates = 16
+
+// report compara los archivos añadidos y agrupa las copias de cada bloque
+func (d *cloneDetector) report(root string) *DuplicationReport {
+	report := &DuplicationReport{Root: root, Files: len(d.files), MinTokens: d.minTokens, Blocks: []DuplicateBlock{}}
+	index := make(map[uint64][]cloneRef)
+	groups := make(map[cloneSpan][]int) // contenido normalizado -> bloques con ese contenido
+	var spans []cloneRef                // primera copia de cada bloque
+
+	for f, file := range d.files {
+		hashes := windowHashes(file.tokens, d.minTokens)
+		for i := 0; i < len(hashes); {
+			original, length := d.longestMatch(index[hashes[i]], f, i)
+			if length == 0 {
+				index[hashes[i]] = append(index[hashes[i]], cloneRef{f, i})
+				i++
+				continue
+			}
+
+			// Las copias se agrupan por el contenido normalizado del tramo compartido, no por la
+			// copia con la que se han encontrado: dos originales iguales forman un solo bloque
+			first := d.location(original.file, original.pos, length)
+			span := d.files[original.file].tokens[original.pos : original.pos+length]
+			key := cloneSpan{hash: spanHash(span), tokens: length}
+			block := -1
+			for _, candidate := range groups[key] {
+				ref := spans[candidate]
+				if slices.Equal(d.files[ref.file].tokens[ref.pos:ref.pos+length], span) { // descarta colisiones
+					block = candidate
+					break
+				}
+			}
+			if block < 0 {
+				block = len(report.Blocks)
+				groups[key] = append(groups[key], block)
+				report.Blocks = append(report.Blocks, DuplicateBlock{
+					Tokens:    length,
+					Lines:     first.EndLine - first.StartLine + 1,
+					Locations: []CloneLocation{first},
+				})
+				spans = append(spans, original)
+			} else if !slices.Contains(report.Blocks[block].Locations, first) {
+				report.Blocks[block].Locations = append(report.Blocks[block].Locations, first)
+			}
+			d.cover(original.file, original.pos, length)
+			report.Blocks[block].Locations = append(report.Blocks[block].Locations, d.location(f, i, length))
+			d.cover(f, i, length)
+			// Las ventanas de la copia no se indexan: una tercera copia se agrupa con la primera
+			i += length
+		}
+	}
+
+	for _, file := range d.files {
+		report.Lines += file.code
+		for _, covered := range file.covered {
+			if covered {
+				report.DuplicatedLines++
+			}
+		}
+	}
+	if report.Lines > 0 {
+		report.Percentage = round1(float64(report.DuplicatedLines) / float64(report.Lines) * 100)
+	}
+	sort.SliceStable(report.Blocks, func(i, j int) bool {
+		a, b := report.Blocks[i], report.Blocks[j]
+		if a.Lines*len(a.Locations) != b.Lines*len(b.Locations) {
+			return a.Lines*len(a.Locations) > b.Lines*len(b.Locations)
+		}
+		return a.Tokens > b.Tokens
+	})
+	return report
+}
+
+// cloneSpan identifica el contenido normalizado de un tramo de tokens
+type cloneSpan struct {
+	hash   uint64
+	tokens int
+}
+
+// spanHash es el hash del tramo completo, con la misma base que windowHashes
+func spanHash(tokens []uint64) uint64 {
+	var hash uint64
+	for _, token := range tokens {
+		hash = hash*cloneHashBase + token
+	}
+	return hash
+}
+
+// longestMatch verifica los candidatos con el mismo hash que la ventana pos de f y devuelve el
+// que coincide durante más tokens (0 si ninguno llega a los mínimos)
+func (d *cloneDetector) longestMatch(candidates []cloneRef, f, pos int) (cloneRef, int) {
+	var best cloneRef
+	bestLength := 0
+	file := d.files[f]
+	for _, candidate := range candidates[:min(len(candidates), cloneCandidates)] {
+		other := d.files[candidate.file]
+		limit := len(other.tokens) - candidate.pos
+		if candidate.file == f {
+			limit = pos - candidate.pos // sin solaparse con la propia copia
+		}
+		length := 0
+		for length < limit && pos+length < len(file.tokens) && file.tokens[pos+length] == other.tokens[candidate.pos+length] {
+			length++
+		}
+		if length < d.minTokens || length <= bestLength {
+			continue
+		}
+		if file.endLines[pos+length-1]-file.lines[pos]+1 < DuplicationMinLines {
+			continue
+		}
+		best, bestLength = candidate, length
+	}
+	return best, bestLength
+}
+
+func (d *cloneDetector) location(f, pos, length int) CloneLocation {
+	file := d.files[f]
+	return CloneLocation{File: file.path, StartLine: file.lines[pos], EndLine: file.endLines[pos+length-1]}
+}
+
+// cover marca como duplicadas las líneas de los tokens [pos, pos+length)
+func (d *cloneDetector) cover(f, pos, length int) {
+	file := d.files[f]
+	for i := pos; i < pos+length; i++ {
+		for line := file.lines[i]; line <= file.endLines[i]; line++ {
+			file.covered[line] = true
+		}
+	}
+}
+
+// DetectDuplication busca código duplicado en los archivos de root con bloques de al menos
+// minTokens tokens (DuplicationMinTokens si es 0)
+func DetectDuplication(ctx context.Context, root string, limits LocalLimits, minTokens int) (*DuplicationReport, error) {
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	if info, err := os.Stat(abs); err != nil || !info.IsDir() {
+		return nil, fmt.Errorf("cannot analyze %s: not a directory", root)
+	}
+
+	detector := newCloneDetector(minTokens)
+	if _, err := WalkSourceFiles(ctx, abs, limits, func(file SourceFile) error {
+		if _, ok := cloneSyntaxes[file.Language]; !ok {
+			return nil
+		}
+		content, err := os.ReadFile(file.AbsPath)
+		if err != nil {
+			return err
+		}
+		detector.add(file, content)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("failed to walk %s: %w", root, err)
+	}
+	if len(detector.files) == 0 {
+		return nil, fmt.Errorf("no supported source files found in %s", root)
+	}
+	return detector.report(abs), nil
+}
+
+// duplicationPenalty es lo que la duplicación resta a la mantenibilidad: hasta un 3% es
+// normal; a partir de ahí 1.5 puntos por punto porcentual, con un máximo de 25
+func duplicationPenalty(percentage float64) float64 {
+	return max(0, min(25, (percentage-3)*1.5))
+}
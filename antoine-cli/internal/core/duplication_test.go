@@ -0,0 +1,142 @@
+package core
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestWindowHashes(t *testing.T) {
+	tests := []struct {
+		name   string
+		tokens []uint64
+		size   int
+	}{
+		{"single window", []uint64{1, 2, 3}, 3},
+		{"rolling windows", []uint64{5, 1, 4, 1, 5, 9, 2, 6, 5, 3}, 4},
+		{"repeated tokens", []uint64{7, 7, 7, 7, 7, 7}, 2},
+		{"large token hashes", []uint64{^uint64(0), 1 << 63, 42, ^uint64(0) - 1, 3}, 3},
+		{"window of one", []uint64{3, 1, 2}, 1},
+		{"shorter than the window", []uint64{1, 2}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := windowHashes(tt.tokens, tt.size)
+			if want := max(0, len(tt.tokens)-tt.size+1); len(got) != want {
+				t.Fatalf("windows = %d, want %d", len(got), want)
+			}
+			for i, hash := range got {
+				if want := spanHash(tt.tokens[i : i+tt.size]); hash != want {
+					t.Errorf("window %d = %x, want %x", i, hash, want)
+				}
+			}
+		})
+	}
+}
+
+// testCloneFile crea el flujo de tokens de un archivo con un token por línea
+func testCloneFile(path string, tokens ...uint64) *cloneFile {
+	file := &cloneFile{path: path, tokens: tokens, code: len(tokens), covered: make([]bool, len(tokens)+1)}
+	for i := range tokens {
+		file.lines = append(file.lines, i+1)
+		file.endLines = append(file.endLines, i+1)
+	}
+	return file
+}
+
+func TestCloneDetectorGroups(t *testing.T) {
+	block := []uint64{10, 11, 12, 13, 14, 15}
+	with := func(prefix []uint64, suffix ...uint64) []uint64 {
+		return append(append(append([]uint64{}, prefix...), block...), suffix...)
+	}
+
+	tests := []struct {
+		name  string
+		files map[string][]uint64
+		order []string
+		want  []string // ubicaciones de cada bloque, en el orden del informe
+	}{
+		{
+			name:  "three copies form one block",
+			files: map[string][]uint64{"a": with(nil, 1), "b": with([]uint64{2}), "c": with([]uint64{3, 4}, 5)},
+			order: []string{"a", "b", "c"},
+			want:  []string{"[a:1-6 b:2-7 c:3-8]"},
+		},
+		{
+			name:  "copy inside the same file",
+			files: map[string][]uint64{"a": with([]uint64{1}, with([]uint64{2})...)},
+			order: []string{"a"},
+			want:  []string{"[a:2-7 a:9-14]"},
+		},
+		{
+			name: "longer shared span is its own block",
+			files: map[string][]uint64{
+				"a": with(nil, 20, 21),
+				"b": with([]uint64{1}, 30),
+				"c": with([]uint64{2}, 20, 21),
+			},
+			order: []string{"a", "b", "c"},
+			want:  []string{"[a:1-8 c:2-9]", "[a:1-6 b:2-7]"},
+		},
+		{
+			name:  "shorter than the minimum",
+			files: map[string][]uint64{"a": {1, 2, 3, 4, 9}, "b": {1, 2, 3, 4, 8}},
+			order: []string{"a", "b"},
+			want:  nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			detector := newCloneDetector(5)
+			for _, name := range tt.order {
+				detector.files = append(detector.files, testCloneFile(name, tt.files[name]...))
+			}
+
+			var got []string
+			for _, block := range detector.report("").Blocks {
+				var locations []string
+				for _, location := range block.Locations {
+					locations = append(locations, fmt.Sprintf("%s:%d-%d", location.File, location.StartLine, location.EndLine))
+				}
+				got = append(got, fmt.Sprint(locations))
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("blocks = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDetectDuplicationRenamedCopies(t *testing.T) {
+	source := `package main
+
+func %s(values []int) int {
+	total := 0
+	for _, value := range values {
+		if value > 10 {
+			total += value * 2
+		} else {
+			total -= value
+		}
+	}
+	return total
+}
+`
+	detector := newCloneDetector(20)
+	for i, name := range []string{"sum", "score", "weight"} {
+		content := fmt.Sprintf(source, name)
+		if i == 2 {
+			content += "\nvar extra = 1\n"
+		}
+		detector.add(SourceFile{Path: fmt.Sprintf("f%d.go", i), Language: "Go"}, []byte(content))
+	}
+
+	report := detector.report("")
+	if len(report.Blocks) != 1 || len(report.Blocks[0].Locations) != 3 {
+		t.Fatalf("blocks = %+v, want one block with three copies", report.Blocks)
+	}
+	if report.DuplicatedLines == 0 || report.Percentage == 0 {
+		t.Errorf("duplicated lines = %d (%.1f%%), want them counted", report.DuplicatedLines, report.Percentage)
+	}
+}
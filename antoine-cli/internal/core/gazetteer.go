@@ -0,0 +1,205 @@
+package core
+
+// gazetteerEntry es una ciudad del gazetteer offline usado para geocodificar sin red
+type gazetteerEntry struct {
+	City      string
+	Country   string
+	Code      string // ISO 3166-1 alpha-2
+	Latitude  float64
+	Longitude float64
+}
+
+// gazetteer incluye las ciudades con más actividad de hackathons. Cuando un nombre es ambiguo
+// (Cambridge, Portland...) va primero la ciudad más habitual; el país permite elegir la otra.
+var gazetteer = []gazetteerEntry{
+	// Norteamérica
+	{"San Francisco", "United States", "US", 37.7749, -122.4194},
+	{"New York", "United States", "US", 40.7128, -74.0060},
+	{"Boston", "United States", "US", 42.3601, -71.0589},
+	{"Cambridge", "United States", "US", 42.3736, -71.1097},
+	{"Seattle", "United States", "US", 47.6062, -122.3321},
+	{"Los Angeles", "United States", "US", 34.0522, -118.2437},
+	{"San Diego", "United States", "US", 32.7157, -117.1611},
+	{"San Jose", "United States", "US", 37.3382, -121.8863},
+	{"Palo Alto", "United States", "US", 37.4419, -122.1430},
+	{"Mountain View", "United States", "US", 37.3861, -122.0839},
+	{"Berkeley", "United States", "US", 37.8715, -122.2730},
+	{"Oakland", "United States", "US", 37.8044, -122.2712},
+	{"Stanford", "United States", "US", 37.4275, -122.1697},
+	{"Austin", "United States", "US", 30.2672, -97.7431},
+	{"Dallas", "United States", "US", 32.7767, -96.7970},
+	{"Houston", "United States", "US", 29.7604, -95.3698},
+	{"Chicago", "United States", "US", 41.8781, -87.6298},
+	{"Denver", "United States", "US", 39.7392, -104.9903},
+	{"Boulder", "United States", "US", 40.0150, -105.2705},
+	{"Atlanta", "United States", "US", 33.7490, -84.3880},
+	{"Miami", "United States", "US", 25.7617, -80.1918},
+	{"Washington", "United States", "US", 38.9072, -77.0369},
+	{"Philadelphia", "United States", "US", 39.9526, -75.1652},
+	{"Pittsburgh", "United States", "US", 40.4406, -79.9959},
+	{"Ann Arbor", "United States", "US", 42.2808, -83.7430},
+	{"Detroit", "United States", "US", 42.3314, -83.0458},
+	{"Minneapolis", "United States", "US", 44.9778, -93.2650},
+	{"Portland", "United States", "US", 45.5152, -122.6784},
+	{"Salt Lake City", "United States", "US", 40.7608, -111.8910},
+	{"Phoenix", "United States", "US", 33.4484, -112.0740},
+	{"Las Vegas", "United States", "US", 36.1699, -115.1398},
+	{"Nashville", "United States", "US", 36.1627, -86.7816},
+	{"Raleigh", "United States", "US", 35.7796, -78.6382},
+	{"Princeton", "United States", "US", 40.3573, -74.6672},
+	{"New Haven", "United States", "US", 41.3083, -72.9279},
+	{"Ithaca", "United States", "US", 42.4440, -76.5019},
+	{"Urbana", "United States", "US", 40.1106, -88.2073},
+	{"Toronto", "Canada", "CA", 43.6532, -79.3832},
+	{"Waterloo", "Canada", "CA", 43.4643, -80.5204},
+	{"Montreal", "Canada", "CA", 45.5017, -73.5673},
+	{"Vancouver", "Canada", "CA", 49.2827, -123.1207},
+	{"Ottawa", "Canada", "CA", 45.4215, -75.6972},
+	{"Calgary", "Canada", "CA", 51.0447, -114.0719},
+	{"Mexico City", "Mexico", "MX", 19.4326, -99.1332},
+	{"Guadalajara", "Mexico", "MX", 20.6597, -103.3496},
+	{"Monterrey", "Mexico", "MX", 25.6866, -100.3161},
+
+	// Sudamérica
+	{"São Paulo", "Brazil", "BR", -23.5505, -46.6333},
+	{"Rio de Janeiro", "Brazil", "BR", -22.9068, -43.1729},
+	{"Buenos Aires", "Argentina", "AR", -34.6037, -58.3816},
+	{"Santiago", "Chile", "CL", -33.4489, -70.6693},
+	{"Bogotá", "Colombia", "CO", 4.7110, -74.0721},
+	{"Medellín", "Colombia", "CO", 6.2442, -75.5812},
+	{"Lima", "Peru", "PE", -12.0464, -77.0428},
+	{"Montevideo", "Uruguay", "UY", -34.9011, -56.1645},
+
+	// Europa
+	{"London", "United Kingdom", "GB", 51.5074, -0.1278},
+	{"Cambridge", "United Kingdom", "GB", 52.2053, 0.1218},
+	{"Oxford", "United Kingdom", "GB", 51.7520, -1.2577},
+	{"Manchester", "United Kingdom", "GB", 53.4808, -2.2426},
+	{"Edinburgh", "United Kingdom", "GB", 55.9533, -3.1883},
+	{"Bristol", "United Kingdom", "GB", 51.4545, -2.5879},
+	{"Dublin", "Ireland", "IE", 53.3498, -6.2603},
+	{"Paris", "France", "FR", 48.8566, 2.3522},
+	{"Lyon", "France", "FR", 45.7640, 4.8357},
+	{"Toulouse", "France", "FR", 43.6047, 1.4442},
+	{"Berlin", "Germany", "DE", 52.5200, 13.4050},
+	{"Munich", "Germany", "DE", 48.1351, 11.5820},
+	{"Hamburg", "Germany", "DE", 53.5511, 9.9937},
+	{"Frankfurt", "Germany", "DE", 50.1109, 8.6821},
+	{"Cologne", "Germany", "DE", 50.9375, 6.9603},
+	{"Stuttgart", "Germany", "DE", 48.7758, 9.1829},
+	{"Amsterdam", "Netherlands", "NL", 52.3676, 4.9041},
+	{"Rotterdam", "Netherlands", "NL", 51.9244, 4.4777},
+	{"Eindhoven", "Netherlands", "NL", 51.4416, 5.4697},
+	{"Delft", "Netherlands", "NL", 52.0116, 4.3571},
+	{"Brussels", "Belgium", "BE", 50.8503, 4.3517},
+	{"Luxembourg", "Luxembourg", "LU", 49.6116, 6.1319},
+	{"Zurich", "Switzerland", "CH", 47.3769, 8.5417},
+	{"Geneva", "Switzerland", "CH", 46.2044, 6.1432},
+	{"Lausanne", "Switzerland", "CH", 46.5197, 6.6323},
+	{"Vienna", "Austria", "AT", 48.2082, 16.3738},
+	{"Madrid", "Spain", "ES", 40.4168, -3.7038},
+	{"Barcelona", "Spain", "ES", 41.3874, 2.1686},
+	{"Valencia", "Spain", "ES", 39.4699, -0.3763},
+	{"Seville", "Spain", "ES", 37.3891, -5.9845},
+	{"Málaga", "Spain", "ES", 36.7213, -4.4214},
+	{"Bilbao", "Spain", "ES", 43.2630, -2.9350},
+	{"Lisbon", "Portugal", "PT", 38.7223, -9.1393},
+	{"Porto", "Portugal", "PT", 41.1579, -8.6291},
+	{"Milan", "Italy", "IT", 45.4642, 9.1900},
+	{"Rome", "Italy", "IT", 41.9028, 12.4964},
+	{"Turin", "Italy", "IT", 45.0703, 7.6869},
+	{"Copenhagen", "Denmark", "DK", 55.6761, 12.5683},
+	{"Stockholm", "Sweden", "SE", 59.3293, 18.0686},
+	{"Oslo", "Norway", "NO", 59.9139, 10.7522},
+	{"Helsinki", "Finland", "FI", 60.1699, 24.9384},
+	{"Tallinn", "Estonia", "EE", 59.4370, 24.7536},
+	{"Riga", "Latvia", "LV", 56.9496, 24.1052},
+	{"Vilnius", "Lithuania", "LT", 54.6872, 25.2797},
+	{"Warsaw", "Poland", "PL", 52.2297, 21.0122},
+	{"Kraków", "Poland", "PL", 50.0647, 19.9450},
+	{"Prague", "Czech Republic", "CZ", 50.0755, 14.4378},
+	{"Budapest", "Hungary", "HU", 47.4979, 19.0402},
+	{"Bucharest", "Romania", "RO", 44.4268, 26.1025},
+	{"Sofia", "Bulgaria", "BG", 42.6977, 23.3219},
+	{"Athens", "Greece", "GR", 37.9838, 23.7275},
+	{"Belgrade", "Serbia", "RS", 44.7866, 20.4489},
+	{"Zagreb", "Croatia", "HR", 45.8150, 15.9819},
+	{"Ljubljana", "Slovenia", "SI", 46.0569, 14.5058},
+	{"Kyiv", "Ukraine", "UA", 50.4501, 30.5234},
+	{"Istanbul", "Turkey", "TR", 41.0082, 28.9784},
+
+	// Oriente Medio y África
+	{"Tel Aviv", "Israel", "IL", 32.0853, 34.7818},
+	{"Dubai", "United Arab Emirates", "AE", 25.2048, 55.2708},
+	{"Abu Dhabi", "United Arab Emirates", "AE", 24.4539, 54.3773},
+	{"Riyadh", "Saudi Arabia", "SA", 24.7136, 46.6753},
+	{"Doha", "Qatar", "QA", 25.2854, 51.5310},
+	{"Cairo", "Egypt", "EG", 30.0444, 31.2357},
+	{"Lagos", "Nigeria", "NG", 6.5244, 3.3792},
+	{"Nairobi", "Kenya", "KE", -1.2921, 36.8219},
+	{"Accra", "Ghana", "GH", 5.6037, -0.1870},
+	{"Kigali", "Rwanda", "RW", -1.9441, 30.0619},
+	{"Cape Town", "South Africa", "ZA", -33.9249, 18.4241},
+	{"Johannesburg", "South Africa", "ZA", -26.2041, 28.0473},
+	{"Casablanca", "Morocco", "MA", 33.5731, -7.5898},
+
+	// Asia y Oceanía
+	{"Bangalore", "India", "IN", 12.9716, 77.5946},
+	{"Mumbai", "India", "IN", 19.0760, 72.8777},
+	{"Delhi", "India", "IN", 28.7041, 77.1025},
+	{"Hyderabad", "India", "IN", 17.3850, 78.4867},
+	{"Chennai", "India", "IN", 13.0827, 80.2707},
+	{"Pune", "India", "IN", 18.5204, 73.8567},
+	{"Kolkata", "India", "IN", 22.5726, 88.3639},
+	{"Singapore", "Singapore", "SG", 1.3521, 103.8198},
+	{"Kuala Lumpur", "Malaysia", "MY", 3.1390, 101.6869},
+	{"Jakarta", "Indonesia", "ID", -6.2088, 106.8456},
+	{"Bangkok", "Thailand", "TH", 13.7563, 100.5018},
+	{"Ho Chi Minh City", "Vietnam", "VN", 10.8231, 106.6297},
+	{"Hanoi", "Vietnam", "VN", 21.0278, 105.8342},
+	{"Manila", "Philippines", "PH", 14.5995, 120.9842},
+	{"Hong Kong", "Hong Kong", "HK", 22.3193, 114.1694},
+	{"Shenzhen", "China", "CN", 22.5431, 114.0579},
+	{"Shanghai", "China", "CN", 31.2304, 121.4737},
+	{"Beijing", "China", "CN", 39.9042, 116.4074},
+	{"Hangzhou", "China", "CN", 30.2741, 120.1551},
+	{"Taipei", "Taiwan", "TW", 25.0330, 121.5654},
+	{"Seoul", "South Korea", "KR", 37.5665, 126.9780},
+	{"Tokyo", "Japan", "JP", 35.6762, 139.6503},
+	{"Osaka", "Japan", "JP", 34.6937, 135.5023},
+	{"Kyoto", "Japan", "JP", 35.0116, 135.7681},
+	{"Sydney", "Australia", "AU", -33.8688, 151.2093},
+	{"Melbourne", "Australia", "AU", -37.8136, 144.9631},
+	{"Brisbane", "Australia", "AU", -27.4698, 153.0251},
+	{"Perth", "Australia", "AU", -31.9505, 115.8605},
+	{"Auckland", "New Zealand", "NZ", -36.8485, 174.7633},
+	{"Wellington", "New Zealand", "NZ", -41.2865, 174.7762},
+}
+
+// gazetteerAliases traduce nombres alternativos habituales al nombre del gazetteer
+var gazetteerAliases = map[string]string{
+	"nyc":            "new york",
+	"new york city":  "new york",
+	"sf":             "san francisco",
+	"bay area":       "san francisco",
+	"silicon valley": "palo alto",
+	"la":             "los angeles",
+	"dc":             "washington",
+	"washington dc":  "washington",
+	"bengaluru":      "bangalore",
+	"new delhi":      "delhi",
+	"bombay":         "mumbai",
+	"munchen":        "munich",
+	"koln":           "cologne",
+	"wien":           "vienna",
+	"lisboa":         "lisbon",
+	"sevilla":        "seville",
+	"milano":         "milan",
+	"roma":           "rome",
+	"torino":         "turin",
+	"praha":          "prague",
+	"warszawa":       "warsaw",
+	"kiev":           "kyiv",
+	"saigon":         "ho chi minh city",
+	"cdmx":           "mexico city",
+}
@@ -0,0 +1,233 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"antoine-cli/internal/models"
+)
+
+// earthRadiusKm es el radio medio de la Tierra usado por la fórmula de haversine
+const earthRadiusKm = 6371.0
+
+// GeoFilter filtra hackathons por distancia a un punto y los ordena del más cercano al más lejano
+type GeoFilter struct {
+	Center        models.LatLng
+	Place         string  // nombre resuelto del punto, para mostrarlo
+	RadiusKm      float64 // 0 = sin límite
+	IncludeOnline bool    // incluir eventos online, y los híbridos fuera del radio
+}
+
+// NewGeoFilter geocodifica near ("Madrid", "Cambridge, UK" o "40.41,-3.70") y crea el filtro
+func NewGeoFilter(near string, radiusKm float64, includeOnline bool) (*GeoFilter, error) {
+	center, place, err := Geocode(near)
+	if err != nil {
+		return nil, err
+	}
+	return &GeoFilter{Center: center, Place: place, RadiusKm: radiusKm, IncludeOnline: includeOnline}, nil
+}
+
+// Distance devuelve la distancia en km al hackathon, si tiene ubicación física conocida
+func (g *GeoFilter) Distance(hackathon *models.Hackathon) (float64, bool) {
+	point, ok := HackathonCoordinates(hackathon)
+	if !ok {
+		return 0, false
+	}
+	return HaversineKm(g.Center, point), true
+}
+
+// Apply devuelve los hackathons que cumplen el filtro, ordenados por distancia.
+// Presenciales: dentro del radio. Híbridos: dentro del radio, o siempre con IncludeOnline.
+// Online: solo con IncludeOnline, al final y en el orden original.
+func (g *GeoFilter) Apply(hackathons []*models.Hackathon) []*models.Hackathon {
+	type match struct {
+		hackathon *models.Hackathon
+		distance  float64
+		located   bool
+	}
+
+	var matches []match
+	for _, hackathon := range hackathons {
+		kind := strings.ToLower(hackathon.Location.Type)
+		distance, located := g.Distance(hackathon)
+		inside := located && (g.RadiusKm <= 0 || distance <= g.RadiusKm)
+
+		switch {
+		case kind == "online":
+			if !g.IncludeOnline {
+				continue
+			}
+			located = false
+		case kind == "hybrid":
+			if !inside && !g.IncludeOnline {
+				continue
+			}
+		default:
+			if !inside {
+				continue
+			}
+		}
+		matches = append(matches, match{hackathon: hackathon, distance: distance, located: located})
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].located != matches[j].located {
+			return matches[i].located
+		}
+		return matches[i].located && matches[i].distance < matches[j].distance
+	})
+
+	result := make([]*models.Hackathon, len(matches))
+	for i, m := range matches {
+		result[i] = m.hackathon
+	}
+	return result
+}
+
+// Describe resume el filtro en una línea
+func (g *GeoFilter) Describe() string {
+	description := "near " + g.Place
+	if g.RadiusKm > 0 {
+		description += fmt.Sprintf(" (≤ %s)", FormatDistance(g.RadiusKm))
+	}
+	if g.IncludeOnline {
+		description += " + online"
+	}
+	return description
+}
+
+// HackathonCoordinates devuelve las coordenadas del hackathon, o las de su ciudad en el gazetteer
+func HackathonCoordinates(hackathon *models.Hackathon) (models.LatLng, bool) {
+	location := hackathon.Location
+	if location.Coordinates != nil && (location.Coordinates.Latitude != 0 || location.Coordinates.Longitude != 0) {
+		return *location.Coordinates, true
+	}
+	if location.City == "" {
+		return models.LatLng{}, false
+	}
+
+	entry, ok := lookupGazetteer(location.City, location.Country)
+	if !ok {
+		return models.LatLng{}, false
+	}
+	return models.LatLng{Latitude: entry.Latitude, Longitude: entry.Longitude}, true
+}
+
+// Geocode resuelve "lat,lng" o un nombre de ciudad (opcionalmente ", país") con el gazetteer offline
+func Geocode(query string) (models.LatLng, string, error) {
+	query = strings.TrimSpace(query)
+	if point, ok := parseLatLng(query); ok {
+		return point, fmt.Sprintf("%.4f,%.4f", point.Latitude, point.Longitude), nil
+	}
+
+	city, country := query, ""
+	if i := strings.Index(query, ","); i >= 0 {
+		city, country = query[:i], query[i+1:]
+	}
+
+	entry, ok := lookupGazetteer(city, country)
+	if !ok {
+		return models.LatLng{}, "", fmt.Errorf("unknown place %q: use a major city name or coordinates like 40.4168,-3.7038", query)
+	}
+	return models.LatLng{Latitude: entry.Latitude, Longitude: entry.Longitude}, entry.City + ", " + entry.Country, nil
+}
+
+// lookupGazetteer busca una ciudad; el país (nombre o código ISO) desempata nombres repetidos.
+// Si se da un país que no coincide no hay resultado: mejor sin ubicación que en otro continente
+func lookupGazetteer(city, country string) (gazetteerEntry, bool) {
+	city = normalizePlace(city)
+	if alias, ok := gazetteerAliases[city]; ok {
+		city = alias
+	}
+	country = normalizePlace(country)
+
+	for _, entry := range gazetteer {
+		if normalizePlace(entry.City) != city {
+			continue
+		}
+		if country == "" || country == normalizePlace(entry.Country) || country == strings.ToLower(entry.Code) ||
+			(country == "uk" && entry.Code == "GB") || (country == "usa" && entry.Code == "US") {
+			return entry, true
+		}
+	}
+	return gazetteerEntry{}, false
+}
+
+// normalizePlace pasa a minúsculas y quita acentos y puntuación para comparar nombres de lugares
+func normalizePlace(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.NewReplacer(
+		"á", "a", "à", "a", "ã", "a", "â", "a", "ä", "a",
+		"é", "e", "è", "e", "ê", "e", "ë", "e",
+		"í", "i", "ì", "i", "î", "i", "ï", "i",
+		"ó", "o", "ò", "o", "õ", "o", "ô", "o", "ö", "o",
+		"ú", "u", "ù", "u", "û", "u", "ü", "u",
+		"ñ", "n", "ç", "c", "ł", "l", "ø", "o", "å", "a",
+		".", "", "-", " ",
+	).Replace(name)
+	return strings.Join(strings.Fields(name), " ")
+}
+
+// parseLatLng reconoce "lat,lng" con coordenadas válidas
+func parseLatLng(value string) (models.LatLng, bool) {
+	parts := strings.Split(value, ",")
+	if len(parts) != 2 {
+		return models.LatLng{}, false
+	}
+
+	lat, err := strconv.ParseFloat(strings.TrimSpace(parts[0]), 64)
+	if err != nil || lat < -90 || lat > 90 {
+		return models.LatLng{}, false
+	}
+	lng, err := strconv.ParseFloat(strings.TrimSpace(parts[1]), 64)
+	if err != nil || lng < -180 || lng > 180 {
+		return models.LatLng{}, false
+	}
+	return models.LatLng{Latitude: lat, Longitude: lng}, true
+}
+
+// HaversineKm calcula la distancia de círculo máximo entre dos puntos
+func HaversineKm(a, b models.LatLng) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+
+	dLat := toRad(b.Latitude - a.Latitude)
+	dLng := toRad(b.Longitude - a.Longitude)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(a.Latitude))*math.Cos(toRad(b.Latitude))*math.Sin(dLng/2)*math.Sin(dLng/2)
+
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// ParseDistance convierte "100km", "60mi", "500m" o "100" (km) a kilómetros
+func ParseDistance(value string) (float64, error) {
+	value = strings.ToLower(strings.ReplaceAll(value, " ", ""))
+
+	units := []struct {
+		suffix string
+		factor float64
+	}{
+		{"km", 1}, {"mi", 1.609344}, {"m", 0.001}, {"", 1},
+	}
+	for _, unit := range units {
+		if !strings.HasSuffix(value, unit.suffix) {
+			continue
+		}
+		number, err := strconv.ParseFloat(strings.TrimSuffix(value, unit.suffix), 64)
+		if err != nil || number < 0 {
+			break
+		}
+		return number * unit.factor, nil
+	}
+	return 0, fmt.Errorf("invalid distance %q: use a value like 100km or 60mi", value)
+}
+
+// FormatDistance muestra una distancia en km redondeada
+func FormatDistance(km float64) string {
+	if km < 10 {
+		return fmt.Sprintf("%.1f km", km)
+	}
+	return fmt.Sprintf("%.0f km", km)
+}
@@ -0,0 +1,33 @@
+package core
+
+import "testing"
+
+func TestLookupGazetteer(t *testing.T) {
+	tests := []struct {
+		name        string
+		city        string
+		country     string
+		wantOK      bool
+		wantCountry string
+	}{
+		{"city only", "Madrid", "", true, "Spain"},
+		{"country name", "Cambridge", "United Kingdom", true, "United Kingdom"},
+		{"iso code", "Cambridge", "US", true, "United States"},
+		{"uk alias", "Cambridge", "UK", true, "United Kingdom"},
+		{"accents and case", "  MADRÍD ", "spain", true, "Spain"},
+		{"country mismatch", "Paris", "United States", false, ""},
+		{"unknown city", "Atlantis", "", false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entry, ok := lookupGazetteer(tt.city, tt.country)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v (entry %+v)", ok, tt.wantOK, entry)
+			}
+			if ok && entry.Country != tt.wantCountry {
+				t.Errorf("country = %s, want %s", entry.Country, tt.wantCountry)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// gitTimeout acota cada llamada a git para que un repositorio bloqueado no detenga el análisis
+const gitTimeout = 10 * time.Second
+
+// runGit ejecuta git en root y devuelve su salida estándar
+func runGit(ctx context.Context, root string, args ...string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, gitTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "git", append([]string{"-C", root}, args...)...)
+	// Solo se lee: que git no tome index.lock mientras otro proceso trabaja en el repositorio
+	cmd.Env = append(os.Environ(), "GIT_OPTIONAL_LOCKS=0")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("git %s: %w: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return output, nil
+}
+
+// gitTrackedFiles devuelve los archivos que git sigue bajo root, relativos a root y con "/"
+func gitTrackedFiles(ctx context.Context, root string) ([]string, error) {
+	output, err := runGit(ctx, root, "ls-files", "-z")
+	if err != nil {
+		return nil, err
+	}
+	var files []string
+	for _, file := range strings.Split(string(output), "\x00") {
+		if file != "" {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+// gitCommitTime devuelve la fecha de commit de HEAD
+func gitCommitTime(ctx context.Context, root string) (time.Time, error) {
+	output, err := runGit(ctx, root, "log", "-1", "--format=%ct")
+	if err != nil {
+		return time.Time{}, err
+	}
+	seconds, err := strconv.ParseInt(strings.TrimSpace(string(output)), 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid commit time %q", output)
+	}
+	return time.Unix(seconds, 0), nil
+}
+
+// latestModTime devuelve la modificación más reciente de los archivos bajo root, sin entrar en .git
+func latestModTime(root string) time.Time {
+	var latest time.Time
+	filepath.WalkDir(root, func(current string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return nil // un directorio ilegible no invalida el resto
+		}
+		if entry.IsDir() {
+			if entry.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+		if info, err := entry.Info(); err == nil && info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+		return nil
+	})
+	return latest
+}
@@ -0,0 +1,140 @@
+package core
+
+import (
+	"bufio"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// ignoreRule es un patrón de .gitignore compilado a expresión regular
+type ignoreRule struct {
+	pattern  *regexp.Regexp
+	negate   bool
+	dirOnly  bool
+	original string
+}
+
+// GitIgnore acumula las reglas de los .gitignore de un árbol de trabajo.
+// Las rutas son relativas a la raíz y con "/" como separador.
+type GitIgnore struct {
+	rules []ignoreRule
+}
+
+// LoadGitIgnore lee las reglas de file (un .gitignore o .git/info/exclude), que se aplican dentro de base
+func (g *GitIgnore) LoadGitIgnore(file, base string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		g.AddPattern(scanner.Text(), base)
+	}
+	return scanner.Err()
+}
+
+// AddPattern añade una línea de .gitignore que se aplica dentro de base ("" = raíz)
+func (g *GitIgnore) AddPattern(line, base string) {
+	line = strings.TrimRight(line, " \t\r")
+	if line == "" || strings.HasPrefix(line, "#") {
+		return
+	}
+
+	rule := ignoreRule{original: line}
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	}
+	line = strings.TrimPrefix(line, "\\") // \# y \! escapan el primer carácter
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+	if line == "" {
+		return
+	}
+
+	// Con una barra que no sea la final, el patrón es relativo al directorio del .gitignore
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+
+	prefix := ""
+	if base != "" && base != "." {
+		prefix = regexp.QuoteMeta(strings.TrimSuffix(base, "/")) + "/"
+	}
+	expr := "^" + prefix
+	if !anchored {
+		expr += "(?:.*/)?"
+	}
+	expr += globToRegexp(line) + "$"
+
+	compiled, err := regexp.Compile(expr)
+	if err != nil {
+		return
+	}
+	rule.pattern = compiled
+	g.rules = append(g.rules, rule)
+}
+
+// Ignored indica si la ruta relativa está ignorada; la última regla que coincide decide
+func (g *GitIgnore) Ignored(relPath string, isDir bool) bool {
+	relPath = path.Clean(strings.TrimPrefix(relPath, "./"))
+	ignored := false
+	for _, rule := range g.rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		if rule.pattern.MatchString(relPath) {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
+
+// globToRegexp traduce la sintaxis glob de git (*, ?, [..], **) a una expresión regular
+func globToRegexp(glob string) string {
+	var expr strings.Builder
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			expr.WriteString("(?:.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "/**") && i+3 == len(glob):
+			expr.WriteString("/.*")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			expr.WriteString(".*")
+			i++
+		case c == '*':
+			expr.WriteString("[^/]*")
+		case c == '?':
+			expr.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				expr.WriteString(`\[`)
+				continue
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expr.WriteString("[" + strings.ReplaceAll(class, `\`, `\\`) + "]")
+			i += end + 1
+		case c == '\\' && i+1 < len(glob):
+			i++
+			expr.WriteString(regexp.QuoteMeta(string(glob[i])))
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return expr.String()
+}
@@ -0,0 +1,200 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/utils"
+)
+
+// hackathonCacheFile guarda los hackathons de las búsquedas en vivo para usarlos sin conexión
+const hackathonCacheFile = "hackathons.json"
+
+// Orígenes de los hackathons candidatos
+const (
+	SourceAuto  = "auto"  // búsqueda en vivo, y la caché si falla o no devuelve nada
+	SourceLive  = "live"  // solo búsqueda en vivo
+	SourceCache = "cache" // solo la caché local
+)
+
+// HackathonCache es el contenido de ~/.antoine/hackathons.json
+type HackathonCache struct {
+	Hackathons []*models.Hackathon `json:"hackathons"`
+	UpdatedAt  time.Time           `json:"updated_at"`
+}
+
+// LoadHackathonCache carga los hackathons guardados por búsquedas anteriores
+func LoadHackathonCache() (*HackathonCache, error) {
+	path, err := DataPath(hackathonCacheFile)
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &HackathonCache{}
+	if _, err := readJSONFile(path, cache); err != nil {
+		return nil, err
+	}
+	return cache, nil
+}
+
+// storeHackathons añade los hackathons a la caché local, sustituyendo los que ya estaban
+func storeHackathons(hackathons []*models.Hackathon) error {
+	if len(hackathons) == 0 {
+		return nil
+	}
+
+	cache, err := LoadHackathonCache()
+	if err != nil {
+		return err
+	}
+
+	index := make(map[string]int, len(cache.Hackathons))
+	for i, hackathon := range cache.Hackathons {
+		index[hackathonKey(hackathon)] = i
+	}
+
+	for _, hackathon := range hackathons {
+		key := hackathonKey(hackathon)
+		if i, ok := index[key]; ok {
+			cache.Hackathons[i] = hackathon
+			continue
+		}
+		index[key] = len(cache.Hackathons)
+		cache.Hackathons = append(cache.Hackathons, hackathon)
+	}
+	cache.UpdatedAt = time.Now()
+
+	path, err := DataPath(hackathonCacheFile)
+	if err != nil {
+		return err
+	}
+	return writeJSONFile(path, cache)
+}
+
+// hackathonKey identifica un hackathon por ID, o por URL/nombre si no lo tiene
+func hackathonKey(hackathon *models.Hackathon) string {
+	switch {
+	case hackathon.ID != "":
+		return "id:" + hackathon.ID
+	case hackathon.URL != "":
+		return "url:" + strings.TrimSuffix(strings.ToLower(hackathon.URL), "/")
+	default:
+		return "name:" + strings.ToLower(hackathon.Name)
+	}
+}
+
+// FindCachedHackathon busca en la caché local un hackathon por ID, URL o nombre.
+// El nombre puede ser parcial si identifica a un único hackathon.
+func FindCachedHackathon(ref string) (*models.Hackathon, error) {
+	cache, err := LoadHackathonCache()
+	if err != nil {
+		return nil, err
+	}
+
+	hackathon, err := findHackathon(cache.Hackathons, ref)
+	if err != nil {
+		return nil, fmt.Errorf("%w in the local cache: search for it first with 'antoine search hackathons'", err)
+	}
+	return hackathon, nil
+}
+
+// findHackathon busca por ID, URL o nombre exacto, o por un nombre parcial que sea único
+func findHackathon(hackathons []*models.Hackathon, ref string) (*models.Hackathon, error) {
+	ref = strings.TrimSpace(ref)
+	var partial []*models.Hackathon
+
+	for _, hackathon := range hackathons {
+		if hackathonMatches(hackathon, ref) {
+			return hackathon, nil
+		}
+		if strings.Contains(strings.ToLower(hackathon.Name), strings.ToLower(ref)) {
+			partial = append(partial, hackathon)
+		}
+	}
+
+	switch len(partial) {
+	case 0:
+		return nil, fmt.Errorf("hackathon %q not found", ref)
+	case 1:
+		return partial[0], nil
+	}
+
+	var names []string
+	for _, hackathon := range partial[:min(3, len(partial))] {
+		names = append(names, hackathon.Name)
+	}
+	return nil, fmt.Errorf("%q matches %d hackathons (%s…): use the ID or URL", ref, len(partial), strings.Join(names, ", "))
+}
+
+// hackathonMatches indica si ref es el ID, la URL o el nombre exacto del hackathon
+func hackathonMatches(hackathon *models.Hackathon, ref string) bool {
+	refURL := strings.TrimSuffix(strings.ToLower(ref), "/")
+	return (hackathon.ID != "" && hackathon.ID == ref) ||
+		(hackathon.URL != "" && strings.TrimSuffix(strings.ToLower(hackathon.URL), "/") == refURL) ||
+		strings.EqualFold(hackathon.Name, ref)
+}
+
+// HackathonCandidates obtiene hackathons de la búsqueda en vivo y/o de la caché local.
+// Devuelve también el origen usado finalmente.
+func (c *AntoineClient) HackathonCandidates(ctx context.Context, query string, filters map[string]interface{}, source string) ([]*models.Hackathon, string, error) {
+	if source == "" {
+		source = SourceAuto
+	}
+
+	var liveErr error
+	if source == SourceLive || source == SourceAuto {
+		hackathons, err := c.SearchHackathons(ctx, query, filters)
+		if err == nil && (len(hackathons) > 0 || source == SourceLive) {
+			return hackathons, SourceLive, nil
+		}
+		if source == SourceLive {
+			return nil, SourceLive, err
+		}
+		liveErr = err
+		if err != nil {
+			utils.WithError(err).Debug("Live hackathon search failed, using local cache")
+		}
+	}
+
+	cache, err := LoadHackathonCache()
+	if err != nil {
+		return nil, SourceCache, err
+	}
+	if len(cache.Hackathons) == 0 {
+		if liveErr != nil {
+			return nil, SourceCache, fmt.Errorf("live search failed and the local cache is empty: %w", liveErr)
+		}
+		return nil, SourceCache, fmt.Errorf("no cached hackathons yet: run 'antoine search hackathons' first")
+	}
+
+	return cache.Hackathons, SourceCache, nil
+}
+
+// SortHackathons ordena los hackathons por start_date, prize_pool, popularity o name.
+// order es asc o desc. Los premios se comparan en la moneda base.
+func SortHackathons(hackathons []*models.Hackathon, sortBy, order string) {
+	prizes := Prizes()
+	less := func(a, b *models.Hackathon) bool {
+		switch sortBy {
+		case "prize_pool", "prize":
+			return prizes.Amount(a) < prizes.Amount(b)
+		case "popularity":
+			return a.ParticipantCount < b.ParticipantCount
+		case "name":
+			return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+		default:
+			return a.StartDate.Before(b.StartDate)
+		}
+	}
+
+	sort.SliceStable(hackathons, func(i, j int) bool {
+		if order == "desc" {
+			return less(hackathons[j], hackathons[i])
+		}
+		return less(hackathons[i], hackathons[j])
+	})
+}
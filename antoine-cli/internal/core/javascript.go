@@ -0,0 +1,250 @@
+package core
+
+import "strings"
+
+var scriptSyntax = lexerSyntax{
+	lineComments:  []string{"//"},
+	blockStart:    "/*",
+	blockEnd:      "*/",
+	templates:     true,
+	regexLiterals: true,
+}
+
+var scriptControls = map[string]bool{
+	"if": true, "else": true, "for": true, "while": true, "do": true,
+	"switch": true, "case": true, "catch": true,
+}
+
+// scriptKeywords no pueden ser el nombre de un método
+var scriptKeywords = map[string]bool{
+	"if": true, "for": true, "while": true, "switch": true, "catch": true, "function": true,
+	"return": true, "typeof": true, "new": true, "await": true, "yield": true, "super": true,
+	"import": true, "export": true, "with": true, "do": true, "else": true, "try": true,
+	"class": true, "delete": true, "void": true, "throw": true, "in": true, "of": true,
+}
+
+// scriptModifiers pueden preceder al nombre de un método
+var scriptModifiers = map[string]bool{
+	"async": true, "static": true, "get": true, "set": true, "public": true, "private": true,
+	"protected": true, "readonly": true, "override": true, "abstract": true, "*": true,
+}
+
+// typeKeywords son los tipos que delatan una flecha de tipo de función: (x: T) => void
+var typeKeywords = map[string]bool{
+	"void": true, "string": true, "number": true, "boolean": true, "any": true,
+	"unknown": true, "never": true, "object": true, "bigint": true, "symbol": true,
+}
+
+// testLibraries son los módulos cuyo import marca un archivo como test
+var testLibraries = []string{"jest", "vitest", "mocha", "chai", "@testing-library", "ava", "node:test", "supertest", "cypress", "playwright"}
+
+// scriptAnalyzer analiza JavaScript y TypeScript sobre los tokens: funciones, funciones
+// flecha, métodos y clases, con la complejidad de cada función
+type scriptAnalyzer struct {
+	typescript bool
+}
+
+func newScriptAnalyzer(typescript bool) scriptAnalyzer {
+	return scriptAnalyzer{typescript: typescript}
+}
+
+func (a scriptAnalyzer) Analyze(file SourceFile, content []byte) (FileMetrics, error) {
+	tokens := tokenize(content, scriptSyntax)
+	w := newBraceWalker(file, significantTokens(tokens), scriptControls, "switch")
+
+	for i := 0; i < len(w.tokens); i++ {
+		t := w.tokens[i]
+		switch {
+		case t.kind == tokenIdent && t.text == "function" && w.text(i-1) != ".":
+			a.function(w, i)
+		case t.kind == tokenIdent && t.text == "class" && w.text(i-1) != "." && w.text(i+1) != ":":
+			w.classes++
+			name := "<class>"
+			if w.isIdent(i+1) && w.text(i+1) != "extends" && w.text(i+1) != "implements" {
+				name = w.text(i + 1)
+			}
+			w.expectClass(name, frameClass)
+		case a.typescript && t.kind == tokenIdent && (t.text == "interface" || t.text == "type") && a.typeDeclaration(w, i):
+			w.expectClass(w.text(i+1), frameType)
+		case t.kind == tokenPunct && t.text == "=>":
+			a.arrow(w, i)
+		case t.kind == tokenPunct && t.text == "?":
+			// Ternario; x?: T y f(x?) son parámetros opcionales de TypeScript
+			switch w.text(i + 1) {
+			case ":", ")", ",", "=", ";":
+			default:
+				w.add(1, 1, true)
+			}
+		case t.kind == tokenIdent && !w.inType() && a.isMethod(w, i):
+			w.expectFunction(i, t.text, false)
+		}
+		w.visit(i)
+	}
+
+	metrics := w.metrics(classifyLines(content, tokens))
+	metrics.Test = isScriptTest(w.tokens)
+	return metrics, nil
+}
+
+// function procesa la palabra clave function: function name() {}, const name = function() {}
+func (a scriptAnalyzer) function(w *braceWalker, i int) {
+	next := i + 1
+	if w.text(next) == "*" {
+		next++
+	}
+	if w.isIdent(next) {
+		w.expectFunction(next, w.text(next), false)
+		return
+	}
+	w.expectFunction(i, assignedName(w, i-1, "<anonymous>"), false)
+}
+
+// arrow procesa =>: con llaves abre una función; con expresión cuenta una función de una línea
+func (a scriptAnalyzer) arrow(w *braceWalker, i int) {
+	if w.inType() {
+		return
+	}
+	start := i - 1
+	if a.typescript && w.text(start) != ")" {
+		// Tipo de retorno: (x: T): R => ...
+	search:
+		for j := start; j >= 0 && j > i-20; j-- {
+			switch w.text(j) {
+			case ")":
+				if w.text(j+1) == ":" {
+					start = j
+				}
+				break search
+			case "=", ";", "{", "}", ",", "(":
+				break search
+			}
+		}
+	}
+	if w.text(start) == ")" {
+		start = w.matchingBackwards(start)
+	}
+	name := assignedName(w, start-1, "<arrow>")
+
+	if w.text(i+1) == "{" {
+		w.expectFunction(i, name, false)
+		return
+	}
+	// (x: T) => void es un tipo, no una función
+	if a.typescript && typeKeywords[w.text(i+1)] {
+		return
+	}
+	w.addFunction(name, w.tokens[i].line)
+}
+
+// assignedName busca el nombre al que se asigna una función anónima: name = ..., name: ...
+func assignedName(w *braceWalker, i int, fallback string) string {
+	if w.text(i) == "async" {
+		i--
+	}
+	if w.text(i) == ">" { // genéricos de TypeScript: <T>(x: T) => ...
+		for i >= 0 && w.text(i) != "<" {
+			i--
+		}
+		i--
+	}
+	switch w.text(i) {
+	case "=", ":":
+		if w.isIdent(i - 1) {
+			return w.text(i - 1)
+		}
+	}
+	return fallback
+}
+
+// typeDeclaration reconoce interface Name { y type Name = {
+func (a scriptAnalyzer) typeDeclaration(w *braceWalker, i int) bool {
+	if w.text(i-1) == "." || !w.isIdent(i+1) {
+		return false
+	}
+	if w.text(i) == "interface" {
+		return true
+	}
+	next := i + 2
+	if w.text(next) == "<" {
+		if next = w.matching(next); next < 0 {
+			return false
+		}
+		next++
+	}
+	return w.text(next) == "=" && w.text(next+1) == "{"
+}
+
+// isMethod reconoce la definición de un método al principio de una sentencia:
+// name(...) {, async name(...) {, y en TypeScript name<T>(...): Type {
+func (a scriptAnalyzer) isMethod(w *braceWalker, i int) bool {
+	if scriptKeywords[w.text(i)] || scriptModifiers[w.text(i)] && w.text(i+1) != "(" {
+		return false
+	}
+	previous := i - 1
+	for scriptModifiers[w.text(previous)] {
+		previous--
+	}
+	switch w.text(previous) {
+	case "", "{", "}", ";", ",":
+	default:
+		return false
+	}
+
+	open := i + 1
+	if a.typescript && w.text(open) == "<" {
+		if open = w.matching(open); open < 0 {
+			return false
+		}
+		open++
+	}
+	if w.text(open) != "(" {
+		return false
+	}
+	closing := w.matching(open)
+	if closing < 0 {
+		return false
+	}
+	switch w.text(closing + 1) {
+	case "{":
+		return true
+	case ":":
+		if !a.typescript {
+			return false
+		}
+		// Tipo de retorno: hasta la llave del cuerpo sin pasar por el final de la sentencia
+		for j := closing + 2; j < len(w.tokens) && j < closing+40; j++ {
+			switch w.text(j) {
+			case "{":
+				return true
+			case ";", "=", "=>", "}":
+				return false
+			}
+		}
+	}
+	return false
+}
+
+// isScriptTest reconoce los tests por el contenido: llamadas describe/it/test con expect,
+// o imports de una librería de tests
+func isScriptTest(tokens []sourceToken) bool {
+	suites, assertions := false, false
+	for i, token := range tokens {
+		switch {
+		case token.kind == tokenIdent && i+1 < len(tokens) && tokens[i+1].text == "(":
+			switch token.text {
+			case "describe", "it", "test":
+				suites = true
+			case "expect", "assert":
+				assertions = true
+			}
+		case token.kind == tokenString && i > 0 && (tokens[i-1].text == "from" || tokens[i-1].text == "require" || tokens[i-1].text == "("):
+			module := strings.Trim(token.text, "'\"`")
+			for _, library := range testLibraries {
+				if module == library || strings.HasPrefix(module, library+"/") {
+					return true
+				}
+			}
+		}
+	}
+	return suites && assertions
+}
@@ -0,0 +1,551 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/utils"
+)
+
+// Estados de un trabajo en segundo plano (se guardan en AnalysisResult.Status)
+const (
+	JobQueued    = "queued"
+	JobRunning   = "running"
+	JobCompleted = "completed"
+	JobFailed    = "failed"
+	JobCancelled = "cancelled"
+)
+
+// jobPollInterval es cada cuánto un worker revisa si hay hueco o si lo cancelaron
+const jobPollInterval = time.Second
+
+// ErrJobNotFound se devuelve cuando ningún trabajo coincide con el ID pedido
+var ErrJobNotFound = errors.New("job not found")
+
+// Job es un análisis encolado que se ejecuta fuera del proceso que lo creó
+type Job struct {
+	ID        string                 `json:"id"`
+	Request   models.AnalysisRequest `json:"request"`
+	Result    models.AnalysisResult  `json:"result"`
+	PID       int                    `json:"pid,omitempty"`
+	Error     string                 `json:"error,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+	UpdatedAt time.Time              `json:"updated_at"`
+}
+
+// Status devuelve el estado actual del trabajo
+func (j *Job) Status() string {
+	return j.Result.Status
+}
+
+// Finished indica si el trabajo ya no va a cambiar de estado
+func (j *Job) Finished() bool {
+	switch j.Result.Status {
+	case JobCompleted, JobFailed, JobCancelled:
+		return true
+	}
+	return false
+}
+
+// JobManager guarda el estado de los trabajos en ~/.antoine/jobs, un archivo por trabajo.
+// Cualquier proceso de Antoine puede retomar la cola: no hay daemon permanente.
+type JobManager struct {
+	dir           string
+	maxConcurrent int
+}
+
+// NewJobManager crea un manager sobre ~/.antoine/jobs
+func NewJobManager(maxConcurrent int) (*JobManager, error) {
+	dir, err := DataPath("jobs")
+	if err != nil {
+		return nil, err
+	}
+	return NewJobManagerAt(dir, maxConcurrent)
+}
+
+// NewJobManagerAt crea un manager sobre un directorio concreto
+func NewJobManagerAt(dir string, maxConcurrent int) (*JobManager, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create jobs directory: %w", err)
+	}
+	if maxConcurrent <= 0 {
+		maxConcurrent = 1
+	}
+
+	return &JobManager{dir: dir, maxConcurrent: maxConcurrent}, nil
+}
+
+// Submit encola un análisis y devuelve el trabajo creado
+func (jm *JobManager) Submit(request models.AnalysisRequest) (*Job, error) {
+	now := time.Now()
+	id := utils.GenerateUUID()[:8]
+
+	request.RequestID = id
+	request.Timestamp = now
+
+	job := &Job{
+		ID:      id,
+		Request: request,
+		Result: models.AnalysisResult{
+			RequestID: id,
+			Type:      request.Type,
+			Status:    JobQueued,
+		},
+		CreatedAt: now,
+	}
+
+	if err := jm.Save(job); err != nil {
+		return nil, err
+	}
+	jm.AppendLog(id, "Job queued: %s analysis of %s", request.Type, request.Target)
+
+	return job, nil
+}
+
+// Get carga un trabajo por ID completo o por un prefijo que no sea ambiguo
+func (jm *JobManager) Get(id string) (*Job, error) {
+	job, err := jm.load(id)
+	if err == nil {
+		return job, nil
+	}
+	if !errors.Is(err, ErrJobNotFound) {
+		return nil, err
+	}
+
+	jobs, err := jm.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var match *Job
+	for _, candidate := range jobs {
+		if strings.HasPrefix(candidate.ID, id) {
+			if match != nil {
+				return nil, fmt.Errorf("job id %q is ambiguous", id)
+			}
+			match = candidate
+		}
+	}
+	if match == nil {
+		return nil, fmt.Errorf("%w: %s", ErrJobNotFound, id)
+	}
+
+	return match, nil
+}
+
+// List devuelve todos los trabajos, los más recientes primero
+func (jm *JobManager) List() ([]*Job, error) {
+	paths, err := filepath.Glob(filepath.Join(jm.dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	jobs := make([]*Job, 0, len(paths))
+	for _, path := range paths {
+		job := &Job{}
+		if _, err := readJSONFile(path, job); err != nil {
+			utils.WithError(err).Debug("Skipping unreadable job file")
+			continue
+		}
+		jobs = append(jobs, job)
+	}
+
+	sort.Slice(jobs, func(i, j int) bool {
+		return jobs[i].CreatedAt.After(jobs[j].CreatedAt)
+	})
+
+	return jobs, nil
+}
+
+// Save persiste el estado del trabajo
+func (jm *JobManager) Save(job *Job) error {
+	job.UpdatedAt = time.Now()
+	return writeJSONFile(jm.jobPath(job.ID), job)
+}
+
+// LogPath devuelve la ruta del log del trabajo
+func (jm *JobManager) LogPath(id string) string {
+	return filepath.Join(jm.dir, id+".log")
+}
+
+// AppendLog añade una línea con marca de tiempo al log del trabajo
+func (jm *JobManager) AppendLog(id, format string, args ...interface{}) {
+	file, err := os.OpenFile(jm.LogPath(id), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		utils.WithError(err).Debug("Failed to open job log")
+		return
+	}
+	defer file.Close()
+
+	fmt.Fprintf(file, "[%s] %s\n", time.Now().Format("2006-01-02 15:04:05"), fmt.Sprintf(format, args...))
+}
+
+// ReadLog devuelve el contenido del log del trabajo
+func (jm *JobManager) ReadLog(id string) (string, error) {
+	data, err := os.ReadFile(jm.LogPath(id))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to read job log: %w", err)
+	}
+	return string(data), nil
+}
+
+// Cancel marca el trabajo como cancelado. El worker lo detecta y se detiene.
+func (jm *JobManager) Cancel(id string) (*Job, error) {
+	job, err := jm.Get(id)
+	if err != nil {
+		return nil, err
+	}
+
+	job, updated, err := jm.update(job.ID, func(latest *Job) {
+		jm.finish(latest, JobCancelled, "cancelled by user")
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return job, fmt.Errorf("job %s already %s", job.ID, job.Status())
+	}
+	jm.AppendLog(job.ID, "Job cancelled by user")
+
+	return job, nil
+}
+
+// Reconcile marca como fallidos los trabajos cuyo worker murió y devuelve los
+// trabajos encolados que no tienen ningún worker vivo esperando por ellos
+func (jm *JobManager) Reconcile() ([]*Job, error) {
+	jobs, err := jm.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var orphaned []*Job
+	for _, job := range jobs {
+		alive := job.PID > 0 && processAlive(job.PID)
+
+		switch job.Status() {
+		case JobRunning:
+			if alive {
+				continue
+			}
+			_, updated, err := jm.update(job.ID, func(latest *Job) {
+				jm.finish(latest, JobFailed, "worker exited unexpectedly")
+			})
+			if err != nil {
+				return nil, err
+			}
+			if updated {
+				jm.AppendLog(job.ID, "Worker (pid %d) is gone, marking job as failed", job.PID)
+			}
+		case JobQueued:
+			if !alive {
+				orphaned = append(orphaned, job)
+			}
+		}
+	}
+
+	return orphaned, nil
+}
+
+// Spawn lanza un worker desacoplado de la terminal que ejecuta el binario actual con args.
+// La salida del worker va al log del trabajo, así sobrevive al cierre de la CLI.
+func (jm *JobManager) Spawn(job *Job, args ...string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to locate antoine executable: %w", err)
+	}
+
+	logFile, err := os.OpenFile(jm.LogPath(job.ID), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open job log: %w", err)
+	}
+	defer logFile.Close()
+
+	cmd := exec.Command(executable, args...)
+	cmd.Stdout = logFile
+	cmd.Stderr = logFile
+	cmd.Env = append(os.Environ(), "NO_COLOR=1")
+	detachProcess(cmd)
+
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start job worker: %w", err)
+	}
+
+	return cmd.Process.Release()
+}
+
+// Run ejecuta el trabajo en el proceso actual: espera un hueco según
+// MaxConcurrentJobs, lanza el análisis y guarda el resultado. El timeout
+// cuenta desde que el trabajo empieza a ejecutarse, no mientras espera turno.
+func (jm *JobManager) Run(ctx context.Context, client *AntoineClient, id string, timeout time.Duration) error {
+	job, err := jm.Get(id)
+	if err != nil {
+		return err
+	}
+	if err := jm.claim(job); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go jm.watchCancellation(ctx, job.ID, cancel)
+
+	if err := jm.acquire(ctx, job); err != nil {
+		return jm.stop(job, err)
+	}
+	if timeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeout(ctx, timeout)
+		defer cancelTimeout()
+	}
+
+	jm.setProgress(job, 10, "Fetching repository information...")
+
+	options := job.Request.Options
+	result, err := client.AnalyzeRepository(ctx, job.Request.Target, &options)
+	if err != nil {
+		return jm.stop(job, err)
+	}
+
+	jm.setProgress(job, 90, "Generating insights...")
+
+	// Si llegó una cancelación mientras terminaba el análisis, update no la pisa
+	job, updated, err := jm.update(job.ID, func(latest *Job) {
+		startTime := latest.Result.StartTime
+		latest.Result = *result
+		latest.Result.RequestID = latest.ID
+		latest.Result.Type = latest.Request.Type
+		latest.Result.StartTime = startTime
+		jm.finish(latest, JobCompleted, "")
+	})
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return context.Canceled
+	}
+	jm.AppendLog(job.ID, "Analysis completed in %s", job.Result.Duration.Round(time.Millisecond))
+
+	return nil
+}
+
+// claim registra el PID del proceso actual como worker del trabajo, de modo que
+// Reconcile no lance otro mientras espera turno
+func (jm *JobManager) claim(job *Job) error {
+	unlock, err := jm.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	latest, err := jm.load(job.ID)
+	if err != nil {
+		return err
+	}
+	if latest.Finished() {
+		return fmt.Errorf("job %s already %s", latest.ID, latest.Status())
+	}
+	if latest.PID != os.Getpid() && processAlive(latest.PID) {
+		return fmt.Errorf("job %s is already handled by pid %d", latest.ID, latest.PID)
+	}
+
+	*job = *latest
+	job.PID = os.Getpid()
+	return jm.Save(job)
+}
+
+// acquire espera hasta que haya menos de maxConcurrent trabajos en ejecución
+func (jm *JobManager) acquire(ctx context.Context, job *Job) error {
+	waiting := false
+	for {
+		started, err := jm.tryStart(job)
+		if err != nil {
+			return err
+		}
+		if started {
+			return nil
+		}
+
+		if !waiting {
+			jm.AppendLog(job.ID, "Waiting for a free slot (max %d concurrent jobs)", jm.maxConcurrent)
+			waiting = true
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(jobPollInterval):
+		}
+	}
+}
+
+// tryStart reserva un hueco de ejecución bajo el lock de la cola
+func (jm *JobManager) tryStart(job *Job) (bool, error) {
+	unlock, err := jm.lock()
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+
+	latest, err := jm.load(job.ID)
+	if err != nil {
+		return false, err
+	}
+	if latest.Status() == JobCancelled {
+		return false, context.Canceled
+	}
+	if latest.Finished() {
+		return false, fmt.Errorf("job %s already %s", latest.ID, latest.Status())
+	}
+	*job = *latest
+
+	jobs, err := jm.List()
+	if err != nil {
+		return false, err
+	}
+
+	running := 0
+	for _, other := range jobs {
+		if other.ID != job.ID && other.Status() == JobRunning && processAlive(other.PID) {
+			running++
+		}
+	}
+	if running >= jm.maxConcurrent {
+		return false, nil
+	}
+
+	job.PID = os.Getpid()
+	job.Result.Status = JobRunning
+	job.Result.StartTime = time.Now()
+	if err := jm.Save(job); err != nil {
+		return false, err
+	}
+	jm.AppendLog(job.ID, "Job started (pid %d)", job.PID)
+
+	return true, nil
+}
+
+// lock toma el lock de la cola de trabajos, compartido por todos los procesos
+func (jm *JobManager) lock() (func(), error) {
+	return lockFile(filepath.Join(jm.dir, "queue"))
+}
+
+// watchCancellation cancela ctx cuando otro proceso marca el trabajo como cancelado
+func (jm *JobManager) watchCancellation(ctx context.Context, id string, cancel context.CancelFunc) {
+	ticker := time.NewTicker(jobPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if job, err := jm.load(id); err == nil && job.Status() == JobCancelled {
+				cancel()
+				return
+			}
+		}
+	}
+}
+
+// stop registra el final de un trabajo que no terminó con éxito
+func (jm *JobManager) stop(job *Job, cause error) error {
+	latest, updated, err := jm.update(job.ID, func(latest *Job) {
+		jm.finish(latest, JobFailed, cause.Error())
+	})
+	if err != nil {
+		return err
+	}
+	if !updated {
+		if latest.Status() == JobCancelled {
+			jm.AppendLog(job.ID, "Worker stopped after cancellation")
+			return context.Canceled
+		}
+		return cause
+	}
+	*job = *latest
+	jm.AppendLog(job.ID, "Job failed: %v", cause)
+
+	return cause
+}
+
+// setProgress actualiza AnalysisResult.Progress y deja constancia en el log
+func (jm *JobManager) setProgress(job *Job, progress int, step string) {
+	latest, updated, err := jm.update(job.ID, func(latest *Job) {
+		latest.Result.Progress = progress
+	})
+	if err != nil {
+		utils.WithError(err).Debug("Failed to save job progress")
+		return
+	}
+	if !updated {
+		return
+	}
+	*job = *latest
+	jm.AppendLog(job.ID, "[%3d%%] %s", progress, step)
+}
+
+// update recarga el trabajo bajo el lock de la cola y le aplica apply antes de guardarlo,
+// así ningún proceso pisa los cambios de otro con una copia vieja. Un trabajo en estado
+// terminal no se modifica: se devuelve tal cual con updated = false.
+func (jm *JobManager) update(id string, apply func(*Job)) (job *Job, updated bool, err error) {
+	unlock, err := jm.lock()
+	if err != nil {
+		return nil, false, err
+	}
+	defer unlock()
+
+	latest, err := jm.load(id)
+	if err != nil {
+		return nil, false, err
+	}
+	if latest.Finished() {
+		return latest, false, nil
+	}
+
+	apply(latest)
+	if err := jm.Save(latest); err != nil {
+		return nil, false, err
+	}
+	return latest, true, nil
+}
+
+// finish cierra el trabajo con el estado indicado
+func (jm *JobManager) finish(job *Job, status, message string) {
+	now := time.Now()
+	job.Result.Status = status
+	job.Result.EndTime = &now
+	job.Error = message
+	if status == JobCompleted {
+		job.Result.Progress = 100
+	}
+	if !job.Result.StartTime.IsZero() {
+		job.Result.Duration = now.Sub(job.Result.StartTime)
+	}
+}
+
+func (jm *JobManager) load(id string) (*Job, error) {
+	job := &Job{}
+	found, err := readJSONFile(jm.jobPath(id), job)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrJobNotFound, id)
+	}
+	return job, nil
+}
+
+func (jm *JobManager) jobPath(id string) string {
+	return filepath.Join(jm.dir, filepath.Base(id)+".json")
+}
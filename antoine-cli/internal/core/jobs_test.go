@@ -0,0 +1,126 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"antoine-cli/internal/models"
+)
+
+func TestJobTerminalStateIsKept(t *testing.T) {
+	tests := []struct {
+		name string
+		// write simula lo que hace un worker con su copia en memoria del trabajo
+		write   func(jm *JobManager, stale *Job) error
+		wantErr error
+	}{
+		{
+			name: "progress after cancel",
+			write: func(jm *JobManager, stale *Job) error {
+				jm.setProgress(stale, 50, "still working")
+				return nil
+			},
+		},
+		{
+			name: "failure after cancel",
+			write: func(jm *JobManager, stale *Job) error {
+				return jm.stop(stale, errors.New("analysis failed"))
+			},
+			wantErr: context.Canceled,
+		},
+		{
+			name: "start after cancel",
+			write: func(jm *JobManager, stale *Job) error {
+				_, err := jm.tryStart(stale)
+				return err
+			},
+			wantErr: context.Canceled,
+		},
+		{
+			name: "second cancel",
+			write: func(jm *JobManager, stale *Job) error {
+				_, err := jm.Cancel(stale.ID)
+				if err == nil {
+					return errors.New("cancelling twice succeeded")
+				}
+				return nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jm, err := NewJobManagerAt(t.TempDir(), 1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			job, err := jm.Submit(models.AnalysisRequest{Type: "repository", Target: "https://github.com/acme/app"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			stale := *job
+
+			if _, err := jm.Cancel(job.ID); err != nil {
+				t.Fatal(err)
+			}
+			if err := tt.write(jm, &stale); !errors.Is(err, tt.wantErr) {
+				t.Fatalf("err = %v, want %v", err, tt.wantErr)
+			}
+
+			latest, err := jm.Get(job.ID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if latest.Status() != JobCancelled {
+				t.Errorf("status = %s, want %s", latest.Status(), JobCancelled)
+			}
+		})
+	}
+}
+
+func TestJobTryStartRespectsConcurrency(t *testing.T) {
+	tests := []struct {
+		name          string
+		maxConcurrent int
+		running       int
+		wantStarted   bool
+	}{
+		{"free slot", 1, 0, true},
+		{"slots full", 1, 1, false},
+		{"second slot", 2, 1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jm, err := NewJobManagerAt(t.TempDir(), tt.maxConcurrent)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for i := 0; i < tt.running; i++ {
+				other, err := jm.Submit(models.AnalysisRequest{Type: "repository", Target: "other"})
+				if err != nil {
+					t.Fatal(err)
+				}
+				if started, err := jm.tryStart(other); err != nil || !started {
+					t.Fatalf("could not start running job: %v", err)
+				}
+			}
+
+			job, err := jm.Submit(models.AnalysisRequest{Type: "repository", Target: "target"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			started, err := jm.tryStart(job)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if started != tt.wantStarted {
+				t.Errorf("started = %v, want %v", started, tt.wantStarted)
+			}
+			if latest, _ := jm.Get(job.ID); started && latest.Status() != JobRunning {
+				t.Errorf("status = %s, want %s", latest.Status(), JobRunning)
+			}
+		})
+	}
+}
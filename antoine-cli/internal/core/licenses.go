@@ -0,0 +1,882 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/utils"
+)
+
+// Categorías de licencia, de menos a más restrictiva
+const (
+	LicensePermissive      = "permissive"
+	LicenseWeakCopyleft    = "weak-copyleft"
+	LicenseStrongCopyleft  = "strong-copyleft"
+	LicenseNetworkCopyleft = "network-copyleft" // AGPL, SSPL: también obliga al usarse como servicio
+	LicenseProprietary     = "proprietary"
+	LicenseUnknown         = "unknown"
+)
+
+var licenseRestriction = map[string]int{
+	LicensePermissive: 0, LicenseWeakCopyleft: 1, LicenseStrongCopyleft: 2, LicenseNetworkCopyleft: 3, LicenseProprietary: 4,
+}
+
+// spdxLicense describe una licencia conocida: su categoría y las frases de su texto que la identifican
+type spdxLicense struct {
+	ID       string
+	Category string
+	Phrases  []string
+}
+
+// spdxLicenses son las licencias reconocidas por su texto. Las frases se comparan normalizadas
+// (minúsculas, solo letras y números); la que reúne más frases de las suyas gana.
+var spdxLicenses = []spdxLicense{
+	{"MIT", LicensePermissive, []string{
+		"permission is hereby granted, free of charge, to any person obtaining a copy",
+		"to deal in the software without restriction",
+		"the above copyright notice and this permission notice shall be included in all copies or substantial portions of the software",
+		"the software is provided \"as is\", without warranty of any kind",
+	}},
+	{"ISC", LicensePermissive, []string{
+		"permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted",
+		"provided that the above copyright notice and this permission notice appear in all copies",
+		"the software is provided \"as is\" and the author disclaims all warranties",
+	}},
+	{"0BSD", LicensePermissive, []string{
+		"permission to use, copy, modify, and/or distribute this software for any purpose with or without fee is hereby granted",
+		"the software is provided \"as is\" and the author disclaims all warranties",
+	}},
+	{"BSD-2-Clause", LicensePermissive, []string{
+		"redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met",
+		"redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer",
+		"redistributions in binary form must reproduce the above copyright notice",
+	}},
+	{"BSD-3-Clause", LicensePermissive, []string{
+		"redistribution and use in source and binary forms, with or without modification, are permitted provided that the following conditions are met",
+		"redistributions of source code must retain the above copyright notice, this list of conditions and the following disclaimer",
+		"redistributions in binary form must reproduce the above copyright notice",
+		"may be used to endorse or promote products derived from this software without specific prior written permission",
+	}},
+	{"Apache-2.0", LicensePermissive, []string{
+		"apache license",
+		"version 2.0, january 2004",
+		"terms and conditions for use, reproduction, and distribution",
+		"grant of patent license",
+	}},
+	{"MPL-2.0", LicenseWeakCopyleft, []string{
+		"mozilla public license version 2.0",
+		"\"covered software\" means",
+		"\"incompatible with secondary licenses\" means",
+	}},
+	// La LGPL-2.0 repite el preámbulo de la GPL-2.0: con una frase más, gana solo si es la "library"
+	{"LGPL-2.0", LicenseWeakCopyleft, []string{
+		"gnu library general public license",
+		"gnu general public license",
+		"version 2, june 1991",
+		"everyone is permitted to copy and distribute verbatim copies",
+		"the licenses for most software are designed to take away your freedom to share and change it",
+	}},
+	{"LGPL-2.1", LicenseWeakCopyleft, []string{
+		"gnu lesser general public license",
+		"version 2.1, february 1999",
+	}},
+	{"LGPL-3.0", LicenseWeakCopyleft, []string{
+		"gnu lesser general public license",
+		"version 3, 29 june 2007",
+		"this version of the gnu lesser general public license incorporates the terms and conditions of version 3 of the gnu general public license",
+	}},
+	{"GPL-2.0", LicenseStrongCopyleft, []string{
+		"gnu general public license",
+		"version 2, june 1991",
+		"everyone is permitted to copy and distribute verbatim copies",
+		"the licenses for most software are designed to take away your freedom to share and change it",
+	}},
+	{"GPL-3.0", LicenseStrongCopyleft, []string{
+		"gnu general public license",
+		"version 3, 29 june 2007",
+		"is a free, copyleft license for software and other kinds of works",
+		"conveying verbatim copies",
+	}},
+	{"AGPL-3.0", LicenseNetworkCopyleft, []string{
+		"gnu affero general public license",
+		"version 3, 19 november 2007",
+		"remote network interaction",
+	}},
+	{"EPL-2.0", LicenseWeakCopyleft, []string{
+		"eclipse public license - v 2.0",
+		"the accompanying program is provided under the terms of this eclipse public license",
+	}},
+	{"EPL-1.0", LicenseWeakCopyleft, []string{
+		"eclipse public license - v 1.0",
+		"the accompanying program is provided under the terms of this eclipse public license",
+	}},
+	{"BSL-1.0", LicensePermissive, []string{
+		"boost software license - version 1.0 - august 17th, 2003",
+		"permission is hereby granted, free of charge, to any person or organization obtaining a copy of the software and accompanying documentation",
+	}},
+	{"Zlib", LicensePermissive, []string{
+		"this software is provided 'as-is', without any express or implied warranty",
+		"altered source versions must be plainly marked as such, and must not be misrepresented as being the original software",
+	}},
+	{"Unlicense", LicensePermissive, []string{
+		"this is free and unencumbered software released into the public domain",
+		"for more information, please refer to <http://unlicense.org>",
+	}},
+	{"CC0-1.0", LicensePermissive, []string{
+		"creative commons legal code",
+		"cc0 1.0 universal",
+		"statement of purpose",
+	}},
+	{"CC-BY-4.0", LicensePermissive, []string{
+		"attribution 4.0 international",
+		"creative commons corporation",
+	}},
+	{"WTFPL", LicensePermissive, []string{
+		"do what the fuck you want to public license",
+	}},
+	{"EUPL-1.2", LicenseStrongCopyleft, []string{
+		"european union public licence",
+		"v. 1.2",
+	}},
+	{"BUSL-1.1", LicenseProprietary, []string{
+		"business source license 1.1",
+		"additional use grant",
+	}},
+	{"Elastic-2.0", LicenseProprietary, []string{
+		"elastic license 2.0",
+		"you may not provide the software to third parties as a hosted or managed service",
+	}},
+	{"SSPL-1.0", LicenseNetworkCopyleft, []string{
+		"server side public license",
+		"version 1, october 16, 2018",
+	}},
+}
+
+// licenseMatchThreshold es la fracción de frases que debe aparecer para reconocer una licencia
+const licenseMatchThreshold = 0.6
+
+// licenseCategories clasifica los identificadores SPDX que no se reconocen por texto
+var licenseCategories = map[string]string{
+	"MIT-0": LicensePermissive, "PSF-2.0": LicensePermissive, "Python-2.0": LicensePermissive,
+	"PostgreSQL": LicensePermissive, "BlueOak-1.0.0": LicensePermissive, "Unicode-DFS-2016": LicensePermissive,
+	"Unicode-3.0": LicensePermissive, "BSD-1-Clause": LicensePermissive, "X11": LicensePermissive,
+	"CC-BY-3.0": LicensePermissive, "LicenseRef-Public-Domain": LicensePermissive, "Apache-1.1": LicensePermissive,
+	"MPL-1.1": LicenseWeakCopyleft, "CDDL-1.0": LicenseWeakCopyleft, "CDDL-1.1": LicenseWeakCopyleft,
+	"Artistic-2.0": LicenseWeakCopyleft, "OFL-1.1": LicenseWeakCopyleft, "CC-BY-SA-4.0": LicenseWeakCopyleft,
+	"EUPL-1.1": LicenseStrongCopyleft, "OSL-3.0": LicenseStrongCopyleft,
+	"UNLICENSED": LicenseProprietary,
+}
+
+// licenseVariants son los sufijos SPDX de versión: GPL-3.0-only, GPL-3.0-or-later, GPL-3.0+
+var licenseVariants = regexp.MustCompile(`^(.*?)(-only|-or-later|\+)$`)
+
+var licenseNormalizer = regexp.MustCompile(`[^a-z0-9]+`)
+
+// normalizeLicenseText deja solo palabras en minúsculas para comparar textos con distinto formato
+func normalizeLicenseText(text string) string {
+	text = strings.ToLower(text)
+	text = strings.ReplaceAll(text, "licence", "license")
+	text = strings.ReplaceAll(text, "and/or", "and or")
+	return strings.TrimSpace(licenseNormalizer.ReplaceAllString(text, " "))
+}
+
+// normalizedPhrases son las frases de spdxLicenses ya normalizadas
+var normalizedPhrases = func() map[string][]string {
+	phrases := make(map[string][]string)
+	for _, license := range spdxLicenses {
+		for _, phrase := range license.Phrases {
+			phrases[license.ID] = append(phrases[license.ID], normalizeLicenseText(phrase))
+		}
+	}
+	return phrases
+}()
+
+var spdxIdentifierLine = regexp.MustCompile(`(?i)SPDX-License-Identifier:\s*([^\n*]+)`)
+
+// MatchLicenseText reconoce una licencia por su texto. Devuelve el identificador SPDX y la
+// fracción de frases encontradas, o "" si ninguna llega al umbral.
+func MatchLicenseText(text string) (string, float64) {
+	normalized := " " + normalizeLicenseText(text) + " "
+	best, bestScore, bestPhrases := "", 0.0, 0
+	for _, license := range spdxLicenses {
+		phrases := normalizedPhrases[license.ID]
+		found := 0
+		for _, phrase := range phrases {
+			if strings.Contains(normalized, " "+phrase+" ") {
+				found++
+			}
+		}
+		score := float64(found) / float64(len(phrases))
+		// Con la misma puntuación gana la más específica (BSD-3 frente a BSD-2, ISC frente a 0BSD)
+		if score > bestScore || (score == bestScore && score > 0 && len(phrases) > bestPhrases) {
+			best, bestScore, bestPhrases = license.ID, score, len(phrases)
+		}
+	}
+	if bestScore >= licenseMatchThreshold {
+		return best, bestScore
+	}
+	if match := spdxIdentifierLine.FindStringSubmatch(text); match != nil {
+		return NormalizeLicense(match[1]), 1
+	}
+	return "", bestScore
+}
+
+// licenseFiles reconoce LICENSE, LICENSE.md, LICENSE-MIT, COPYING, COPYING.LESSER, UNLICENSE...
+var licenseFiles = regexp.MustCompile(`(?i)^(license|licence|copying|unlicense)([-._][a-z0-9.-]+)?$`)
+
+// detectLicenseFile reconoce la licencia de los archivos de licencia de dir; "Other" si hay
+// archivo pero no se reconoce y "" si no hay ninguno
+func detectLicenseFile(dir string) string {
+	license, _, _ := detectLicenseFiles(localLicenseFiles(dir))
+	return license
+}
+
+// localLicenseFiles lista los archivos de licencia de dir y los lee del disco
+func localLicenseFiles(dir string) ([]string, dependencyFileReader) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil
+	}
+	var names []string
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+	return names, func(name string) ([]byte, error) {
+		return os.ReadFile(filepath.Join(dir, name))
+	}
+}
+
+// detectLicenseFiles devuelve la expresión SPDX de los archivos de licencia entre names, los
+// archivos y la confianza. Varios archivos con licencias distintas (LICENSE-MIT y LICENSE-APACHE)
+// son una licencia dual.
+func detectLicenseFiles(names []string, read dependencyFileReader) (string, []string, float64) {
+	var ids, files []string
+	confidence := 1.0
+	for _, name := range names {
+		if !licenseFiles.MatchString(name) {
+			continue
+		}
+		data, err := read(name)
+		if err != nil {
+			continue
+		}
+		files = append(files, name)
+		id, score := MatchLicenseText(string(data[:min(len(data), 64*1024)]))
+		if id == "" {
+			id, score = "Other", 0
+		}
+		confidence = min(confidence, score)
+		if !containsString(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return "", nil, 0
+	}
+	// COPYING + COPYING.LESSER es como se distribuye la LGPL-3.0
+	if containsString(ids, "LGPL-3.0") && containsString(ids, "GPL-3.0") {
+		ids = removeString(ids, "GPL-3.0")
+	}
+	if len(ids) > 1 {
+		ids = removeString(ids, "Other")
+	}
+	sort.Strings(ids)
+	return strings.Join(ids, " OR "), files, confidence
+}
+
+func removeString(values []string, value string) []string {
+	var kept []string
+	for _, v := range values {
+		if v != value {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+// ProjectLicense es la licencia del proyecto y de dónde sale
+type ProjectLicense struct {
+	License    string   `json:"license"` // expresión SPDX; "" sin licencia
+	Category   string   `json:"category,omitempty"`
+	Files      []string `json:"files,omitempty"`
+	Declared   string   `json:"declared,omitempty"` // la de package.json, Cargo.toml, pyproject.toml o pom.xml
+	Manifest   string   `json:"manifest,omitempty"`
+	Confidence float64  `json:"confidence"`
+}
+
+// DetectProjectLicense reconoce la licencia de un árbol por sus archivos de licencia y, si no
+// los hay, por la que declaran sus manifiestos
+func DetectProjectLicense(root string) ProjectLicense {
+	names, read := localLicenseFiles(root)
+	if read == nil {
+		return ProjectLicense{}
+	}
+	return projectLicense(names, read)
+}
+
+// RemoteProjectLicense reconoce la licencia de un repositorio de GitHub leyendo sus archivos
+func (c *AntoineClient) RemoteProjectLicense(ctx context.Context, repoURL string) (ProjectLicense, error) {
+	files, err := c.mcp.github.ListFiles(ctx, repoURL, "")
+	if err != nil {
+		return ProjectLicense{}, err
+	}
+	var names []string
+	for _, file := range files {
+		file = strings.TrimPrefix(filepath.ToSlash(file), "/")
+		if !strings.Contains(file, "/") {
+			names = append(names, file)
+		}
+	}
+	return projectLicense(names, func(name string) ([]byte, error) {
+		content, err := c.mcp.github.ReadFile(ctx, repoURL, name)
+		return []byte(content), err
+	}), nil
+}
+
+// projectLicense combina los archivos de licencia de la raíz con la licencia declarada
+func projectLicense(names []string, read dependencyFileReader) ProjectLicense {
+	var project ProjectLicense
+	project.License, project.Files, project.Confidence = detectLicenseFiles(names, read)
+	project.Declared, project.Manifest = declaredLicense(names, read)
+	if project.License == "" || project.License == "Other" && project.Declared != "" {
+		project.License, project.Confidence = project.Declared, 0.8
+	}
+	if project.License != "" {
+		project.Category = LicenseCategory(project.License)
+	}
+	return project
+}
+
+// declaredLicense lee el campo de licencia de los manifiestos de la raíz
+func declaredLicense(names []string, readFile dependencyFileReader) (string, string) {
+	read := func(name string) []byte {
+		if !containsString(names, name) {
+			return nil
+		}
+		data, _ := readFile(name)
+		return data
+	}
+
+	if data := read("package.json"); data != nil {
+		var pkg struct {
+			License interface{} `json:"license"`
+		}
+		if json.Unmarshal(data, &pkg) == nil {
+			if license := licenseString(pkg.License); license != "" {
+				return NormalizeLicense(license), "package.json"
+			}
+		}
+	}
+	if data := read("Cargo.toml"); data != nil {
+		var crate struct {
+			Package struct {
+				License string `toml:"license"`
+			} `toml:"package"`
+		}
+		if toml.Unmarshal(data, &crate) == nil && crate.Package.License != "" {
+			return NormalizeLicense(crate.Package.License), "Cargo.toml"
+		}
+	}
+	if data := read("pyproject.toml"); data != nil {
+		var project struct {
+			Project struct {
+				License interface{} `toml:"license"`
+			} `toml:"project"`
+			Tool struct {
+				Poetry struct {
+					License string `toml:"license"`
+				} `toml:"poetry"`
+			} `toml:"tool"`
+		}
+		if toml.Unmarshal(data, &project) == nil {
+			license := project.Tool.Poetry.License
+			switch value := project.Project.License.(type) {
+			case string:
+				license = value
+			case map[string]interface{}: // license = { text = "MIT" }
+				if text, ok := value["text"].(string); ok && len(text) < 100 {
+					license = text
+				}
+			}
+			if license != "" {
+				return NormalizeLicense(license), "pyproject.toml"
+			}
+		}
+	}
+	if data := read("pom.xml"); data != nil {
+		var pom struct {
+			Licenses []struct {
+				Name string `xml:"name"`
+			} `xml:"licenses>license"`
+		}
+		if xml.Unmarshal(data, &pom) == nil && len(pom.Licenses) > 0 {
+			return NormalizeLicense(pom.Licenses[0].Name), "pom.xml"
+		}
+	}
+	return "", ""
+}
+
+// licenseAliases traduce los nombres habituales en manifiestos y clasificadores a SPDX; se
+// comprueban en orden sobre el texto normalizado
+var licenseAliases = []struct {
+	pattern *regexp.Regexp
+	id      string
+}{
+	{regexp.MustCompile(`\bunlicensed\b|proprietary|commercial|all rights reserved`), "UNLICENSED"},
+	{regexp.MustCompile(`\bunlicense\b`), "Unlicense"},
+	{regexp.MustCompile(`affero`), "AGPL-3.0"},
+	{regexp.MustCompile(`(lesser|library) general public license( v| version)? ?3|lgpl ?v?3`), "LGPL-3.0"},
+	{regexp.MustCompile(`lesser|library general public|lgpl`), "LGPL-2.1"},
+	{regexp.MustCompile(`general public license( v| version)? ?3|gpl ?v?3`), "GPL-3.0"},
+	{regexp.MustCompile(`general public license|\bgpl`), "GPL-2.0"},
+	{regexp.MustCompile(`mozilla.*1 1|mpl ?1 1`), "MPL-1.1"},
+	{regexp.MustCompile(`mozilla|\bmpl`), "MPL-2.0"},
+	{regexp.MustCompile(`eclipse.*1 0|epl ?1`), "EPL-1.0"},
+	{regexp.MustCompile(`eclipse|\bepl`), "EPL-2.0"},
+	{regexp.MustCompile(`apache.*1 1`), "Apache-1.1"},
+	{regexp.MustCompile(`apache|\basl\b`), "Apache-2.0"},
+	{regexp.MustCompile(`\bbsd\b.*(2|two|simplified|freebsd)|(2|two) clause bsd|simplified bsd|freebsd`), "BSD-2-Clause"},
+	{regexp.MustCompile(`\b0bsd\b|zero clause bsd`), "0BSD"},
+	{regexp.MustCompile(`\bbsd\b`), "BSD-3-Clause"},
+	{regexp.MustCompile(`\bmit\b|\bexpat\b`), "MIT"},
+	{regexp.MustCompile(`\bisc\b`), "ISC"},
+	{regexp.MustCompile(`python software foundation|\bpsf\b`), "PSF-2.0"},
+	{regexp.MustCompile(`\bboost\b`), "BSL-1.0"},
+	{regexp.MustCompile(`\bzlib\b`), "Zlib"},
+	{regexp.MustCompile(`\bcc0\b`), "CC0-1.0"},
+	{regexp.MustCompile(`public domain`), "LicenseRef-Public-Domain"},
+	{regexp.MustCompile(`business source`), "BUSL-1.1"},
+	{regexp.MustCompile(`server side public`), "SSPL-1.0"},
+	{regexp.MustCompile(`\belastic license\b`), "Elastic-2.0"},
+	{regexp.MustCompile(`\bwtfpl\b`), "WTFPL"},
+}
+
+// spdxIDs indexa en minúsculas los identificadores conocidos
+var spdxIDs = func() map[string]string {
+	ids := make(map[string]string)
+	for _, license := range spdxLicenses {
+		ids[strings.ToLower(license.ID)] = license.ID
+	}
+	for id := range licenseCategories {
+		ids[strings.ToLower(id)] = id
+	}
+	return ids
+}()
+
+var licenseTokens = regexp.MustCompile(`\(|\)|[^\s()]+`)
+
+// NormalizeLicense convierte la licencia de un manifiesto a una expresión SPDX: "MIT/Apache-2.0"
+// pasa a "MIT OR Apache-2.0" y "Apache License, Version 2.0" a "Apache-2.0". Lo que no reconoce
+// se devuelve tal cual.
+func NormalizeLicense(raw string) string {
+	raw = strings.TrimSpace(strings.Trim(strings.TrimSpace(raw), `"'`))
+	if raw == "" || raw == UnknownLicense {
+		return raw
+	}
+	if strings.HasPrefix(strings.ToUpper(raw), "SEE LICENSE IN") {
+		return raw
+	}
+
+	// Expresión SPDX: todos los operandos son identificadores
+	tokens := licenseTokens.FindAllString(strings.ReplaceAll(raw, "/", " OR "), -1)
+	expression := len(tokens) > 1
+	for i, token := range tokens {
+		switch strings.ToUpper(token) {
+		case "(", ")":
+		case "AND", "OR", "WITH":
+			tokens[i] = strings.ToUpper(token)
+		default:
+			if i > 0 && tokens[i-1] == "WITH" {
+				continue // las excepciones no se normalizan
+			}
+			id, ok := normalizeLicenseID(token)
+			if !ok {
+				expression = false
+			}
+			tokens[i] = id
+		}
+	}
+	if expression {
+		joined := strings.Join(tokens, " ")
+		joined = strings.ReplaceAll(strings.ReplaceAll(joined, "( ", "("), " )", ")")
+		return joined
+	}
+
+	if id, ok := normalizeLicenseID(raw); ok {
+		return id
+	}
+	normalized := normalizeLicenseText(raw)
+	for _, alias := range licenseAliases {
+		if alias.pattern.MatchString(normalized) {
+			id := alias.id
+			if strings.Contains(id, "GPL") && (strings.Contains(normalized, "or later") || strings.HasSuffix(raw, "+")) {
+				id += "-or-later"
+			}
+			return id
+		}
+	}
+	return raw
+}
+
+// normalizeLicenseID devuelve el identificador SPDX con su grafía canónica, conservando -only y -or-later
+func normalizeLicenseID(id string) (string, bool) {
+	suffix := ""
+	if match := licenseVariants.FindStringSubmatch(id); match != nil {
+		id, suffix = match[1], match[2]
+		if suffix == "+" {
+			suffix = "-or-later"
+		}
+	}
+	canonical, ok := spdxIDs[strings.ToLower(id)]
+	if !ok {
+		return id + suffix, false
+	}
+	return canonical + suffix, true
+}
+
+// licenseBase quita la variante de versión: GPL-3.0-or-later -> GPL-3.0
+func licenseBase(id string) string {
+	if match := licenseVariants.FindStringSubmatch(id); match != nil {
+		return match[1]
+	}
+	return id
+}
+
+// licenseCategoryOf clasifica un identificador suelto
+func licenseCategoryOf(id string) string {
+	base := licenseBase(id)
+	for _, license := range spdxLicenses {
+		if license.ID == base {
+			return license.Category
+		}
+	}
+	if category, ok := licenseCategories[base]; ok {
+		return category
+	}
+	return LicenseUnknown
+}
+
+// LicenseCategory clasifica una expresión SPDX. En "A OR B" se puede elegir la menos restrictiva;
+// en "A AND B" se cumplen las dos y cuenta la más restrictiva. Una excepción WITH (Classpath)
+// rebaja el copyleft fuerte a débil.
+func LicenseCategory(expression string) string {
+	alternatives := licenseAlternatives(expression)
+	best := LicenseUnknown
+	for _, terms := range alternatives {
+		category := LicensePermissive
+		for _, term := range terms {
+			termCategory := licenseCategoryOf(term.id)
+			if term.exception != "" && termCategory == LicenseStrongCopyleft {
+				termCategory = LicenseWeakCopyleft
+			}
+			if termCategory == LicenseUnknown {
+				category = LicenseUnknown
+				break
+			}
+			if licenseRestriction[termCategory] > licenseRestriction[category] {
+				category = termCategory
+			}
+		}
+		if best == LicenseUnknown || category != LicenseUnknown && licenseRestriction[category] < licenseRestriction[best] {
+			best = category
+		}
+	}
+	return best
+}
+
+type licenseTerm struct {
+	id        string
+	exception string
+}
+
+// licenseAlternatives separa una expresión en sus alternativas OR, cada una con sus términos AND.
+// Los paréntesis se ignoran: basta para las expresiones que aparecen en los manifiestos.
+func licenseAlternatives(expression string) [][]licenseTerm {
+	var alternatives [][]licenseTerm
+	tokens := licenseTokens.FindAllString(expression, -1)
+	var current []licenseTerm
+	for i := 0; i < len(tokens); i++ {
+		switch tokens[i] {
+		case "(", ")", "AND":
+		case "OR":
+			if len(current) > 0 {
+				alternatives = append(alternatives, current)
+			}
+			current = nil
+		case "WITH":
+			if len(current) > 0 && i+1 < len(tokens) {
+				current[len(current)-1].exception = tokens[i+1]
+				i++
+			}
+		default:
+			current = append(current, licenseTerm{id: tokens[i]})
+		}
+	}
+	if len(current) > 0 {
+		alternatives = append(alternatives, current)
+	}
+	if len(alternatives) == 0 {
+		alternatives = [][]licenseTerm{{{id: expression}}}
+	}
+	return alternatives
+}
+
+// licenseIDs devuelve los identificadores de una expresión
+func licenseIDs(expression string) []string {
+	var ids []string
+	for _, terms := range licenseAlternatives(expression) {
+		for _, term := range terms {
+			ids = append(ids, term.id)
+		}
+	}
+	return ids
+}
+
+// LicenseIssue es un problema de licencias del proyecto o de una dependencia
+type LicenseIssue struct {
+	Severity   string `json:"severity"` // high, medium, low
+	Dependency string `json:"dependency,omitempty"`
+	License    string `json:"license,omitempty"`
+	Message    string `json:"message"`
+}
+
+// LicenseReport es la sección de licencias del análisis
+type LicenseReport struct {
+	Project    ProjectLicense `json:"project"`
+	Categories map[string]int `json:"categories"` // dependencias por categoría
+	Issues     []LicenseIssue `json:"issues"`
+	Hackathon  string         `json:"hackathon,omitempty"`
+}
+
+// openSourceRequirement reconoce los requisitos de un hackathon que piden código abierto. "License"
+// a secas no basta: "valid driver's license" o "business license" no hablan del código.
+var openSourceRequirement = regexp.MustCompile(`(?i)open[- ]?source|\boss\b|\bosi\b|public (github )?repo|` +
+	`\b(open|free|permissive|copyleft)\s+(software\s+)?licen[cs]e|` +
+	`licen[cs]ed under (an? |the )?(open|osi|mit|apache|gpl|agpl|lgpl|bsd|mpl|creative commons)|` +
+	`\b(mit|apache|gpl|agpl|lgpl|bsd|mpl)\b[^.]{0,20}\blicen[cs]e`)
+
+// RequiresOpenSource indica si los requisitos del hackathon piden publicar con licencia abierta
+func RequiresOpenSource(hackathon *models.Hackathon) bool {
+	if hackathon == nil {
+		return false
+	}
+	for _, requirement := range hackathon.Requirements {
+		if openSourceRequirement.MatchString(requirement) {
+			return true
+		}
+	}
+	return false
+}
+
+// BuildLicenseReport clasifica las licencias de las dependencias y busca incompatibilidades con la
+// del proyecto. Las dependencias de desarrollo no se distribuyen y no se comprueban.
+func BuildLicenseReport(project ProjectLicense, deps models.DependencyAnalysis, hackathon *models.Hackathon) LicenseReport {
+	report := LicenseReport{Project: project, Categories: make(map[string]int)}
+	openSource := RequiresOpenSource(hackathon)
+	if hackathon != nil {
+		report.Hackathon = hackathon.Name
+	}
+
+	switch {
+	case project.License == "" && openSource:
+		report.Issues = append(report.Issues, LicenseIssue{Severity: "high",
+			Message: fmt.Sprintf("No license found, but %s requires an open-source submission. Add a LICENSE file (MIT or Apache-2.0 are common).", hackathon.Name)})
+	case project.License == "":
+		report.Issues = append(report.Issues, LicenseIssue{Severity: "medium",
+			Message: "No license found: without one, nobody may legally reuse the code."})
+	case openSource && (project.Category == LicenseProprietary || project.Category == LicenseUnknown):
+		report.Issues = append(report.Issues, LicenseIssue{Severity: "high", License: project.License,
+			Message: fmt.Sprintf("%s requires an open-source license, and %s is not a recognized one.", hackathon.Name, project.License)})
+	}
+	if project.Declared != "" && project.License != "" && project.License != project.Declared && len(project.Files) > 0 {
+		report.Issues = append(report.Issues, LicenseIssue{Severity: "low", License: project.Declared,
+			Message: fmt.Sprintf("%s declares %s but the license file is %s.", project.Manifest, project.Declared, project.License)})
+	}
+
+	unknown := 0
+	for _, dep := range deps.Details {
+		license := NormalizeLicense(dep.License)
+		category := LicenseUnknown
+		if license != "" && license != UnknownLicense {
+			category = LicenseCategory(license)
+		}
+		report.Categories[category]++
+		if dep.Dev {
+			continue
+		}
+		if category == LicenseUnknown {
+			unknown++
+			continue
+		}
+		if issue, ok := licenseConflict(project, dep, license, category); ok {
+			report.Issues = append(report.Issues, issue)
+		}
+	}
+	if unknown > 0 {
+		report.Issues = append(report.Issues, LicenseIssue{Severity: "low",
+			Message: fmt.Sprintf("%d runtime dependencies have an unknown license; install them (npm install, go mod download...) so licenses can be read.", unknown)})
+	}
+
+	sort.SliceStable(report.Issues, func(i, j int) bool {
+		return severityRank[report.Issues[i].Severity] > severityRank[report.Issues[j].Severity]
+	})
+	return report
+}
+
+// licenseConflict compara la licencia de una dependencia con la del proyecto
+func licenseConflict(project ProjectLicense, dep models.DependencyDetail, license, category string) (LicenseIssue, bool) {
+	issue := LicenseIssue{Dependency: dep.Name + "@" + dep.Version, License: license}
+	projectCategory := project.Category
+	projectLicense := project.License
+	if projectLicense == "" {
+		projectLicense, projectCategory = "no license", LicenseProprietary
+	}
+
+	switch {
+	case category == LicenseProprietary:
+		issue.Severity = "medium"
+		issue.Message = fmt.Sprintf("%s is not open source (%s): check that its terms allow redistribution.", dep.Name, license)
+	case category == LicenseNetworkCopyleft && projectCategory != LicenseNetworkCopyleft:
+		issue.Severity = "high"
+		issue.Message = fmt.Sprintf("%s is %s: offering the project as a network service requires publishing its source under %s, incompatible with %s.", dep.Name, license, license, projectLicense)
+	case category == LicenseStrongCopyleft && licenseRestriction[projectCategory] < licenseRestriction[LicenseStrongCopyleft]:
+		issue.Severity = "high"
+		issue.Message = fmt.Sprintf("%s is %s: distributing the project requires releasing it under %s, incompatible with %s.", dep.Name, license, license, projectLicense)
+	case category == LicenseStrongCopyleft && projectCategory == LicenseProprietary:
+		issue.Severity = "high"
+		issue.Message = fmt.Sprintf("%s is %s: the project must be released under %s to be distributed.", dep.Name, license, license)
+	case gplVersionConflict(projectLicense, license):
+		issue.Severity = "high"
+		issue.Message = fmt.Sprintf("%s (%s) cannot be combined with a %s project.", dep.Name, license, projectLicense)
+	case category == LicenseWeakCopyleft && strings.Contains(license, "LGPL") && (dep.Ecosystem == EcosystemGo || dep.Ecosystem == EcosystemCargo):
+		issue.Severity = "low"
+		issue.Message = fmt.Sprintf("%s is %s and %s links statically: users must be able to relink it, so share object files or the source.", dep.Name, license, dep.Ecosystem)
+	default:
+		return issue, false
+	}
+	return issue, true
+}
+
+// gplVersionConflict detecta las combinaciones de la familia GPL que no se pueden mezclar:
+// GPL-2.0-only con GPL-3.0/LGPL-3.0/Apache-2.0, y GPL-3.0 con GPL-2.0-only. Como en
+// LicenseCategory, con "A OR B" se puede elegir: solo hay conflicto si lo tienen todas las
+// combinaciones de alternativas.
+func gplVersionConflict(project, dep string) bool {
+	for _, p := range licenseAlternatives(project) {
+		for _, d := range licenseAlternatives(dep) {
+			if !gplTermsConflict(p, d) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// gplTermsConflict indica si algún término de una alternativa AND choca con alguno de la otra
+func gplTermsConflict(project, dep []licenseTerm) bool {
+	for _, pt := range project {
+		for _, dt := range dep {
+			p, d := pt.id, dt.id
+			pOnly2 := licenseBase(p) == "GPL-2.0" && !strings.HasSuffix(p, "-or-later")
+			dOnly2 := licenseBase(d) == "GPL-2.0" && !strings.HasSuffix(d, "-or-later")
+			switch {
+			case pOnly2 && (licenseBase(d) == "GPL-3.0" || licenseBase(d) == "LGPL-3.0" || licenseBase(d) == "AGPL-3.0" || d == "Apache-2.0"):
+				return true
+			case dOnly2 && (licenseBase(p) == "GPL-3.0" || licenseBase(p) == "AGPL-3.0"):
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// licenseHackathon resuelve el hackathon de AnalysisOptions cuyos requisitos se comprueban.
+// Si no se encuentra se avisa y el análisis sigue sin él, igual que sin licencia remota.
+func licenseHackathon(options *models.AnalysisOptions) *models.Hackathon {
+	if options == nil || options.Hackathon == "" {
+		return nil
+	}
+	hackathon, err := FindCachedHackathon(options.Hackathon)
+	if err != nil {
+		utils.WithError(err).Warn("Skipping hackathon license requirements")
+		return nil
+	}
+	return hackathon
+}
+
+// attachLicenses guarda el informe de licencias en el resultado y añade el insight y la
+// recomendación
+func attachLicenses(result *models.AnalysisResult, report LicenseReport) {
+	if repo, ok := RepositoryFromResult(result); ok && report.Project.License != "" {
+		repo.License = report.Project.License
+		result.Results = repo
+	}
+	if result.Metadata == nil {
+		result.Metadata = make(map[string]interface{})
+	}
+	result.Metadata["licenses"] = report
+
+	high, conflicts := 0, 0
+	for _, issue := range report.Issues {
+		if issue.Severity == "high" {
+			high++
+			if issue.Dependency != "" {
+				conflicts++
+			}
+		}
+	}
+	project := report.Project.License
+	if project == "" {
+		project = "no license"
+	}
+	description := fmt.Sprintf("Project license: %s.", project)
+	if len(report.Issues) == 0 {
+		description += " No license conflicts with its dependencies."
+	} else {
+		description += fmt.Sprintf(" %d license issues (%d high): %s", len(report.Issues), high, report.Issues[0].Message)
+	}
+	impact := "low"
+	switch {
+	case high > 0:
+		impact = "high"
+	case len(report.Issues) > 0 && report.Issues[0].Severity == "medium":
+		impact = "medium"
+	}
+	result.Insights = append(result.Insights, models.Insight{
+		Type:        "licenses",
+		Title:       "Licenses",
+		Description: description,
+		Impact:      impact,
+		Confidence:  0.85,
+		Data:        report,
+	})
+
+	// Sin licencia y con un hackathon que exige código abierto, añadirla pasa a ser prioritario
+	if report.Project.License == "" && high > conflicts {
+		for i := range result.Recommendations {
+			if result.Recommendations[i].ID == "local-license" {
+				result.Recommendations[i].Priority = "high"
+			}
+		}
+	}
+	if conflicts > 0 {
+		result.Recommendations = append(result.Recommendations, models.Recommendation{
+			ID: "licenses", Type: "compliance", Category: "licensing",
+			Title:       "Replace dependencies with incompatible licenses",
+			Description: fmt.Sprintf("%d dependencies have licenses incompatible with %s. Swap them for permissively licensed alternatives or relicense the project.", conflicts, project),
+			Priority:    "high", Effort: "medium", Impact: "high",
+		})
+	}
+}
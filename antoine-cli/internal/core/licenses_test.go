@@ -0,0 +1,60 @@
+package core
+
+import (
+	"testing"
+
+	"antoine-cli/internal/models"
+)
+
+func TestGPLVersionConflict(t *testing.T) {
+	tests := []struct {
+		name    string
+		project string
+		dep     string
+		want    bool
+	}{
+		{"gpl2 only with gpl3", "GPL-2.0-only", "GPL-3.0-only", true},
+		{"gpl2 only with apache", "GPL-2.0-only", "Apache-2.0", true},
+		{"gpl3 with gpl2 only dep", "GPL-3.0-or-later", "GPL-2.0-only", true},
+		{"gpl2 or later with gpl3", "GPL-2.0-or-later", "GPL-3.0-only", false},
+		{"project alternative avoids conflict", "GPL-2.0-only OR MIT", "GPL-3.0-only", false},
+		{"dep alternative avoids conflict", "GPL-2.0-only", "GPL-3.0-only OR MIT", false},
+		{"every alternative conflicts", "GPL-2.0-only", "GPL-3.0-only OR Apache-2.0", true},
+		{"conjunction keeps conflict", "GPL-2.0-only", "MIT AND Apache-2.0", true},
+		{"permissive pair", "MIT", "Apache-2.0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := gplVersionConflict(tt.project, tt.dep); got != tt.want {
+				t.Errorf("gplVersionConflict(%q, %q) = %v, want %v", tt.project, tt.dep, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRequiresOpenSource(t *testing.T) {
+	tests := []struct {
+		requirement string
+		want        bool
+	}{
+		{"Projects must be open source", true},
+		{"Submit a public GitHub repo", true},
+		{"Use an OSI-approved license", true},
+		{"Code must be licensed under MIT or Apache", true},
+		{"Any permissive license is fine", true},
+		{"Include an MIT or BSD license file", true},
+		{"Participants need a valid driver's license", false},
+		{"Teams must hold a business license", false},
+		{"Bring your laptop", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.requirement, func(t *testing.T) {
+			hackathon := &models.Hackathon{Requirements: []string{tt.requirement}}
+			if got := RequiresOpenSource(hackathon); got != tt.want {
+				t.Errorf("RequiresOpenSource(%q) = %v, want %v", tt.requirement, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,742 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+
+	"antoine-cli/internal/models"
+)
+
+// Ecosistemas de dependencias, con los nombres que usa OSV
+const (
+	EcosystemGo    = "Go"
+	EcosystemNpm   = "npm"
+	EcosystemPyPI  = "PyPI"
+	EcosystemCargo = "crates.io"
+	EcosystemMaven = "Maven"
+)
+
+// manifestParser extrae las dependencias de un manifiesto o lockfile
+type manifestParser func(content []byte) ([]models.DependencyDetail, error)
+
+// dependencyManifests son los archivos reconocidos; en cada directorio los lockfiles se
+// procesan después de su manifiesto para fijar las versiones
+var dependencyManifests = []string{
+	"go.mod",
+	"package.json", "package-lock.json", "npm-shrinkwrap.json", "yarn.lock", "pnpm-lock.yaml",
+	"requirements.txt", "pyproject.toml",
+	"Cargo.toml", "Cargo.lock",
+	"pom.xml",
+}
+
+var manifestParsers = map[string]manifestParser{
+	"go.mod":              parseGoMod,
+	"package.json":        parsePackageJSON,
+	"package-lock.json":   parsePackageLock,
+	"npm-shrinkwrap.json": parsePackageLock,
+	"yarn.lock":           parseYarnLock,
+	"pnpm-lock.yaml":      parsePnpmLock,
+	"requirements.txt":    parseRequirements,
+	"pyproject.toml":      parsePyProject,
+	"Cargo.toml":          parseCargoToml,
+	"Cargo.lock":          parseCargoLock,
+	"pom.xml":             parsePom,
+}
+
+// lockfiles fijan versiones: sus dependencias no son directas salvo que las declare el manifiesto
+var lockfiles = map[string]bool{
+	"package-lock.json": true, "npm-shrinkwrap.json": true, "yarn.lock": true, "pnpm-lock.yaml": true, "Cargo.lock": true,
+}
+
+// IsDependencyManifest indica si el archivo es un manifiesto o lockfile reconocido
+func IsDependencyManifest(filePath string) bool {
+	_, ok := manifestParsers[path.Base(filePath)]
+	return ok
+}
+
+// ParseManifest parsea un manifiesto o lockfile según su nombre
+func ParseManifest(filePath string, content []byte) ([]models.DependencyDetail, error) {
+	parser, ok := manifestParsers[path.Base(filePath)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported manifest: %s", filePath)
+	}
+	deps, err := parser(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", filePath, err)
+	}
+	for i := range deps {
+		deps[i].Manifest = filePath
+	}
+	return deps, nil
+}
+
+// manifestOrder es la posición del archivo en dependencyManifests
+func manifestOrder(filePath string) int {
+	base := path.Base(filePath)
+	for i, name := range dependencyManifests {
+		if name == base {
+			return i
+		}
+	}
+	return len(dependencyManifests)
+}
+
+// --- Go ---
+
+func parseGoMod(content []byte) ([]models.DependencyDetail, error) {
+	var deps []models.DependencyDetail
+	inRequire := false
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		comment := ""
+		if i := strings.Index(line, "//"); i >= 0 {
+			comment = line[i+2:]
+			line = strings.TrimSpace(line[:i])
+		}
+
+		switch {
+		case inRequire && line == ")":
+			inRequire = false
+			continue
+		case line == "require (" || line == "require(":
+			inRequire = true
+			continue
+		case strings.HasPrefix(line, "require "):
+			line = strings.TrimSpace(strings.TrimPrefix(line, "require "))
+		case !inRequire:
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+		deps = append(deps, models.DependencyDetail{
+			Name:      strings.Trim(fields[0], `"`),
+			Version:   fields[1],
+			Ecosystem: EcosystemGo,
+			Direct:    !strings.Contains(comment, "indirect"),
+		})
+	}
+	return deps, scanner.Err()
+}
+
+// --- npm ---
+
+func parsePackageJSON(content []byte) ([]models.DependencyDetail, error) {
+	var manifest struct {
+		Dependencies         map[string]string `json:"dependencies"`
+		DevDependencies      map[string]string `json:"devDependencies"`
+		OptionalDependencies map[string]string `json:"optionalDependencies"`
+	}
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return nil, err
+	}
+
+	var deps []models.DependencyDetail
+	for _, group := range []struct {
+		deps map[string]string
+		dev  bool
+	}{{manifest.Dependencies, false}, {manifest.OptionalDependencies, false}, {manifest.DevDependencies, true}} {
+		for _, name := range sortedKeys(group.deps) {
+			deps = append(deps, models.DependencyDetail{
+				Name: name, Version: group.deps[name], Ecosystem: EcosystemNpm, Direct: true, Dev: group.dev,
+			})
+		}
+	}
+	return deps, nil
+}
+
+// npmPackageEntry es una entrada de "packages" (lockfileVersion 2 y 3), indexada por su ruta
+type npmPackageEntry struct {
+	Version              string            `json:"version"`
+	Integrity            string            `json:"integrity"`
+	License              interface{}       `json:"license"`
+	Dev                  bool              `json:"dev"`
+	Link                 bool              `json:"link"`
+	Dependencies         map[string]string `json:"dependencies"` // nombre -> rango pedido
+	OptionalDependencies map[string]string `json:"optionalDependencies"`
+	PeerDependencies     map[string]string `json:"peerDependencies"`
+}
+
+// npmLockEntry es una entrada del árbol "dependencies" de lockfileVersion 1
+type npmLockEntry struct {
+	Version      string                  `json:"version"`
+	Integrity    string                  `json:"integrity"`
+	Dev          bool                    `json:"dev"`
+	Requires     map[string]string       `json:"requires"` // nombre -> rango pedido
+	Dependencies map[string]npmLockEntry `json:"dependencies"`
+}
+
+func parsePackageLock(content []byte) ([]models.DependencyDetail, error) {
+	var lock struct {
+		Packages     map[string]npmPackageEntry `json:"packages"`
+		Dependencies map[string]npmLockEntry    `json:"dependencies"`
+	}
+	if err := json.Unmarshal(content, &lock); err != nil {
+		return nil, err
+	}
+
+	var deps []models.DependencyDetail
+	versions := make(map[string]string) // ruta -> versión instalada
+	var requires []map[string]string    // rangos pedidos por cada entrada de deps
+	if len(lock.Packages) > 0 {
+		for _, key := range sortedKeys(lock.Packages) {
+			entry := lock.Packages[key]
+			i := strings.LastIndex(key, "node_modules/")
+			if i < 0 || entry.Link || entry.Version == "" {
+				continue // "" es el propio proyecto; los link son paquetes del workspace
+			}
+			deps = append(deps, models.DependencyDetail{
+				Name: key[i+len("node_modules/"):], Version: entry.Version, License: licenseString(entry.License),
+				Ecosystem: EcosystemNpm, Checksum: entry.Integrity, Dev: entry.Dev, Path: key,
+			})
+			versions[key] = entry.Version
+			wanted := make(map[string]string)
+			for _, group := range []map[string]string{entry.PeerDependencies, entry.OptionalDependencies, entry.Dependencies} {
+				for name, spec := range group {
+					wanted[name] = spec
+				}
+			}
+			requires = append(requires, wanted)
+		}
+	} else {
+		// lockfileVersion 1: árbol de dependencias anidadas; la ruta se construye como en la versión 2
+		var walk func(parent string, entries map[string]npmLockEntry)
+		walk = func(parent string, entries map[string]npmLockEntry) {
+			for _, name := range sortedKeys(entries) {
+				entry := entries[name]
+				lockPath := parent + "node_modules/" + name
+				deps = append(deps, models.DependencyDetail{
+					Name: name, Version: entry.Version, Ecosystem: EcosystemNpm, Checksum: entry.Integrity, Dev: entry.Dev,
+					Path: lockPath,
+				})
+				versions[lockPath] = entry.Version
+				requires = append(requires, entry.Requires)
+				walk(lockPath+"/", entry.Dependencies)
+			}
+		}
+		walk("", lock.Dependencies)
+	}
+
+	// Cada dependencia se resuelve como lo hace node: el node_modules más cercano hacia arriba
+	for i := range deps {
+		for _, name := range sortedKeys(requires[i]) {
+			if version, ok := resolveNodeModule(versions, deps[i].Path, name); ok {
+				deps[i].Requires = append(deps[i].Requires, name+"@"+version)
+			}
+		}
+	}
+	return deps, nil
+}
+
+// resolveNodeModule busca name en from/node_modules y en los node_modules de sus antecesores
+func resolveNodeModule(versions map[string]string, from, name string) (string, bool) {
+	for dir := from; ; {
+		if version, ok := versions[dir+"/node_modules/"+name]; ok {
+			return version, true
+		}
+		i := strings.LastIndex(dir, "/node_modules/")
+		if i < 0 {
+			break
+		}
+		dir = dir[:i]
+	}
+	version, ok := versions["node_modules/"+name]
+	return version, ok
+}
+
+// licenseString acepta "MIT", {"type": "MIT"} o [{"type": "MIT"}, ...] (formatos antiguos de npm)
+func licenseString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case map[string]interface{}:
+		if t, ok := v["type"].(string); ok {
+			return t
+		}
+	case []interface{}:
+		var licenses []string
+		for _, item := range v {
+			if license := licenseString(item); license != "" {
+				licenses = append(licenses, license)
+			}
+		}
+		if len(licenses) > 1 {
+			return "(" + strings.Join(licenses, " OR ") + ")"
+		}
+		return strings.Join(licenses, "")
+	}
+	return ""
+}
+
+// parseYarnLock entiende el formato de yarn 1 (version "1.0.0") y de yarn berry (version: 1.0.0).
+// La cabecera de cada entrada lista los rangos (name@range) que resuelve, y así se resuelven sus
+// dependencias.
+func parseYarnLock(content []byte) ([]models.DependencyDetail, error) {
+	var deps []models.DependencyDetail
+	resolved := make(map[string]int) // name@range -> índice en deps
+	var specs []string               // rangos de la entrada actual
+	var requires [][]string          // name@range que pide cada entrada de deps
+	name, section := "", ""
+	current := -1 // entrada cuyos campos se están leyendo
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		switch {
+		case trimmed == "" || strings.HasPrefix(trimmed, "#"):
+			continue
+		case indent == 0 && strings.HasSuffix(trimmed, ":"):
+			name, section, current, specs = "", "", -1, nil
+			for _, spec := range strings.Split(strings.TrimSuffix(trimmed, ":"), ",") {
+				spec = strings.Trim(spec, `" `)
+				if at := strings.LastIndex(spec, "@"); at > 0 {
+					name = spec[:at]
+					specs = append(specs, spec)
+				}
+			}
+		case name != "" && (strings.HasPrefix(trimmed, "version ") || strings.HasPrefix(trimmed, "version:")):
+			version := strings.Trim(strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(trimmed, "version"), ":")), `"`)
+			deps = append(deps, models.DependencyDetail{Name: name, Version: version, Ecosystem: EcosystemNpm})
+			requires = append(requires, nil)
+			current = len(deps) - 1
+			for _, spec := range specs {
+				resolved[spec] = current
+			}
+			name = ""
+		case current >= 0 && indent <= 2:
+			section = strings.TrimSuffix(trimmed, ":")
+			if strings.HasPrefix(trimmed, "integrity ") { // yarn berry usa su propio checksum
+				deps[current].Checksum = strings.Trim(strings.TrimSpace(strings.TrimPrefix(trimmed, "integrity")), `"`)
+			}
+		case current >= 0 && (section == "dependencies" || section == "optionalDependencies"):
+			// yarn 1: name "range"; yarn berry: name: "npm:range"
+			depName, spec, ok := strings.Cut(trimmed, " ")
+			if !ok {
+				continue
+			}
+			depName = strings.Trim(strings.TrimSuffix(depName, ":"), `"`)
+			requires[current] = append(requires[current], depName+"@"+strings.Trim(strings.TrimSpace(spec), `"`))
+		}
+	}
+
+	for i, wanted := range requires {
+		for _, spec := range wanted {
+			if j, ok := resolved[spec]; ok {
+				deps[i].Requires = append(deps[i].Requires, deps[j].Name+"@"+deps[j].Version)
+			}
+		}
+		sort.Strings(deps[i].Requires)
+	}
+	return deps, scanner.Err()
+}
+
+// pnpmPackage tiene los campos de packages (v5, v6) y snapshots (v9) que se leen
+type pnpmPackage struct {
+	Dev        bool `yaml:"dev"`
+	Resolution struct {
+		Integrity string `yaml:"integrity"`
+	} `yaml:"resolution"`
+	Dependencies         map[string]string `yaml:"dependencies"` // nombre -> versión resuelta
+	OptionalDependencies map[string]string `yaml:"optionalDependencies"`
+}
+
+// parsePnpmLock lee las claves de packages: /name/1.0.0 (v5), /name@1.0.0 (v6) o name@1.0.0 (v9).
+// En v9 las dependencias de cada paquete están en snapshots.
+func parsePnpmLock(content []byte) ([]models.DependencyDetail, error) {
+	var lock struct {
+		Packages  map[string]pnpmPackage `yaml:"packages"`
+		Snapshots map[string]pnpmPackage `yaml:"snapshots"`
+	}
+	if err := yaml.Unmarshal(content, &lock); err != nil {
+		return nil, err
+	}
+
+	requires := make(map[string][]string) // name@version -> name@version de sus dependencias
+	for _, entries := range []map[string]pnpmPackage{lock.Packages, lock.Snapshots} {
+		for key, entry := range entries {
+			name, version := pnpmSpec(key)
+			for _, group := range []map[string]string{entry.Dependencies, entry.OptionalDependencies} {
+				for depName, ref := range group {
+					if depVersion, ok := pnpmReference(depName, ref); ok {
+						requires[name+"@"+version] = append(requires[name+"@"+version], depVersion)
+					}
+				}
+			}
+		}
+	}
+
+	var deps []models.DependencyDetail
+	seen := make(map[string]bool)
+	for _, key := range sortedKeys(lock.Packages) {
+		name, version := pnpmSpec(key)
+		if name == "" || seen[name+"@"+version] {
+			continue
+		}
+		seen[name+"@"+version] = true
+		entry := lock.Packages[key]
+		dep := models.DependencyDetail{
+			Name: name, Version: version, Ecosystem: EcosystemNpm, Checksum: entry.Resolution.Integrity, Dev: entry.Dev,
+		}
+		dep.Requires = slices.Compact(slices.Sorted(slices.Values(requires[name+"@"+version])))
+		deps = append(deps, dep)
+	}
+	return deps, nil
+}
+
+// pnpmSpec separa nombre y versión de una clave de pnpm, sin el sufijo de peer dependencies
+func pnpmSpec(key string) (string, string) {
+	spec := strings.TrimPrefix(key, "/")
+	if i := strings.Index(spec, "("); i > 0 {
+		spec = spec[:i]
+	}
+	if slash := strings.LastIndex(spec, "/"); slash > 0 {
+		// v5: /name/1.0.0 o /name/1.0.0_peer@2.0.0
+		version, _, _ := strings.Cut(spec[slash+1:], "_")
+		if version != "" && version[0] >= '0' && version[0] <= '9' && !strings.Contains(version, "@") {
+			return spec[:slash], version
+		}
+	}
+	if at := strings.LastIndex(spec, "@"); at > 0 {
+		return spec[:at], spec[at+1:]
+	}
+	return "", ""
+}
+
+// pnpmReference convierte la versión de una dependencia en name@version. Puede ser una versión
+// (1.0.0, 1.0.0(peer@2)) o un alias a otro paquete (/other/1.0.0, other@1.0.0); link: y file:
+// apuntan al workspace.
+func pnpmReference(name, ref string) (string, bool) {
+	if strings.HasPrefix(ref, "link:") || strings.HasPrefix(ref, "file:") {
+		return "", false
+	}
+	if ref != "" && ref[0] >= '0' && ref[0] <= '9' {
+		version, _, _ := strings.Cut(strings.SplitN(ref, "(", 2)[0], "_")
+		return name + "@" + version, true
+	}
+	if aliased, version := pnpmSpec(ref); aliased != "" {
+		return aliased + "@" + version, true
+	}
+	return "", false
+}
+
+// --- Python ---
+
+// pythonRequirement reconoce name[extras] <especificador> ; marcadores (PEP 508)
+var pythonRequirement = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9._-]*)\s*(\[[^\]]*\])?\s*(.*)$`)
+
+// parsePythonRequirement devuelve false para líneas que no son un paquete (-r, URLs, rutas)
+func parsePythonRequirement(line string) (models.DependencyDetail, bool) {
+	line = strings.TrimSpace(line)
+	if i := strings.Index(line, ";"); i >= 0 {
+		line = strings.TrimSpace(line[:i])
+	}
+	if line == "" || strings.HasPrefix(line, "-") || strings.Contains(line, "://") || strings.HasPrefix(line, ".") || strings.HasPrefix(line, "/") {
+		return models.DependencyDetail{}, false
+	}
+	match := pythonRequirement.FindStringSubmatch(line)
+	if match == nil {
+		return models.DependencyDetail{}, false
+	}
+	version := strings.TrimSpace(strings.Trim(strings.TrimSpace(match[3]), "()"))
+	if strings.HasPrefix(version, "@") { // name @ url
+		version = ""
+	}
+	if strings.HasPrefix(version, "==") && !strings.ContainsAny(version[2:], ",*") {
+		version = strings.TrimSpace(version[2:])
+	}
+	return models.DependencyDetail{Name: normalizePythonName(match[1]), Version: version, Ecosystem: EcosystemPyPI, Direct: true}, true
+}
+
+var pythonNameSeparators = regexp.MustCompile(`[-_.]+`)
+
+// normalizePythonName aplica la normalización de nombres de PEP 503
+func normalizePythonName(name string) string {
+	return pythonNameSeparators.ReplaceAllString(strings.ToLower(name), "-")
+}
+
+func parseRequirements(content []byte) ([]models.DependencyDetail, error) {
+	var deps []models.DependencyDetail
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, " #"); i >= 0 {
+			line = line[:i]
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		if dep, ok := parsePythonRequirement(line); ok {
+			deps = append(deps, dep)
+		}
+	}
+	return deps, scanner.Err()
+}
+
+// parsePyProject entiende PEP 621 ([project]), PEP 735 ([dependency-groups]) y Poetry
+func parsePyProject(content []byte) ([]models.DependencyDetail, error) {
+	var project struct {
+		Project struct {
+			Dependencies         []string            `toml:"dependencies"`
+			OptionalDependencies map[string][]string `toml:"optional-dependencies"`
+		} `toml:"project"`
+		DependencyGroups map[string][]interface{} `toml:"dependency-groups"`
+		Tool             struct {
+			Poetry struct {
+				Dependencies    map[string]interface{} `toml:"dependencies"`
+				DevDependencies map[string]interface{} `toml:"dev-dependencies"`
+				Group           map[string]struct {
+					Dependencies map[string]interface{} `toml:"dependencies"`
+				} `toml:"group"`
+			} `toml:"poetry"`
+		} `toml:"tool"`
+	}
+	if err := toml.Unmarshal(content, &project); err != nil {
+		return nil, err
+	}
+
+	var deps []models.DependencyDetail
+	addRequirements := func(requirements []string, dev bool) {
+		for _, requirement := range requirements {
+			if dep, ok := parsePythonRequirement(requirement); ok {
+				dep.Dev = dev
+				deps = append(deps, dep)
+			}
+		}
+	}
+	addPoetry := func(table map[string]interface{}, dev bool) {
+		for _, name := range sortedKeys(table) {
+			if strings.EqualFold(name, "python") {
+				continue
+			}
+			deps = append(deps, models.DependencyDetail{
+				Name: normalizePythonName(name), Version: tableVersion(table[name]), Ecosystem: EcosystemPyPI, Direct: true, Dev: dev,
+			})
+		}
+	}
+
+	addRequirements(project.Project.Dependencies, false)
+	for _, group := range sortedKeys(project.Project.OptionalDependencies) {
+		addRequirements(project.Project.OptionalDependencies[group], isDevGroup(group))
+	}
+	for _, group := range sortedKeys(project.DependencyGroups) {
+		var requirements []string
+		for _, item := range project.DependencyGroups[group] {
+			if requirement, ok := item.(string); ok { // {include-group = ...} no es un paquete
+				requirements = append(requirements, requirement)
+			}
+		}
+		addRequirements(requirements, isDevGroup(group))
+	}
+	poetry := project.Tool.Poetry
+	addPoetry(poetry.Dependencies, false)
+	addPoetry(poetry.DevDependencies, true)
+	for _, group := range sortedKeys(poetry.Group) {
+		addPoetry(poetry.Group[group].Dependencies, group != "main")
+	}
+	return deps, nil
+}
+
+// isDevGroup reconoce los grupos de dependencias de desarrollo por su nombre
+func isDevGroup(group string) bool {
+	group = strings.ToLower(group)
+	for _, marker := range []string{"dev", "test", "lint", "doc", "type", "check"} {
+		if strings.Contains(group, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// tableVersion acepta "1.0" o {version = "1.0", ...} (Poetry y Cargo)
+func tableVersion(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case map[string]interface{}:
+		if version, ok := v["version"].(string); ok {
+			return version
+		}
+	}
+	return ""
+}
+
+// --- Rust ---
+
+func parseCargoToml(content []byte) ([]models.DependencyDetail, error) {
+	var manifest map[string]interface{}
+	if err := toml.Unmarshal(content, &manifest); err != nil {
+		return nil, err
+	}
+
+	var deps []models.DependencyDetail
+	add := func(table interface{}, dev bool) {
+		entries, _ := table.(map[string]interface{})
+		for _, name := range sortedKeys(entries) {
+			crate := name
+			if spec, ok := entries[name].(map[string]interface{}); ok {
+				if _, local := spec["path"]; local && spec["version"] == nil {
+					continue // crate del propio workspace
+				}
+				if renamed, ok := spec["package"].(string); ok {
+					crate = renamed
+				}
+			}
+			deps = append(deps, models.DependencyDetail{
+				Name: crate, Version: tableVersion(entries[name]), Ecosystem: EcosystemCargo, Direct: true, Dev: dev,
+			})
+		}
+	}
+	addSections := func(sections map[string]interface{}) {
+		add(sections["dependencies"], false)
+		add(sections["build-dependencies"], true)
+		add(sections["dev-dependencies"], true)
+	}
+
+	addSections(manifest)
+	if workspace, ok := manifest["workspace"].(map[string]interface{}); ok {
+		add(workspace["dependencies"], false)
+	}
+	if targets, ok := manifest["target"].(map[string]interface{}); ok {
+		for _, target := range sortedKeys(targets) {
+			if sections, ok := targets[target].(map[string]interface{}); ok {
+				addSections(sections)
+			}
+		}
+	}
+	return deps, nil
+}
+
+func parseCargoLock(content []byte) ([]models.DependencyDetail, error) {
+	var lock struct {
+		Package []struct {
+			Name     string `toml:"name"`
+			Version  string `toml:"version"`
+			Source   string `toml:"source"`
+			Checksum string `toml:"checksum"` // sha256 en hexadecimal
+			// "name", o "name version (source)" si hay varias versiones del crate
+			Dependencies []string `toml:"dependencies"`
+		} `toml:"package"`
+	}
+	if err := toml.Unmarshal(content, &lock); err != nil {
+		return nil, err
+	}
+
+	versions := make(map[string]string) // nombre -> versión, si solo hay una
+	for _, pkg := range lock.Package {
+		if _, ok := versions[pkg.Name]; ok {
+			versions[pkg.Name] = ""
+			continue
+		}
+		versions[pkg.Name] = pkg.Version
+	}
+
+	var deps []models.DependencyDetail
+	for _, pkg := range lock.Package {
+		if pkg.Source == "" {
+			continue // crates del propio workspace
+		}
+		dep := models.DependencyDetail{
+			Name: pkg.Name, Version: pkg.Version, Ecosystem: EcosystemCargo, Checksum: hexChecksum("sha256", pkg.Checksum),
+		}
+		for _, requirement := range pkg.Dependencies {
+			fields := strings.Fields(requirement)
+			switch {
+			case len(fields) > 1:
+				dep.Requires = append(dep.Requires, fields[0]+"@"+fields[1])
+			case len(fields) == 1 && versions[fields[0]] != "":
+				dep.Requires = append(dep.Requires, fields[0]+"@"+versions[fields[0]])
+			}
+		}
+		deps = append(deps, dep)
+	}
+	return deps, nil
+}
+
+// --- Maven ---
+
+type pomProperty struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+func parsePom(content []byte) ([]models.DependencyDetail, error) {
+	var pom struct {
+		Version string `xml:"version"`
+		Parent  struct {
+			Version string `xml:"version"`
+		} `xml:"parent"`
+		Properties struct {
+			Entries []pomProperty `xml:",any"`
+		} `xml:"properties"`
+		Dependencies []struct {
+			GroupID    string `xml:"groupId"`
+			ArtifactID string `xml:"artifactId"`
+			Version    string `xml:"version"`
+			Scope      string `xml:"scope"`
+		} `xml:"dependencies>dependency"`
+	}
+	if err := xml.Unmarshal(content, &pom); err != nil {
+		return nil, err
+	}
+
+	properties := map[string]string{"project.version": pom.Version, "project.parent.version": pom.Parent.Version}
+	for _, property := range pom.Properties.Entries {
+		properties[property.XMLName.Local] = strings.TrimSpace(property.Value)
+	}
+	expand := func(value string) string {
+		for i := 0; i < 5 && strings.Contains(value, "${"); i++ { // propiedades que usan otras
+			for name, replacement := range properties {
+				value = strings.ReplaceAll(value, "${"+name+"}", replacement)
+			}
+		}
+		return value
+	}
+
+	var deps []models.DependencyDetail
+	for _, dependency := range pom.Dependencies {
+		scope := strings.TrimSpace(dependency.Scope)
+		deps = append(deps, models.DependencyDetail{
+			Name:      expand(strings.TrimSpace(dependency.GroupID)) + ":" + expand(strings.TrimSpace(dependency.ArtifactID)),
+			Version:   expand(strings.TrimSpace(dependency.Version)),
+			Ecosystem: EcosystemMaven,
+			Direct:    true,
+			Dev:       scope == "test" || scope == "provided",
+		})
+	}
+	return deps, nil
+}
+
+// hexChecksum convierte un hash en hexadecimal al formato SRI de DependencyDetail.Checksum
+func hexChecksum(algorithm, value string) string {
+	sum, err := hex.DecodeString(value)
+	if err != nil || len(sum) == 0 {
+		return ""
+	}
+	return algorithm + "-" + base64.StdEncoding.EncodeToString(sum)
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
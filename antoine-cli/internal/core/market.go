@@ -0,0 +1,144 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/utils"
+)
+
+// AnalyzeMarket busca competidores y financiación del dominio y calcula las
+// puntuaciones de models.MarketAnalysis. Si se pasa un proyecto, las ventajas se
+// calculan respecto a él y se guarda el resultado en Project.MarketPotential.
+func (c *AntoineClient) AnalyzeMarket(ctx context.Context, domain string, project *models.Project) (*models.MarketReport, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	cacheKey := fmt.Sprintf("market:%s", strings.ToLower(domain))
+	report, cached := c.cachedMarketReport(cacheKey)
+	c.analytics.RecordCacheLookup("market", cached)
+
+	if !cached {
+		var err error
+		report, err = c.mcp.exa.SearchMarket(ctx, domain)
+		if err != nil {
+			return nil, fmt.Errorf("market search failed: %w", err)
+		}
+		c.cache.Set(cacheKey, report, 6*time.Hour)
+	}
+
+	// Copia para no modificar el informe guardado en caché
+	result := *report
+	scoreMarket(&result, project)
+
+	if project != nil {
+		project.MarketPotential = result.Analysis
+	}
+
+	c.analytics.RecordAnalysis("market", domain)
+
+	return &result, nil
+}
+
+func (c *AntoineClient) cachedMarketReport(key string) (*models.MarketReport, bool) {
+	if cached, found := c.cache.Get(key); found {
+		if report, ok := cached.(*models.MarketReport); ok {
+			return report, true
+		}
+	}
+	return nil, false
+}
+
+// scoreMarket rellena competencia, oportunidad, viabilidad, necesidad y ventajas
+func scoreMarket(report *models.MarketReport, project *models.Project) {
+	analysis := &report.Analysis
+
+	analysis.Competition = len(analysis.Competitors)
+	if analysis.Competition > 10 {
+		analysis.Competition = 10
+	}
+
+	recentNews := 0
+	for _, news := range report.News {
+		if time.Since(news.Published) <= 90*24*time.Hour {
+			recentNews++
+		}
+	}
+
+	// El interés inversor y las noticias recientes suben la oportunidad; la competencia la baja
+	analysis.Opportunity = clampScore(50 +
+		math.Min(25, float64(len(report.Funding))*5) +
+		math.Min(15, float64(recentNews)*3) -
+		float64(analysis.Competition)*3)
+
+	analysis.Viability = 30
+	if report.TotalFunding > 0 {
+		analysis.Viability = clampScore(30 + 12*math.Log10(report.TotalFunding/1e5+1))
+	}
+
+	analysis.UserNeed = clampScore(40 + float64(len(report.News))*4)
+	analysis.Score = (analysis.Opportunity + analysis.Viability + analysis.UserNeed) / 3
+	analysis.Advantages = marketAdvantages(report, project)
+}
+
+// marketAdvantages describe qué favorece al proyecto (o a un nuevo participante) en el mercado
+func marketAdvantages(report *models.MarketReport, project *models.Project) []string {
+	analysis := report.Analysis
+	var advantages []string
+
+	if project != nil {
+		trending := make(map[string]bool)
+		for _, tech := range analysis.Trends {
+			trending[strings.ToLower(tech)] = true
+		}
+
+		for _, tech := range project.Technologies {
+			if trending[strings.ToLower(tech)] {
+				advantages = append(advantages, fmt.Sprintf("Builds on %s, one of the most discussed technologies in %s", tech, report.Domain))
+			} else {
+				advantages = append(advantages, fmt.Sprintf("Differentiates with %s, rarely mentioned by competitors", tech))
+			}
+		}
+	}
+
+	switch {
+	case analysis.Competition == 0:
+		advantages = append(advantages, "No established competitors found: early mover opportunity")
+	case analysis.Competition <= 3:
+		advantages = append(advantages, fmt.Sprintf("Low competition: only %d competitors found", analysis.Competition))
+	}
+
+	if len(report.Funding) > 0 {
+		advantages = append(advantages, fmt.Sprintf("Investor interest: %d funding rounds in the last year (%s total)",
+			len(report.Funding), utils.FormatUSD(report.TotalFunding)))
+	}
+
+	return advantages
+}
+
+// LoadProjectFile carga un proyecto guardado como JSON
+func LoadProjectFile(path string) (*models.Project, error) {
+	project := &models.Project{}
+	found, err := readJSONFile(path, project)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("project file %s not found", path)
+	}
+	return project, nil
+}
+
+// SaveProjectFile guarda un proyecto como JSON
+func SaveProjectFile(path string, project *models.Project) error {
+	project.LastUpdated = time.Now()
+	return writeJSONFile(path, project)
+}
+
+func clampScore(score float64) float64 {
+	return math.Max(0, math.Min(100, score))
+}
@@ -0,0 +1,176 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"antoine-cli/internal/config"
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/utils"
+)
+
+// Notification es un aviso enviado por los canales de NotificationsConfig
+type Notification struct {
+	Title      string              `json:"title"`
+	Message    string              `json:"message"`
+	Event      string              `json:"event"` // p. ej. watch.new, watch.changed
+	Hackathons []*models.Hackathon `json:"hackathons,omitempty"`
+	SentAt     time.Time           `json:"sent_at"`
+}
+
+// Notifier envía notificaciones por terminal (bell u OSC 9), comando de escritorio y webhook
+type Notifier struct {
+	config     config.NotificationsConfig
+	terminal   io.Writer
+	httpClient *http.Client
+}
+
+// NewNotifier crea un notificador; terminal es donde se escriben las secuencias de escape
+func NewNotifier(cfg config.NotificationsConfig, terminal io.Writer) *Notifier {
+	return &Notifier{
+		config:     cfg,
+		terminal:   terminal,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Channels devuelve los canales activos, para mostrarlos al usuario
+func (n *Notifier) Channels() []string {
+	if !n.config.Enabled {
+		return nil
+	}
+
+	var channels []string
+	if mode := n.terminalMode(); mode != "none" {
+		channels = append(channels, "terminal ("+mode+")")
+	}
+	if n.config.Desktop {
+		channels = append(channels, "desktop")
+	}
+	if n.config.Webhook != "" {
+		channels = append(channels, "webhook")
+	}
+	return channels
+}
+
+// Notify envía la notificación por todos los canales activos y devuelve los errores combinados
+func (n *Notifier) Notify(ctx context.Context, notification Notification) error {
+	if !n.config.Enabled {
+		return nil
+	}
+	if notification.SentAt.IsZero() {
+		notification.SentAt = time.Now()
+	}
+
+	var errs []error
+
+	n.notifyTerminal(notification)
+
+	if n.config.Desktop {
+		if err := n.notifyDesktop(ctx, notification); err != nil {
+			errs = append(errs, fmt.Errorf("desktop notification failed: %w", err))
+		}
+	}
+
+	if n.config.Webhook != "" {
+		if err := n.notifyWebhook(ctx, notification); err != nil {
+			errs = append(errs, fmt.Errorf("webhook notification failed: %w", err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// terminalMode resuelve el modo de terminal; "sound" activa el bell si no se indicó otro
+func (n *Notifier) terminalMode() string {
+	switch mode := strings.ToLower(n.config.Terminal); mode {
+	case "bell", "osc9", "none":
+		return mode
+	case "":
+		if n.config.Sound {
+			return "bell"
+		}
+	}
+	return "none"
+}
+
+func (n *Notifier) notifyTerminal(notification Notification) {
+	if n.terminal == nil {
+		return
+	}
+
+	switch n.terminalMode() {
+	case "bell":
+		fmt.Fprint(n.terminal, "\a")
+	case "osc9":
+		// OSC 9: notificación de escritorio a través del emulador de terminal
+		message := strings.NewReplacer("\a", " ", "\x1b", " ", "\n", " ").Replace(notification.Title + ": " + notification.Message)
+		fmt.Fprintf(n.terminal, "\x1b]9;%s\a", message)
+	}
+}
+
+// notifyDesktop ejecuta el comando configurado o el notificador nativo del sistema
+func (n *Notifier) notifyDesktop(ctx context.Context, notification Notification) error {
+	var cmd *exec.Cmd
+	switch {
+	case n.config.Command != "":
+		cmd = exec.CommandContext(ctx, "sh", "-c", n.config.Command)
+	case runtime.GOOS == "darwin":
+		script := fmt.Sprintf("display notification %q with title %q", notification.Message, notification.Title)
+		cmd = exec.CommandContext(ctx, "osascript", "-e", script)
+	default:
+		path, err := exec.LookPath("notify-send")
+		if err != nil {
+			return fmt.Errorf("no desktop notifier found: set notifications.command")
+		}
+		cmd = exec.CommandContext(ctx, path, notification.Title, notification.Message)
+	}
+
+	cmd.Env = append(os.Environ(),
+		"ANTOINE_TITLE="+notification.Title,
+		"ANTOINE_MESSAGE="+notification.Message,
+		"ANTOINE_EVENT="+notification.Event,
+	)
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
+
+func (n *Notifier) notifyWebhook(ctx context.Context, notification Notification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.config.Webhook, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	start := time.Now()
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	utils.LogHTTPRequest(http.MethodPost, n.config.Webhook, resp.StatusCode, time.Since(start))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/utils"
+)
+
+// profileFile es el archivo dentro de ~/.antoine donde se guarda el perfil
+const profileFile = "profile.json"
+
+// ErrProfileNotFound indica que el usuario todavía no ha creado su perfil
+var ErrProfileNotFound = errors.New("profile not found: run 'antoine profile init'")
+
+// ExperienceLevels son los niveles aceptados por MentorValidation.ValidateExpertiseLevel
+var ExperienceLevels = []string{"beginner", "intermediate", "advanced"}
+
+// LoadProfile carga el perfil del usuario desde ~/.antoine/profile.json
+func LoadProfile() (*models.UserProfile, error) {
+	path, err := DataPath(profileFile)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &models.UserProfile{}
+	found, err := readJSONFile(path, profile)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrProfileNotFound
+	}
+
+	return profile, nil
+}
+
+// SaveProfile valida y guarda el perfil del usuario
+func SaveProfile(profile *models.UserProfile) error {
+	if err := ValidateProfile(profile); err != nil {
+		return err
+	}
+
+	path, err := DataPath(profileFile)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	if profile.CreatedAt.IsZero() {
+		profile.CreatedAt = now
+	}
+	profile.UpdatedAt = now
+
+	return writeJSONFile(path, profile)
+}
+
+// ValidateProfile comprueba el nivel de experiencia, la zona horaria y las preferencias
+func ValidateProfile(profile *models.UserProfile) error {
+	validation := utils.NewMentorValidation()
+	if profile.ExperienceLevel != "" {
+		validation.ValidateExpertiseLevel(profile.ExperienceLevel)
+	}
+
+	if profile.Timezone != "" {
+		if _, err := time.LoadLocation(profile.Timezone); err != nil {
+			validation.Add("timezone", profile.Timezone, "must be a valid IANA timezone (e.g. Europe/Madrid)", "invalid_timezone")
+		}
+	}
+
+	if profile.Travel.MaxDistance < 0 {
+		validation.Add("max_distance_km", fmt.Sprintf("%d", profile.Travel.MaxDistance), "cannot be negative", "invalid_distance")
+	}
+	if profile.Team.PreferredSize < 0 || profile.Team.PreferredSize > 10 {
+		validation.Add("team_size", fmt.Sprintf("%d", profile.Team.PreferredSize), "must be between 0 and 10", "invalid_team_size")
+	}
+
+	if validation.HasErrors() {
+		return validation.Errors()
+	}
+	return nil
+}
+
+// ProfileSearchFilters añade a filters los valores del perfil que no se hayan indicado
+func ProfileSearchFilters(profile *models.UserProfile, filters map[string]interface{}) map[string]interface{} {
+	if filters == nil {
+		filters = make(map[string]interface{})
+	}
+	if profile == nil {
+		return filters
+	}
+
+	if _, ok := filters["technologies"]; !ok && len(profile.Technologies) > 0 {
+		filters["technologies"] = profile.Technologies
+	}
+	if _, ok := filters["difficulty"]; !ok && profile.ExperienceLevel != "" {
+		filters["difficulty"] = profile.ExperienceLevel
+	}
+
+	// Sin disposición a viajar solo interesan eventos online o cercanos
+	_, hasLocation := filters["location"]
+	_, hasOnline := filters["online"]
+	switch {
+	case profile.Travel.OnlineOnly && !hasOnline && !hasLocation:
+		filters["online"] = true
+	case !profile.Travel.Willing && !hasLocation && profile.Location != "":
+		filters["location"] = profile.Location
+	}
+
+	return filters
+}
+
+// SplitList convierte una lista separada por comas en un slice sin vacíos
+func SplitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,218 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"antoine-cli/internal/models"
+)
+
+func testProfile() *models.UserProfile {
+	return &models.UserProfile{
+		Name:            "Ada",
+		Skills:          []string{"backend", "Go"},
+		Technologies:    []string{"Go", "PostgreSQL", "React"},
+		Interests:       []string{"climate", "fintech"},
+		ExperienceLevel: "intermediate",
+		Timezone:        "Europe/Madrid",
+		Location:        "Madrid, Spain",
+		Travel:          models.TravelPreference{Willing: true, MaxDistance: 800},
+		Team:            models.TeamPreference{PreferredSize: 3, Roles: []string{"backend"}, LookingForTeam: true},
+	}
+}
+
+func TestProfileRoundTrip(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+
+	if _, err := LoadProfile(); !errors.Is(err, ErrProfileNotFound) {
+		t.Fatalf("LoadProfile without a profile = %v, want ErrProfileNotFound", err)
+	}
+
+	saved := testProfile()
+	if err := SaveProfile(saved); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(home, ".antoine", "profile.json")); err != nil {
+		t.Fatalf("profile not written under HOME: %v", err)
+	}
+
+	loaded, err := LoadProfile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.CreatedAt.IsZero() || !loaded.CreatedAt.Equal(saved.CreatedAt) || !loaded.UpdatedAt.Equal(saved.UpdatedAt) {
+		t.Errorf("timestamps = %v / %v, want %v / %v", loaded.CreatedAt, loaded.UpdatedAt, saved.CreatedAt, saved.UpdatedAt)
+	}
+	want := testProfile()
+	want.CreatedAt, want.UpdatedAt = loaded.CreatedAt, loaded.UpdatedAt
+	if !reflect.DeepEqual(loaded, want) {
+		t.Errorf("loaded = %+v\nwant     %+v", loaded, want)
+	}
+
+	// Guardar de nuevo conserva la fecha de creación
+	created := loaded.CreatedAt
+	time.Sleep(time.Millisecond)
+	loaded.Interests = append(loaded.Interests, "health")
+	if err := SaveProfile(loaded); err != nil {
+		t.Fatal(err)
+	}
+	reloaded, err := LoadProfile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reloaded.CreatedAt.Equal(created) || !reloaded.UpdatedAt.After(created) || len(reloaded.Interests) != 3 {
+		t.Errorf("after update = %+v", reloaded)
+	}
+}
+
+func TestSaveProfileValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*models.UserProfile)
+	}{
+		{"unknown level", func(p *models.UserProfile) { p.ExperienceLevel = "wizard" }},
+		{"invalid timezone", func(p *models.UserProfile) { p.Timezone = "Mars/Olympus" }},
+		{"negative distance", func(p *models.UserProfile) { p.Travel.MaxDistance = -1 }},
+		{"team too large", func(p *models.UserProfile) { p.Team.PreferredSize = 11 }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("HOME", t.TempDir())
+			profile := testProfile()
+			tt.modify(profile)
+			if err := SaveProfile(profile); err == nil {
+				t.Fatal("SaveProfile accepted an invalid profile")
+			}
+			if _, err := LoadProfile(); !errors.Is(err, ErrProfileNotFound) {
+				t.Errorf("invalid profile was written: %v", err)
+			}
+		})
+	}
+}
+
+func TestProfileSearchFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		profile *models.UserProfile
+		filters map[string]interface{}
+		want    map[string]interface{}
+	}{
+		{
+			name:    "no profile",
+			profile: nil,
+			want:    map[string]interface{}{},
+		},
+		{
+			name:    "fills technologies, difficulty and location",
+			profile: &models.UserProfile{Technologies: []string{"Go"}, ExperienceLevel: "beginner", Location: "Lisbon"},
+			want:    map[string]interface{}{"technologies": []string{"Go"}, "difficulty": "beginner", "location": "Lisbon"},
+		},
+		{
+			name:    "flags win over the profile",
+			profile: &models.UserProfile{Technologies: []string{"Go"}, ExperienceLevel: "beginner", Location: "Lisbon"},
+			filters: map[string]interface{}{"technologies": []string{"Rust"}, "location": "Porto"},
+			want:    map[string]interface{}{"technologies": []string{"Rust"}, "difficulty": "beginner", "location": "Porto"},
+		},
+		{
+			name:    "online only",
+			profile: &models.UserProfile{Location: "Lisbon", Travel: models.TravelPreference{OnlineOnly: true}},
+			want:    map[string]interface{}{"online": true},
+		},
+		{
+			name:    "willing to travel adds no location",
+			profile: &models.UserProfile{Location: "Lisbon", Travel: models.TravelPreference{Willing: true}},
+			want:    map[string]interface{}{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ProfileSearchFilters(tt.profile, tt.filters); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("filters = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchTerms(t *testing.T) {
+	tests := []struct {
+		name   string
+		mine   []string
+		theirs []string
+		want   []string
+	}{
+		{"case insensitive", []string{"python"}, []string{"Python"}, []string{"python"}},
+		{"contained term", []string{"React"}, []string{"React Native"}, []string{"React"}},
+		{"containing term", []string{"TypeScript 5"}, []string{"typescript"}, []string{"TypeScript 5"}},
+		{"short terms only match exactly", []string{"Go", "C"}, []string{"Google Cloud", "CSS"}, nil},
+		{"short exact match", []string{"Go"}, []string{"go"}, []string{"Go"}},
+		{"blank terms ignored", []string{" ", "Rust"}, []string{"rust"}, []string{"Rust"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := matchTerms(tt.mine, tt.theirs); fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("matchTerms(%v, %v) = %v, want %v", tt.mine, tt.theirs, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecommendHackathonsForSavedProfile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	if err := SaveProfile(testProfile()); err != nil {
+		t.Fatal(err)
+	}
+	profile, err := LoadProfile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	hackathons := []*models.Hackathon{
+		{
+			Name: "Far and unrelated", StartDate: now.AddDate(0, 0, 20), Difficulty: "advanced",
+			Technologies: []string{"Swift"}, Themes: []string{"gaming"},
+			Location: models.Location{Type: "in-person", City: "Tokyo", Country: "Japan", Timezone: "Asia/Tokyo"},
+		},
+		{
+			Name: "Climate Go Jam", StartDate: now.AddDate(0, 0, 20), Difficulty: "intermediate",
+			Technologies: []string{"Go", "React"}, Themes: []string{"Climate tech", "Fintech"},
+			Location: models.Location{Type: "in-person", City: "Madrid", Country: "Spain", Timezone: "Europe/Madrid"},
+			TeamSize: models.TeamSizeInfo{Min: 2, Max: 4},
+		},
+		{
+			Name: "Finished", StartDate: now.AddDate(0, 0, -10), EndDate: now.AddDate(0, 0, -8),
+			Technologies: []string{"Go"},
+		},
+	}
+
+	// Sin el factor premio, que depende de la tabla de cambios
+	weights, err := ParseRecommendationWeights(nil, "prize=0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	recommendations := RecommendHackathons(profile, hackathons, weights, false, now)
+	if len(recommendations) != 2 {
+		t.Fatalf("recommendations = %d, want the finished hackathon skipped", len(recommendations))
+	}
+	best := recommendations[0]
+	if best.Hackathon.Name != "Climate Go Jam" || best.Score <= recommendations[1].Score {
+		t.Errorf("ranking = %s (%.1f), %s (%.1f)", best.Hackathon.Name, best.Score, recommendations[1].Hackathon.Name, recommendations[1].Score)
+	}
+	for _, factor := range best.Factors {
+		if factor.Name == FactorPrize {
+			t.Error("prize factor scored with a zero weight")
+		}
+		if factor.Score != 1 {
+			t.Errorf("%s = %.2f (%s), want a full match", factor.Name, factor.Score, factor.Reason)
+		}
+	}
+}
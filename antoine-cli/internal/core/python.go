@@ -0,0 +1,314 @@
+package core
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+var pythonSyntax = lexerSyntax{
+	lineComments:   []string{"#"},
+	tripleQuotes:   true,
+	stringPrefixes: "rRbBfFuU",
+}
+
+// pyLine es una línea lógica: las líneas físicas unidas por paréntesis abiertos o \
+type pyLine struct {
+	tokens  []sourceToken
+	indent  int
+	endLine int
+}
+
+// pyBlock es una sentencia compuesta cuyo cuerpo son las líneas con más sangría
+type pyBlock struct {
+	indent   int
+	kind     braceFrameKind
+	function int
+	name     string
+	match    bool
+}
+
+// pythonAnalyzer analiza Python sobre los tokens y la sangría: def, class, docstrings
+// (que cuentan como documentación) y la complejidad de cada función
+type pythonAnalyzer struct{}
+
+func (pythonAnalyzer) Analyze(file SourceFile, content []byte) (FileMetrics, error) {
+	tokens := tokenize(content, pythonSyntax)
+	p := &pythonWalker{file: file}
+	lines := pythonLogicalLines(content, significantTokens(tokens))
+
+	docstring := true // la primera sentencia del módulo, clase o función
+	for _, line := range lines {
+		p.close(line.indent)
+		if docstring && len(line.tokens) == 1 && line.tokens[0].kind == tokenString {
+			p.docstrings = append(p.docstrings, line.tokens[0])
+		} else {
+			docstring = p.statement(line)
+		}
+		p.lastLine = line.endLine
+	}
+	p.close(-1)
+
+	classes := classifyLines(content, tokens)
+	for _, doc := range p.docstrings {
+		for n := doc.line; n <= doc.endLine && n <= classes.total; n++ {
+			classes.code[n], classes.comment[n] = false, true
+		}
+	}
+
+	var metrics FileMetrics
+	classes.apply(&metrics)
+	metrics.Classes = p.classes
+	metrics.Test = isPythonTest(tokens)
+	summarizeFunctions(&metrics, p.functions)
+	return metrics, nil
+}
+
+// pythonLogicalLines agrupa los tokens en líneas lógicas con la sangría de su primera línea física
+func pythonLogicalLines(content []byte, tokens []sourceToken) []pyLine {
+	physical := strings.Split(string(content), "\n")
+	var lines []pyLine
+	depth := 0
+	continued := false
+	for i, token := range tokens {
+		if token.text == "\\" && token.kind == tokenPunct {
+			continued = true
+			continue
+		}
+		if len(lines) == 0 || (depth == 0 && !continued && token.line > tokens[i-1].endLine) {
+			indent := 0
+			if token.line-1 < len(physical) {
+				indent = indentWidth(physical[token.line-1])
+			}
+			lines = append(lines, pyLine{indent: indent})
+		}
+		continued = false
+
+		last := &lines[len(lines)-1]
+		last.tokens = append(last.tokens, token)
+		last.endLine = token.endLine
+		if token.kind == tokenPunct {
+			switch token.text {
+			case "(", "[", "{":
+				depth++
+			case ")", "]", "}":
+				depth = max(0, depth-1)
+			}
+		}
+	}
+	return lines
+}
+
+// indentWidth mide la sangría; un tabulador llega al siguiente múltiplo de 8
+func indentWidth(line string) int {
+	width := 0
+	for _, c := range line {
+		switch c {
+		case ' ':
+			width++
+		case '\t':
+			width += 8 - width%8
+		default:
+			return width
+		}
+	}
+	return width
+}
+
+type pythonWalker struct {
+	file       SourceFile
+	blocks     []pyBlock
+	functions  []FunctionComplexity
+	names      []string
+	classes    int
+	docstrings []sourceToken
+	lastLine   int
+}
+
+// close cierra los bloques cuyo cuerpo termina antes de una línea con esta sangría
+func (p *pythonWalker) close(indent int) {
+	for len(p.blocks) > 0 && p.blocks[len(p.blocks)-1].indent >= indent {
+		block := p.blocks[len(p.blocks)-1]
+		p.blocks = p.blocks[:len(p.blocks)-1]
+		if block.kind == frameFunction {
+			function := &p.functions[block.function]
+			function.Lines = p.lastLine - function.Line + 1
+		}
+	}
+}
+
+// current devuelve la función más interna (-1 fuera de funciones) y el anidamiento dentro de ella
+func (p *pythonWalker) current() (int, int) {
+	nesting := 0
+	for i := len(p.blocks) - 1; i >= 0; i-- {
+		switch p.blocks[i].kind {
+		case frameFunction:
+			return p.blocks[i].function, nesting
+		case frameControl:
+			nesting++
+		}
+	}
+	return -1, nesting
+}
+
+func (p *pythonWalker) add(cyclomatic, cognitive int, nested bool) {
+	function, nesting := p.current()
+	if function < 0 {
+		return
+	}
+	p.functions[function].Cyclomatic += cyclomatic
+	if nested && cognitive > 0 {
+		cognitive += nesting
+	}
+	p.functions[function].Cognitive += cognitive
+}
+
+func (p *pythonWalker) push(line pyLine, kind braceFrameKind) {
+	p.blocks = append(p.blocks, pyBlock{indent: line.indent, kind: kind, function: -1})
+}
+
+// statement procesa una línea lógica; devuelve true si la siguiente puede ser un docstring
+func (p *pythonWalker) statement(line pyLine) bool {
+	tokens := line.tokens
+	keyword, start := tokens[0].text, 1
+	if keyword == "async" && len(tokens) > 1 {
+		keyword, start = tokens[1].text, 2
+	}
+	header := containsToken(tokens, ":")
+
+	switch keyword {
+	case "@":
+		return false
+	case "def":
+		if len(tokens) <= start {
+			break
+		}
+		name := tokens[start].text
+		qualified := name
+		if n := len(p.blocks); n > 0 && p.blocks[n-1].kind == frameClass {
+			qualified = p.blocks[n-1].name + "." + name
+		}
+		p.functions = append(p.functions, FunctionComplexity{
+			Name: qualified, File: filepath.ToSlash(p.file.Path), Line: tokens[0].line, Lines: 1, Cyclomatic: 1,
+		})
+		p.names = append(p.names, name)
+		p.blocks = append(p.blocks, pyBlock{indent: line.indent, kind: frameFunction, function: len(p.functions) - 1})
+		return true
+	case "class":
+		if len(tokens) <= start {
+			break
+		}
+		p.classes++
+		p.blocks = append(p.blocks, pyBlock{indent: line.indent, kind: frameClass, function: -1, name: tokens[start].text})
+		return true
+	case "if", "while", "for", "except":
+		if header {
+			p.add(1, 1, true)
+			p.push(line, frameControl)
+		}
+	case "elif":
+		p.add(1, 1, false)
+		p.push(line, frameControl)
+	case "else":
+		p.add(0, 1, false)
+		p.push(line, frameControl)
+	case "match":
+		// match es palabra clave solo al principio de una sentencia compuesta
+		if header && len(tokens) > 2 && tokens[1].text != "=" && tokens[1].text != "(" && tokens[1].text != "." {
+			p.add(0, 1, true)
+			p.push(line, frameControl)
+			p.blocks[len(p.blocks)-1].match = true
+		} else {
+			start = 0
+		}
+	case "case":
+		if n := len(p.blocks); n > 0 && p.blocks[n-1].match && header {
+			if len(tokens) < 2 || tokens[1].text != "_" {
+				p.add(1, 0, false)
+			}
+			p.push(line, frameBlock)
+		} else {
+			start = 0
+		}
+	case "try", "finally", "with":
+		if header {
+			p.push(line, frameBlock)
+		}
+	default:
+		start = 0
+	}
+
+	p.expression(tokens[start:])
+	return false
+}
+
+// expression cuenta los operadores lógicos, los if/for de expresiones y comprensiones y la recursión
+func (p *pythonWalker) expression(tokens []sourceToken) {
+	function, _ := p.current()
+	lastLogical := ""
+	for i, token := range tokens {
+		if token.kind != tokenIdent {
+			if token.text == "," || token.text == ":" {
+				lastLogical = ""
+			}
+			continue
+		}
+		switch token.text {
+		case "and", "or":
+			cognitive := 0
+			if token.text != lastLogical {
+				cognitive = 1
+			}
+			p.add(1, cognitive, false)
+			lastLogical = token.text
+		case "if":
+			p.add(1, 1, true)
+		case "for":
+			p.add(1, 0, false)
+		default:
+			if function < 0 || token.text != p.names[function] || i+1 >= len(tokens) || tokens[i+1].text != "(" {
+				continue
+			}
+			// name(), self.name() o cls.name()
+			if i == 0 || tokens[i-1].text != "." || (i >= 2 && (tokens[i-2].text == "self" || tokens[i-2].text == "cls")) {
+				p.add(0, 1, false)
+			}
+		}
+	}
+}
+
+func containsToken(tokens []sourceToken, text string) bool {
+	depth := 0
+	for _, token := range tokens {
+		switch token.text {
+		case "(", "[", "{":
+			depth++
+		case ")", "]", "}":
+			depth--
+		case text:
+			if depth == 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isPythonTest reconoce los tests por el contenido: import de pytest o unittest y funciones test_
+func isPythonTest(tokens []sourceToken) bool {
+	imports, tests := false, false
+	for i, token := range tokens {
+		if token.kind != tokenIdent || i+1 >= len(tokens) {
+			continue
+		}
+		next := tokens[i+1]
+		switch {
+		case (token.text == "import" || token.text == "from") && (next.text == "pytest" || next.text == "unittest" || next.text == "hypothesis"):
+			imports = true
+		case token.text == "def" && strings.HasPrefix(next.text, "test"):
+			tests = true
+		case token.text == "TestCase":
+			tests = true
+		}
+	}
+	return imports && tests
+}
@@ -0,0 +1,434 @@
+package core
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"antoine-cli/internal/models"
+)
+
+// Factores que puntúan un hackathon frente al perfil del usuario
+const (
+	FactorTechnology = "technology"
+	FactorTheme      = "theme"
+	FactorDates      = "dates"
+	FactorLocation   = "location"
+	FactorTimezone   = "timezone"
+	FactorTeam       = "team"
+	FactorDifficulty = "difficulty"
+	FactorPrize      = "prize"
+)
+
+// RecommendationFactors es el orden en el que se muestran los factores
+var RecommendationFactors = []string{
+	FactorTechnology, FactorTheme, FactorDates, FactorLocation,
+	FactorTimezone, FactorTeam, FactorDifficulty, FactorPrize,
+}
+
+// RecommendationWeights es el peso relativo de cada factor
+type RecommendationWeights map[string]float64
+
+// DefaultRecommendationWeights se usan para los factores sin peso configurado
+var DefaultRecommendationWeights = RecommendationWeights{
+	FactorTechnology: 0.25,
+	FactorTheme:      0.15,
+	FactorDates:      0.15,
+	FactorLocation:   0.15,
+	FactorTimezone:   0.05,
+	FactorTeam:       0.05,
+	FactorDifficulty: 0.10,
+	FactorPrize:      0.10,
+}
+
+// RecommendationFactor es la contribución de un factor a la puntuación final
+type RecommendationFactor struct {
+	Name   string  `json:"name"`
+	Weight float64 `json:"weight"` // peso normalizado (los pesos suman 1)
+	Score  float64 `json:"score"`  // 0-1
+	Reason string  `json:"reason"`
+}
+
+// HackathonRecommendation es un hackathon con su puntuación y la explicación por factor
+type HackathonRecommendation struct {
+	Hackathon *models.Hackathon      `json:"hackathon"`
+	Score     float64                `json:"score"` // 0-100
+	Factors   []RecommendationFactor `json:"factors"`
+}
+
+// ParseRecommendationWeights combina los pesos base con overrides "factor=peso,factor=peso"
+func ParseRecommendationWeights(base map[string]float64, overrides string) (RecommendationWeights, error) {
+	weights := make(RecommendationWeights, len(DefaultRecommendationWeights))
+	for factor, weight := range DefaultRecommendationWeights {
+		weights[factor] = weight
+	}
+	for factor, weight := range base {
+		weights[strings.ToLower(factor)] = weight
+	}
+
+	for _, pair := range SplitList(overrides) {
+		factor, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid weight %q, expected factor=weight", pair)
+		}
+		factor = strings.ToLower(strings.TrimSpace(factor))
+		weight, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid weight for %s: %q", factor, value)
+		}
+		weights[factor] = weight
+	}
+
+	total := 0.0
+	for factor, weight := range weights {
+		if _, ok := DefaultRecommendationWeights[factor]; !ok {
+			return nil, fmt.Errorf("unknown factor %q (valid: %s)", factor, strings.Join(RecommendationFactors, ", "))
+		}
+		if weight < 0 {
+			return nil, fmt.Errorf("weight for %s cannot be negative", factor)
+		}
+		total += weight
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("at least one factor needs a positive weight")
+	}
+
+	return weights, nil
+}
+
+// RecommendHackathons puntúa y ordena los hackathons para el perfil dado.
+// Los hackathons ya terminados se descartan salvo que includePast sea true.
+func RecommendHackathons(profile *models.UserProfile, hackathons []*models.Hackathon, weights RecommendationWeights, includePast bool, now time.Time) []*HackathonRecommendation {
+	total := 0.0
+	for _, weight := range weights {
+		total += weight
+	}
+
+	var recommendations []*HackathonRecommendation
+	for _, hackathon := range hackathons {
+		if !includePast && hackathonFinished(hackathon, now) {
+			continue
+		}
+
+		recommendation := &HackathonRecommendation{Hackathon: hackathon}
+		for _, name := range RecommendationFactors {
+			weight := weights[name] / total
+			if weight == 0 {
+				continue
+			}
+
+			score, reason := scoreFactor(name, profile, hackathon, now)
+			recommendation.Factors = append(recommendation.Factors, RecommendationFactor{
+				Name:   name,
+				Weight: weight,
+				Score:  score,
+				Reason: reason,
+			})
+			recommendation.Score += weight * score * 100
+		}
+		recommendations = append(recommendations, recommendation)
+	}
+
+	sort.SliceStable(recommendations, func(i, j int) bool {
+		if recommendations[i].Score != recommendations[j].Score {
+			return recommendations[i].Score > recommendations[j].Score
+		}
+		return recommendations[i].Hackathon.StartDate.Before(recommendations[j].Hackathon.StartDate)
+	})
+
+	return recommendations
+}
+
+func scoreFactor(name string, profile *models.UserProfile, hackathon *models.Hackathon, now time.Time) (float64, string) {
+	switch name {
+	case FactorTechnology:
+		return scoreTechnology(profile, hackathon)
+	case FactorTheme:
+		return scoreTheme(profile, hackathon)
+	case FactorDates:
+		return scoreDates(hackathon, now)
+	case FactorLocation:
+		return scoreLocation(profile, hackathon)
+	case FactorTimezone:
+		return scoreTimezone(profile, hackathon, now)
+	case FactorTeam:
+		return scoreTeam(profile, hackathon)
+	case FactorDifficulty:
+		return scoreDifficulty(profile, hackathon)
+	case FactorPrize:
+		return scorePrize(hackathon)
+	}
+	return 0, ""
+}
+
+func hackathonFinished(hackathon *models.Hackathon, now time.Time) bool {
+	if strings.EqualFold(hackathon.Status, "completed") {
+		return true
+	}
+	end := hackathon.EndDate
+	if end.IsZero() {
+		end = hackathon.StartDate
+	}
+	return !end.IsZero() && end.Before(now)
+}
+
+// scoreTechnology mide cuántas de las tecnologías y skills del usuario pide el hackathon
+func scoreTechnology(profile *models.UserProfile, hackathon *models.Hackathon) (float64, string) {
+	// Una tecnología que figura también como skill cuenta una sola vez
+	mine := uniqueTerms(append(append([]string{}, profile.Technologies...), profile.Skills...))
+	theirs := append(append([]string{}, hackathon.Technologies...), hackathon.Tags...)
+
+	if len(mine) == 0 {
+		return 0.5, "no technologies in your profile"
+	}
+	if len(theirs) == 0 {
+		return 0.5, "no technology requirements listed"
+	}
+
+	matched := matchTerms(mine, theirs)
+	if len(matched) == 0 {
+		return 0, "none of your technologies are listed"
+	}
+
+	// Basta con cubrir la mitad de lo que usa el usuario para puntuar al máximo
+	coverage := float64(len(matched)) / math.Max(1, math.Min(float64(len(mine)), float64(len(theirs)))/2)
+	return math.Min(1, coverage), "matches " + strings.Join(matched, ", ")
+}
+
+// scoreTheme compara los intereses del usuario con temas, categorías y tags
+func scoreTheme(profile *models.UserProfile, hackathon *models.Hackathon) (float64, string) {
+	themes := append(append(append([]string{}, hackathon.Themes...), hackathon.Categories...), hackathon.Tags...)
+
+	if len(profile.Interests) == 0 {
+		return 0.5, "no interests in your profile"
+	}
+	if len(themes) == 0 {
+		return 0.5, "no themes listed"
+	}
+
+	matched := matchTerms(profile.Interests, themes)
+	if len(matched) == 0 {
+		return 0.1, "themes: " + strings.Join(themes[:min(3, len(themes))], ", ")
+	}
+	return math.Min(1, 0.6+0.2*float64(len(matched))), "your interests: " + strings.Join(matched, ", ")
+}
+
+// scoreDates favorece los hackathons que empiezan pronto pero dan tiempo a prepararse
+func scoreDates(hackathon *models.Hackathon, now time.Time) (float64, string) {
+	if hackathon.StartDate.IsZero() {
+		return 0.3, "dates not announced"
+	}
+
+	days := hackathon.StartDate.Sub(now).Hours() / 24
+	switch {
+	case days < 0:
+		if hackathonFinished(hackathon, now) {
+			return 0, fmt.Sprintf("ended %s", hackathon.EndDate.Format("2006-01-02"))
+		}
+		return 0.5, "already running"
+	case days < 3:
+		return 0.7, fmt.Sprintf("starts in %.0f days, little time to prepare", math.Ceil(days))
+	case days <= 60:
+		return 1, fmt.Sprintf("starts in %.0f days", math.Ceil(days))
+	default:
+		return math.Max(0.3, 1-(days-60)/180), fmt.Sprintf("starts in %.0f days", math.Ceil(days))
+	}
+}
+
+// scoreLocation tiene en cuenta el formato del evento y las preferencias de viaje
+func scoreLocation(profile *models.UserProfile, hackathon *models.Hackathon) (float64, string) {
+	kind := strings.ToLower(hackathon.Location.Type)
+	where := strings.Trim(strings.Join([]string{hackathon.Location.City, hackathon.Location.Country}, ", "), ", ")
+
+	switch kind {
+	case "online":
+		return 1, "online"
+	case "hybrid":
+		return 0.9, "hybrid, can join online"
+	}
+
+	if profile.Travel.OnlineOnly {
+		return 0, fmt.Sprintf("in person in %s, you prefer online events", where)
+	}
+	if profile.Location == "" || where == "" {
+		return 0.5, "in person"
+	}
+
+	mine := strings.ToLower(profile.Location)
+	switch {
+	case hackathon.Location.City != "" && strings.Contains(mine, strings.ToLower(hackathon.Location.City)):
+		return 1, "in your city (" + where + ")"
+	case hackathon.Location.Country != "" && strings.Contains(mine, strings.ToLower(hackathon.Location.Country)):
+		if profile.Travel.Willing {
+			return 0.8, "in your country (" + where + ")"
+		}
+		return 0.5, "in your country (" + where + "), you prefer not to travel"
+	case profile.Travel.Willing:
+		return 0.4, "requires travel to " + where
+	default:
+		return 0.1, "requires travel to " + where + ", you prefer not to travel"
+	}
+}
+
+// scoreTimezone penaliza la diferencia horaria con el evento
+func scoreTimezone(profile *models.UserProfile, hackathon *models.Hackathon, now time.Time) (float64, string) {
+	if profile.Timezone == "" || hackathon.Location.Timezone == "" {
+		return 0.5, "timezone unknown"
+	}
+
+	mine, err := time.LoadLocation(profile.Timezone)
+	if err != nil {
+		return 0.5, "timezone unknown"
+	}
+	theirs, err := time.LoadLocation(hackathon.Location.Timezone)
+	if err != nil {
+		return 0.5, "timezone unknown"
+	}
+
+	at := hackathon.StartDate
+	if at.IsZero() {
+		at = now
+	}
+	_, myOffset := at.In(mine).Zone()
+	_, theirOffset := at.In(theirs).Zone()
+	hours := math.Abs(float64(myOffset-theirOffset)) / 3600
+	if hours > 12 {
+		hours = 24 - hours
+	}
+
+	if hours <= 2 {
+		return 1, fmt.Sprintf("%.0fh from your timezone", hours)
+	}
+	return math.Max(0, 1-(hours-2)/10), fmt.Sprintf("%.0fh from your timezone", hours)
+}
+
+// scoreTeam compara el tamaño de equipo preferido con los límites del hackathon
+func scoreTeam(profile *models.UserProfile, hackathon *models.Hackathon) (float64, string) {
+	size := profile.Team.PreferredSize
+	limits := hackathon.TeamSize
+
+	if limits.Min == 0 && limits.Max == 0 {
+		return 0.7, "team size not specified"
+	}
+	if size == 0 {
+		if profile.Team.LookingForTeam && limits.Min <= 1 {
+			return 0.9, fmt.Sprintf("teams of %s, solo sign-up allowed", teamRange(limits))
+		}
+		return 0.7, fmt.Sprintf("teams of %s", teamRange(limits))
+	}
+
+	switch {
+	case size < limits.Min:
+		return math.Max(0, 1-0.25*float64(limits.Min-size)), fmt.Sprintf("needs at least %d people, you prefer %d", limits.Min, size)
+	case limits.Max > 0 && size > limits.Max:
+		return math.Max(0, 1-0.25*float64(size-limits.Max)), fmt.Sprintf("at most %d people, you prefer %d", limits.Max, size)
+	default:
+		return 1, fmt.Sprintf("teams of %s fit your %d", teamRange(limits), size)
+	}
+}
+
+func teamRange(limits models.TeamSizeInfo) string {
+	switch {
+	case limits.Max == 0:
+		return fmt.Sprintf("%d+", limits.Min)
+	case limits.Min == limits.Max:
+		return strconv.Itoa(limits.Max)
+	default:
+		return fmt.Sprintf("%d-%d", limits.Min, limits.Max)
+	}
+}
+
+// scoreDifficulty compara la dificultad con el nivel de experiencia
+func scoreDifficulty(profile *models.UserProfile, hackathon *models.Hackathon) (float64, string) {
+	difficulty := strings.ToLower(hackathon.Difficulty)
+	if difficulty == "" {
+		return 0.6, "difficulty not specified"
+	}
+	if difficulty == "all" || difficulty == "any" {
+		return 1, "open to all levels"
+	}
+
+	mine := levelIndex(profile.ExperienceLevel)
+	theirs := levelIndex(difficulty)
+	if mine < 0 || theirs < 0 {
+		return 0.6, difficulty
+	}
+
+	switch gap := theirs - mine; {
+	case gap == 0:
+		return 1, difficulty + ", your level"
+	case gap == 1:
+		return 0.5, difficulty + ", a step above your level"
+	case gap == -1:
+		return 0.6, difficulty + ", below your level"
+	default:
+		return 0.1, difficulty + ", far from your level"
+	}
+}
+
+func levelIndex(level string) int {
+	for i, candidate := range ExperienceLevels {
+		if strings.EqualFold(candidate, level) {
+			return i
+		}
+	}
+	return -1
+}
+
+// scorePrize usa una escala logarítmica: $100K (o su equivalente) o más puntúa el máximo
+func scorePrize(hackathon *models.Hackathon) (float64, string) {
+	prize := hackathon.PrizePool
+	if prize.Total <= 0 {
+		if len(prize.NonMonetary) > 0 {
+			return 0.2, "non-monetary prizes: " + strings.Join(prize.NonMonetary[:min(2, len(prize.NonMonetary))], ", ")
+		}
+		return 0, "no prize listed"
+	}
+
+	// La escala es en USD aunque la moneda base sea otra
+	normalized := NewPrizeNormalizer(Prizes().table, "USD").Normalize(prize)
+	if !normalized.Known {
+		return 0.1, fmt.Sprintf("%d %s prize pool (unknown currency)", prize.Total, prize.Currency)
+	}
+	score := math.Min(1, math.Log10(normalized.Amount+1)/5)
+	return score, Prizes().Format(hackathon) + " prize pool"
+}
+
+// uniqueTerms quita vacíos y repetidos (sin distinguir mayúsculas) conservando el orden
+func uniqueTerms(terms []string) []string {
+	seen := make(map[string]bool, len(terms))
+	var unique []string
+	for _, term := range terms {
+		key := strings.ToLower(strings.TrimSpace(term))
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, strings.TrimSpace(term))
+	}
+	return unique
+}
+
+// matchTerms devuelve los términos de mine presentes en theirs (sin distinguir mayúsculas,
+// aceptando que uno contenga al otro: "React" encaja con "React Native")
+func matchTerms(mine, theirs []string) []string {
+	var matched []string
+	for _, term := range mine {
+		needle := strings.ToLower(strings.TrimSpace(term))
+		if needle == "" {
+			continue
+		}
+		for _, candidate := range theirs {
+			candidate = strings.ToLower(strings.TrimSpace(candidate))
+			if candidate == needle || (len(needle) > 2 && strings.Contains(candidate, needle)) ||
+				(len(candidate) > 2 && strings.Contains(needle, candidate)) {
+				matched = append(matched, term)
+				break
+			}
+		}
+	}
+	return matched
+}
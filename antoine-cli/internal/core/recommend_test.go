@@ -0,0 +1,31 @@
+package core
+
+import (
+	"testing"
+
+	"antoine-cli/internal/models"
+)
+
+func TestScoreTechnologyCountsTermsOnce(t *testing.T) {
+	tests := []struct {
+		name         string
+		technologies []string
+		skills       []string
+		theirs       []string
+		want         float64
+	}{
+		{"term in technologies and skills", []string{"Go", "Rust", "Python", "Java"}, []string{"go"}, []string{"Go", "Kotlin", "Swift", "Dart"}, 0.5},
+		{"distinct skill adds coverage", []string{"Go", "Rust", "Python", "Java"}, []string{"Kotlin"}, []string{"Go", "Kotlin", "Swift", "Dart"}, 1},
+		{"no match", []string{"Go"}, []string{"Go"}, []string{"Swift"}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &models.UserProfile{Technologies: tt.technologies, Skills: tt.skills}
+			hackathon := &models.Hackathon{Technologies: tt.theirs}
+			if got, reason := scoreTechnology(profile, hackathon); got != tt.want {
+				t.Errorf("score = %v (%s), want %v", got, reason, tt.want)
+			}
+		})
+	}
+}
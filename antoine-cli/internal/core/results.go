@@ -0,0 +1,70 @@
+package core
+
+import (
+	"encoding/json"
+
+	"antoine-cli/internal/models"
+)
+
+// RepositoryFromResult extrae los datos del repositorio que el análisis deja en
+// AnalysisResult.Results. Devuelve false si el resultado no los incluye.
+func RepositoryFromResult(result *models.AnalysisResult) (*models.Repository, bool) {
+	if result == nil || result.Results == nil {
+		return nil, false
+	}
+
+	var repo models.Repository
+	switch value := result.Results.(type) {
+	case *models.Repository:
+		return value, true
+	case models.Repository:
+		repo = value
+	default:
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, false
+		}
+		if err := json.Unmarshal(data, &repo); err != nil {
+			return nil, false
+		}
+	}
+
+	if repo.URL == "" && repo.Language == "" && repo.CodeQuality.Score == 0 && len(repo.Languages) == 0 {
+		return nil, false
+	}
+	return &repo, true
+}
+
+// ResultScore devuelve la puntuación global (0-100) de un análisis. Usa
+// CodeQuality.Score y, si falta, la media de las sub-puntuaciones disponibles.
+func ResultScore(result *models.AnalysisResult) (float64, bool) {
+	repo, ok := RepositoryFromResult(result)
+	if !ok {
+		return 0, false
+	}
+
+	quality := repo.CodeQuality
+	if quality.Score > 0 {
+		return quality.Score, true
+	}
+
+	total, count := 0.0, 0
+	for _, score := range []float64{
+		quality.Maintainability,
+		quality.Security.Score,
+		quality.Architecture.Score,
+		quality.Documentation,
+		quality.TestCoverage,
+		quality.TestRatio,
+	} {
+		if score > 0 {
+			total += score
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, false
+	}
+
+	return total / float64(count), true
+}
@@ -0,0 +1,138 @@
+package core
+
+var rustSyntax = lexerSyntax{
+	lineComments:     []string{"//"},
+	blockStart:       "/*",
+	blockEnd:         "*/",
+	nestedBlocks:     true,
+	multilineStrings: true,
+	stringPrefixes:   "br",
+	rawStrings:       true,
+	lifetimes:        true,
+}
+
+var rustControls = map[string]bool{
+	"if": true, "else": true, "for": true, "while": true, "loop": true, "match": true,
+}
+
+// rustAnalyzer analiza Rust sobre los tokens: fn, struct, enum, trait e impl, y las líneas
+// de los módulos #[cfg(test)] y las funciones #[test] como código de test
+type rustAnalyzer struct{}
+
+func (rustAnalyzer) Analyze(file SourceFile, content []byte) (FileMetrics, error) {
+	tokens := tokenize(content, rustSyntax)
+	w := newBraceWalker(file, significantTokens(tokens), rustControls, "match")
+	testFunction, testModule := false, false
+
+	for i := 0; i < len(w.tokens); i++ {
+		t := w.tokens[i]
+		if t.kind == tokenIdent && w.text(i-1) == "." {
+			w.visit(i) // campo o método con nombre de palabra clave
+			continue
+		}
+
+		switch {
+		case t.kind == tokenPunct && t.text == "#" && w.text(i+1) == "[":
+			end := w.matching(i + 1)
+			if end < 0 {
+				break
+			}
+			function, module := rustTestAttribute(w.tokens[i:end])
+			testFunction = testFunction || function
+			testModule = testModule || module
+			i = end
+			continue
+		case t.kind == tokenIdent && t.text == "fn" && w.isIdent(i+1):
+			w.expectFunction(i+1, w.text(i+1), testFunction)
+			testFunction = false
+		case t.kind == tokenIdent && t.text == "mod" && w.isIdent(i+1) && w.text(i+2) == "{":
+			w.testBlock = testModule
+			testModule = false
+		case t.kind == tokenIdent && (t.text == "struct" || t.text == "enum" || t.text == "union" || t.text == "trait") && w.isIdent(i+1):
+			w.classes++
+			if t.text == "trait" {
+				w.expectClass(w.text(i+1), frameClass)
+			}
+		case t.kind == tokenIdent && t.text == "impl" && w.parens == 0:
+			// La cabecera (genéricos, trait for Tipo, where) no se visita: su for no es un bucle
+			name, body := rustImplHeader(w, i)
+			if body < 0 {
+				break
+			}
+			w.expectClass(name, frameClass)
+			i = body - 1
+			continue
+		case t.kind == tokenPunct && t.text == "=>":
+			if w.topMatch() && w.text(i-1) != "_" {
+				w.add(1, 0, false)
+			}
+		case t.kind == tokenPunct && t.text == "||":
+			// || sin parámetros es un closure, no un or
+			switch w.text(i - 1) {
+			case "(", ",", "=", "move", "return":
+				continue
+			}
+			if w.text(i+1) == "{" {
+				continue
+			}
+		}
+		w.visit(i)
+	}
+
+	return w.metrics(classifyLines(content, tokens)), nil
+}
+
+// rustTestAttribute reconoce #[test], #[tokio::test] y similares, y #[cfg(test)]
+func rustTestAttribute(tokens []sourceToken) (function, module bool) {
+	var idents []string
+	for _, token := range tokens {
+		if token.kind == tokenIdent {
+			idents = append(idents, token.text)
+		}
+	}
+	if len(idents) == 0 {
+		return false, false
+	}
+	if idents[0] == "cfg" {
+		for _, ident := range idents[1:] {
+			if ident == "test" {
+				return false, true
+			}
+		}
+		return false, false
+	}
+	return idents[len(idents)-1] == "test", false
+}
+
+// rustImplHeader devuelve el tipo de impl [<T>] [Trait for] Tipo y el índice de la llave del cuerpo
+func rustImplHeader(w *braceWalker, i int) (string, int) {
+	name := ""
+	for j := i + 1; j < len(w.tokens); j++ {
+		switch text := w.text(j); {
+		case text == "{":
+			if name == "" {
+				name = "impl"
+			}
+			return name, j
+		case text == ";":
+			return "", -1
+		case text == "<":
+			if end := w.matching(j); end > 0 {
+				j = end
+			}
+		case text == "for": // impl Trait for Tipo: cuenta el tipo
+			name = ""
+		case text == "where":
+			if name == "" {
+				name = "impl"
+			}
+			for j < len(w.tokens) && w.text(j) != "{" && w.text(j) != ";" {
+				j++
+			}
+			j--
+		case w.isIdent(j) && text != "dyn" && text != "unsafe" && text != "const" && (name == "" || w.text(j-1) == "::"):
+			name = text
+		}
+	}
+	return "", -1
+}
@@ -0,0 +1,611 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"antoine-cli/internal/models"
+)
+
+// Formatos de SBOM soportados
+const (
+	SBOMCycloneDX = "cyclonedx-json" // CycloneDX 1.5
+	SBOMSPDX      = "spdx-json"      // SPDX 2.3
+)
+
+var SBOMFormats = []string{SBOMCycloneDX, SBOMSPDX}
+
+// SBOMOptions configura la generación del SBOM
+type SBOMOptions struct {
+	Format      string
+	ToolVersion string // versión de antoine que firma el documento
+}
+
+// sbomSource es lo que se sabe del proyecto: nombre, licencia y dependencias
+type sbomSource struct {
+	Name    string
+	License ProjectLicense
+	Deps    models.DependencyAnalysis
+	GoSums  map[int]string // hash h1 de go.sum por índice en Deps.Details
+}
+
+// GenerateSBOM construye el SBOM de un árbol local o de un repositorio de GitHub a partir de sus
+// manifiestos. Devuelve el documento listo para serializar como JSON y los manifiestos que no se
+// han podido leer, que dejan el SBOM incompleto.
+func (c *AntoineClient) GenerateSBOM(ctx context.Context, target string, options SBOMOptions) (interface{}, []string, error) {
+	if !containsString(SBOMFormats, options.Format) {
+		return nil, nil, fmt.Errorf("unsupported SBOM format %q (use %s)", options.Format, strings.Join(SBOMFormats, " or "))
+	}
+
+	var source sbomSource
+	var read dependencyFileReader
+	var warnings []string
+	if isRemoteTarget(target) {
+		source.Name = strings.TrimSuffix(strings.TrimPrefix(strings.TrimPrefix(target, "https://"), "github.com/"), ".git")
+		source.Deps, warnings = c.RemoteDependencies(ctx, target)
+		source.License, _ = c.RemoteProjectLicense(ctx, target)
+		read = func(name string) ([]byte, error) {
+			content, err := c.mcp.github.ReadFile(ctx, target, name)
+			return []byte(content), err
+		}
+	} else {
+		root, err := filepath.Abs(target)
+		if err != nil {
+			return nil, nil, err
+		}
+		if info, err := os.Stat(root); err != nil || !info.IsDir() {
+			return nil, nil, fmt.Errorf("%s is not a directory", target)
+		}
+		source.Name = filepath.Base(root)
+		source.Deps, warnings = LocalDependencies(root)
+		source.License = DetectProjectLicense(root)
+		read = func(name string) ([]byte, error) {
+			return os.ReadFile(filepath.Join(root, filepath.FromSlash(name)))
+		}
+	}
+	if source.Deps.Total == 0 {
+		if len(warnings) > 0 {
+			return nil, warnings, fmt.Errorf("no dependencies found in %s: %s", target, strings.Join(warnings, "; "))
+		}
+		return nil, nil, fmt.Errorf("no dependencies found in %s: no supported manifest (go.mod, package.json, requirements.txt, pyproject.toml, Cargo.toml, pom.xml)", target)
+	}
+	source.GoSums = goSums(source.Deps.Details, read)
+
+	if options.Format == SBOMSPDX {
+		return buildSPDX(source, options.ToolVersion), warnings, nil
+	}
+	return buildCycloneDX(source, options.ToolVersion), warnings, nil
+}
+
+// isRemoteTarget distingue una URL de repositorio de una ruta local
+func isRemoteTarget(target string) bool {
+	return strings.Contains(target, "://") || strings.HasPrefix(target, "github.com/")
+}
+
+// goSums busca el hash h1 de cada módulo de Go en el go.sum junto a su go.mod
+func goSums(details []models.DependencyDetail, read dependencyFileReader) map[int]string {
+	hashes := make(map[int]string)
+	sums := make(map[string]map[string]string)
+	for i, dep := range details {
+		if dep.Ecosystem != EcosystemGo || dep.Manifest == "" {
+			continue
+		}
+		dir := path.Dir(dep.Manifest)
+		if _, ok := sums[dir]; !ok {
+			content, err := read(path.Join(dir, "go.sum"))
+			if err != nil {
+				content = nil
+			}
+			sums[dir] = parseGoSum(content)
+		}
+		if hash, ok := sums[dir][dep.Name+"@"+dep.Version]; ok {
+			hashes[i] = hash
+		}
+	}
+	return hashes
+}
+
+// parseGoSum lee los hashes h1 de go.sum; las líneas /go.mod son el hash del go.mod y no del
+// módulo. h1 es un sha256 sobre la lista de archivos del módulo, no sobre un archivo que se pueda
+// descargar, así que no se publica como hash SHA-256 sino tal cual (go:h1).
+func parseGoSum(content []byte) map[string]string {
+	sums := make(map[string]string)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 3 || strings.HasSuffix(fields[1], "/go.mod") || !strings.HasPrefix(fields[2], "h1:") {
+			continue
+		}
+		sums[fields[0]+"@"+fields[1]] = fields[2]
+	}
+	return sums
+}
+
+// dependencyID identifica una dependencia como la nombran los Requires del lockfile
+func dependencyID(ecosystem, nameVersion string) string {
+	return ecosystem + "|" + nameVersion
+}
+
+// lockedGraph indica si el lockfile de la dependencia registra sus propias dependencias
+func lockedGraph(dep models.DependencyDetail) bool {
+	return lockfiles[path.Base(dep.Manifest)]
+}
+
+// sbomHash es un hash en hexadecimal; el algoritmo va en minúsculas y sin guion (sha256)
+type sbomHash struct {
+	Algorithm string
+	Hex       string
+}
+
+// checksumHashes decodifica el Checksum SRI de una dependencia; admite varios separados por espacios
+func checksumHashes(checksum string) []sbomHash {
+	var hashes []sbomHash
+	for _, part := range strings.Fields(checksum) {
+		algorithm, value, ok := strings.Cut(part, "-")
+		if !ok {
+			continue
+		}
+		sum, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			continue
+		}
+		expected := map[string]int{"sha1": 20, "sha256": 32, "sha384": 48, "sha512": 64}[algorithm]
+		if expected == 0 || len(sum) != expected {
+			continue
+		}
+		hashes = append(hashes, sbomHash{Algorithm: algorithm, Hex: hex.EncodeToString(sum)})
+	}
+	return hashes
+}
+
+// PackageURL construye el purl de una dependencia; sin versión exacta la omite
+func PackageURL(dep models.DependencyDetail) string {
+	var purlType, namespace, name string
+	switch dep.Ecosystem {
+	case EcosystemGo:
+		purlType = "golang"
+		if i := strings.LastIndex(dep.Name, "/"); i > 0 {
+			namespace, name = dep.Name[:i], dep.Name[i+1:]
+		} else {
+			name = dep.Name
+		}
+	case EcosystemNpm:
+		purlType = "npm"
+		if scope, pkg, ok := strings.Cut(dep.Name, "/"); ok && strings.HasPrefix(scope, "@") {
+			namespace, name = scope, pkg
+		} else {
+			name = dep.Name
+		}
+	case EcosystemPyPI:
+		purlType, name = "pypi", normalizePythonName(dep.Name)
+	case EcosystemCargo:
+		purlType, name = "cargo", dep.Name
+	case EcosystemMaven:
+		purlType = "maven"
+		namespace, name, _ = strings.Cut(dep.Name, ":")
+	default:
+		return ""
+	}
+
+	var purl strings.Builder
+	purl.WriteString("pkg:" + purlType + "/")
+	if namespace != "" {
+		for _, segment := range strings.Split(namespace, "/") {
+			purl.WriteString(purlEscape(segment) + "/")
+		}
+	}
+	purl.WriteString(purlEscape(name))
+	if version, ok := exactVersion(dep); ok {
+		purl.WriteString("@" + purlEscape(version))
+	}
+	return purl.String()
+}
+
+// exactVersion devuelve la versión exacta de la dependencia. En Cargo.toml "1.2" es un rango
+// (^1.2); solo Cargo.lock o "=1.2" fijan la versión.
+func exactVersion(dep models.DependencyDetail) (string, bool) {
+	if dep.Ecosystem == EcosystemCargo && !lockfiles[path.Base(dep.Manifest)] && !strings.HasPrefix(dep.Version, "=") {
+		return "", false
+	}
+	return PinnedVersion(dep.Version)
+}
+
+// purlEscape codifica un segmento del purl; "@" también, porque separa la versión
+func purlEscape(segment string) string {
+	return strings.ReplaceAll(url.PathEscape(segment), "@", "%40")
+}
+
+// sbomLicense es la licencia de una dependencia tal como la piden los formatos de SBOM
+type sbomLicense struct {
+	Expression string            // expresión SPDX válida; "" si la licencia es desconocida
+	Single     bool              // un único identificador SPDX, sin operadores ni LicenseRef
+	Refs       map[string]string // LicenseRef-* usados, con el texto original
+}
+
+// spdxIDInvalid son los caracteres no permitidos en SPDXRef-* y LicenseRef-*
+var spdxIDInvalid = regexp.MustCompile(`[^A-Za-z0-9.-]+`)
+
+// spdxLicenseOf convierte la licencia de una dependencia en una expresión SPDX válida. Lo que no es
+// un identificador SPDX se declara como LicenseRef-<texto>.
+func spdxLicenseOf(raw string) sbomLicense {
+	license := NormalizeLicense(raw)
+	if license == "" || license == UnknownLicense {
+		return sbomLicense{}
+	}
+	result := sbomLicense{Refs: make(map[string]string)}
+	ref := func(text string) string {
+		id := "LicenseRef-" + strings.Trim(spdxIDInvalid.ReplaceAllString(text, "-"), "-")
+		result.Refs[id] = text
+		return id
+	}
+
+	tokens := licenseTokens.FindAllString(license, -1)
+	valid, ids := true, 0
+	for i, token := range tokens {
+		switch token {
+		case "(", ")", "AND", "OR", "WITH":
+			continue
+		}
+		if i > 0 && tokens[i-1] == "WITH" {
+			continue
+		}
+		ids++
+		switch id, known := normalizeLicenseID(token); {
+		case strings.HasPrefix(id, "LicenseRef-"):
+			result.Refs[id] = strings.TrimPrefix(id, "LicenseRef-")
+		case id == "UNLICENSED":
+			tokens[i] = ref(id)
+		case !known:
+			valid = false
+		}
+	}
+	if !valid {
+		result.Expression = ref(raw)
+		return result
+	}
+	result.Expression = strings.ReplaceAll(strings.ReplaceAll(strings.Join(tokens, " "), "( ", "("), " )", ")")
+	result.Single = ids == 1 && len(tokens) == 1 && len(result.Refs) == 0
+	return result
+}
+
+// newUUID genera un UUID versión 4 para identificar el documento
+func newUUID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// --- CycloneDX 1.5 ---
+
+type CycloneDXBOM struct {
+	BOMFormat    string                `json:"bomFormat"`
+	SpecVersion  string                `json:"specVersion"`
+	SerialNumber string                `json:"serialNumber"`
+	Version      int                   `json:"version"`
+	Metadata     CycloneDXMetadata     `json:"metadata"`
+	Components   []CycloneDXComponent  `json:"components"`
+	Dependencies []CycloneDXDependency `json:"dependencies"`
+}
+
+type CycloneDXMetadata struct {
+	Timestamp string `json:"timestamp"`
+	Tools     struct {
+		Components []CycloneDXComponent `json:"components"`
+	} `json:"tools"`
+	Component CycloneDXComponent `json:"component"`
+}
+
+type CycloneDXComponent struct {
+	Type       string              `json:"type"`
+	BOMRef     string              `json:"bom-ref,omitempty"`
+	Group      string              `json:"group,omitempty"`
+	Name       string              `json:"name"`
+	Version    string              `json:"version,omitempty"`
+	Scope      string              `json:"scope,omitempty"`
+	Hashes     []CycloneDXHash     `json:"hashes,omitempty"`
+	Licenses   []CycloneDXLicense  `json:"licenses,omitempty"`
+	PURL       string              `json:"purl,omitempty"`
+	Properties []CycloneDXProperty `json:"properties,omitempty"`
+}
+
+type CycloneDXHash struct {
+	Alg     string `json:"alg"`
+	Content string `json:"content"`
+}
+
+// CycloneDXLicense lleva una licencia (id o name) o una expresión, nunca ambas
+type CycloneDXLicense struct {
+	License    *CycloneDXLicenseChoice `json:"license,omitempty"`
+	Expression string                  `json:"expression,omitempty"`
+}
+
+type CycloneDXLicenseChoice struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type CycloneDXProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type CycloneDXDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+var cycloneDXAlgorithms = map[string]string{"sha1": "SHA-1", "sha256": "SHA-256", "sha384": "SHA-384", "sha512": "SHA-512"}
+
+// cycloneDXLicenses usa id para un identificador SPDX, expression para el resto de expresiones y
+// name para las licencias que no son SPDX
+func cycloneDXLicenses(raw string) []CycloneDXLicense {
+	license := spdxLicenseOf(raw)
+	switch {
+	case license.Expression == "":
+		return nil
+	case license.Single:
+		return []CycloneDXLicense{{License: &CycloneDXLicenseChoice{ID: license.Expression}}}
+	case len(license.Refs) > 0:
+		return []CycloneDXLicense{{License: &CycloneDXLicenseChoice{Name: NormalizeLicense(raw)}}}
+	}
+	return []CycloneDXLicense{{Expression: license.Expression}}
+}
+
+func buildCycloneDX(source sbomSource, toolVersion string) *CycloneDXBOM {
+	bom := &CycloneDXBOM{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.5",
+		SerialNumber: "urn:uuid:" + newUUID(),
+		Version:      1,
+		Components:   []CycloneDXComponent{},
+	}
+	bom.Metadata.Timestamp = time.Now().UTC().Format(time.RFC3339)
+	bom.Metadata.Tools.Components = []CycloneDXComponent{{Type: "application", Name: "antoine", Version: toolVersion}}
+	root := CycloneDXComponent{Type: "application", BOMRef: "root:" + source.Name, Name: source.Name}
+	if source.License.License != "" {
+		root.Licenses = cycloneDXLicenses(source.License.License)
+	}
+	bom.Metadata.Component = root
+
+	refs := make(map[string]bool)
+	byID := make(map[string]string) // dependencyID -> bom-ref
+	rootDependency := CycloneDXDependency{Ref: root.BOMRef, DependsOn: []string{}}
+	for i, dep := range source.Deps.Details {
+		component := CycloneDXComponent{Type: "library", Name: dep.Name, PURL: PackageURL(dep), Scope: "required"}
+		switch dep.Ecosystem {
+		case EcosystemMaven:
+			component.Group, component.Name, _ = strings.Cut(dep.Name, ":")
+		case EcosystemNpm:
+			if scope, pkg, ok := strings.Cut(dep.Name, "/"); ok && strings.HasPrefix(scope, "@") {
+				component.Group, component.Name = scope, pkg
+			}
+		}
+		if version, ok := exactVersion(dep); ok {
+			component.Version = version
+		} else if dep.Version != "" {
+			component.Properties = append(component.Properties, CycloneDXProperty{Name: "antoine:version-range", Value: dep.Version})
+		}
+		if dep.Dev {
+			component.Scope = "optional"
+		}
+		for _, hash := range checksumHashes(dep.Checksum) {
+			component.Hashes = append(component.Hashes, CycloneDXHash{Alg: cycloneDXAlgorithms[hash.Algorithm], Content: hash.Hex})
+		}
+		if h1, ok := source.GoSums[i]; ok {
+			component.Properties = append(component.Properties, CycloneDXProperty{Name: "go:h1", Value: h1})
+		}
+		component.Licenses = cycloneDXLicenses(dep.License)
+		if dep.Manifest != "" {
+			component.Properties = append(component.Properties, CycloneDXProperty{Name: "antoine:manifest", Value: dep.Manifest})
+		}
+
+		// El bom-ref debe ser único: dos rangos sin versión exacta comparten purl
+		component.BOMRef = component.PURL
+		if component.BOMRef == "" {
+			component.BOMRef = dep.Ecosystem + ":" + dep.Name
+		}
+		for i, base := 2, component.BOMRef; refs[component.BOMRef]; i++ {
+			component.BOMRef = fmt.Sprintf("%s#%d", base, i)
+		}
+		refs[component.BOMRef] = true
+		byID[dependencyID(dep.Ecosystem, dep.Name+"@"+dep.Version)] = component.BOMRef
+
+		bom.Components = append(bom.Components, component)
+		if dep.Direct {
+			rootDependency.DependsOn = append(rootDependency.DependsOn, component.BOMRef)
+		}
+	}
+
+	// Solo los lockfiles dicen de qué depende cada paquete. Un componente sin entrada en el grafo
+	// tiene dependencias desconocidas; con la entrada vacía, no tiene ninguna.
+	bom.Dependencies = []CycloneDXDependency{rootDependency}
+	for i, dep := range source.Deps.Details {
+		if !lockedGraph(dep) {
+			continue
+		}
+		ref := bom.Components[i].BOMRef
+		dependency := CycloneDXDependency{Ref: ref}
+		for _, requirement := range dep.Requires {
+			if target, ok := byID[dependencyID(dep.Ecosystem, requirement)]; ok && target != ref && !slices.Contains(dependency.DependsOn, target) {
+				dependency.DependsOn = append(dependency.DependsOn, target)
+			}
+		}
+		bom.Dependencies = append(bom.Dependencies, dependency)
+	}
+	return bom
+}
+
+// --- SPDX 2.3 ---
+
+type SPDXDocument struct {
+	SPDXVersion          string                 `json:"spdxVersion"`
+	DataLicense          string                 `json:"dataLicense"`
+	SPDXID               string                 `json:"SPDXID"`
+	Name                 string                 `json:"name"`
+	DocumentNamespace    string                 `json:"documentNamespace"`
+	CreationInfo         SPDXCreationInfo       `json:"creationInfo"`
+	Packages             []SPDXPackage          `json:"packages"`
+	Relationships        []SPDXRelationship     `json:"relationships"`
+	ExtractedLicenseInfo []SPDXExtractedLicense `json:"hasExtractedLicensingInfos,omitempty"`
+}
+
+type SPDXCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type SPDXPackage struct {
+	Name                  string            `json:"name"`
+	SPDXID                string            `json:"SPDXID"`
+	VersionInfo           string            `json:"versionInfo,omitempty"`
+	DownloadLocation      string            `json:"downloadLocation"`
+	FilesAnalyzed         bool              `json:"filesAnalyzed"`
+	Checksums             []SPDXChecksum    `json:"checksums,omitempty"`
+	LicenseConcluded      string            `json:"licenseConcluded"`
+	LicenseDeclared       string            `json:"licenseDeclared"`
+	CopyrightText         string            `json:"copyrightText"`
+	ExternalRefs          []SPDXExternalRef `json:"externalRefs,omitempty"`
+	PrimaryPackagePurpose string            `json:"primaryPackagePurpose,omitempty"`
+	Comment               string            `json:"comment,omitempty"`
+}
+
+type SPDXChecksum struct {
+	Algorithm     string `json:"algorithm"`
+	ChecksumValue string `json:"checksumValue"`
+}
+
+type SPDXExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type SPDXRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+	Comment            string `json:"comment,omitempty"`
+}
+
+type SPDXExtractedLicense struct {
+	LicenseID     string `json:"licenseId"`
+	ExtractedText string `json:"extractedText"`
+	Name          string `json:"name,omitempty"`
+}
+
+// spdxNoAssertion indica que el campo no se ha determinado
+const spdxNoAssertion = "NOASSERTION"
+
+func buildSPDX(source sbomSource, toolVersion string) *SPDXDocument {
+	doc := &SPDXDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              source.Name,
+		DocumentNamespace: "https://spdx.org/spdxdocs/antoine/" + url.PathEscape(source.Name) + "-" + newUUID(),
+		CreationInfo: SPDXCreationInfo{
+			Created:  time.Now().UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: antoine-" + toolVersion},
+		},
+	}
+	refs := make(map[string]string)
+	declared := func(raw string) string {
+		license := spdxLicenseOf(raw)
+		if license.Expression == "" {
+			return spdxNoAssertion
+		}
+		for id, text := range license.Refs {
+			refs[id] = text
+		}
+		return license.Expression
+	}
+
+	rootID := "SPDXRef-RootPackage"
+	doc.Packages = append(doc.Packages, SPDXPackage{
+		Name: source.Name, SPDXID: rootID, DownloadLocation: spdxNoAssertion,
+		LicenseConcluded: spdxNoAssertion, LicenseDeclared: declared(source.License.License), CopyrightText: spdxNoAssertion,
+		PrimaryPackagePurpose: "APPLICATION",
+	})
+	doc.Relationships = append(doc.Relationships, SPDXRelationship{SPDXElementID: doc.SPDXID, RelationshipType: "DESCRIBES", RelatedSPDXElement: rootID})
+
+	ids := make(map[string]bool)
+	byID := make(map[string]string) // dependencyID -> SPDXID
+	for _, dep := range source.Deps.Details {
+		version, pinned := exactVersion(dep)
+		id := "SPDXRef-Package-" + strings.Trim(spdxIDInvalid.ReplaceAllString(dep.Ecosystem+"-"+dep.Name+"-"+version, "-"), "-")
+		for i, base := 2, id; ids[id]; i++ {
+			id = fmt.Sprintf("%s-%d", base, i)
+		}
+		ids[id] = true
+		byID[dependencyID(dep.Ecosystem, dep.Name+"@"+dep.Version)] = id
+
+		pkg := SPDXPackage{
+			Name: dep.Name, SPDXID: id, DownloadLocation: spdxNoAssertion,
+			LicenseConcluded: spdxNoAssertion, LicenseDeclared: declared(dep.License), CopyrightText: spdxNoAssertion,
+			PrimaryPackagePurpose: "LIBRARY",
+		}
+		if pinned {
+			pkg.VersionInfo = version
+		}
+		for _, hash := range checksumHashes(dep.Checksum) {
+			pkg.Checksums = append(pkg.Checksums, SPDXChecksum{Algorithm: strings.ToUpper(hash.Algorithm), ChecksumValue: hash.Hex})
+		}
+		if purl := PackageURL(dep); purl != "" {
+			pkg.ExternalRefs = []SPDXExternalRef{{ReferenceCategory: "PACKAGE-MANAGER", ReferenceType: "purl", ReferenceLocator: purl}}
+		}
+		doc.Packages = append(doc.Packages, pkg)
+	}
+
+	// El proyecto depende de las directas; las aristas entre paquetes salen de los lockfiles
+	required := make(map[string]bool)
+	for i, dep := range source.Deps.Details {
+		id := doc.Packages[i+1].SPDXID
+		if dep.Direct {
+			relationship := SPDXRelationship{SPDXElementID: rootID, RelationshipType: "DEPENDS_ON", RelatedSPDXElement: id}
+			if dep.Dev {
+				relationship = SPDXRelationship{SPDXElementID: id, RelationshipType: "DEV_DEPENDENCY_OF", RelatedSPDXElement: rootID}
+			}
+			if dep.Manifest != "" {
+				relationship.Comment = "from " + dep.Manifest
+			}
+			doc.Relationships = append(doc.Relationships, relationship)
+		}
+		if !lockedGraph(dep) {
+			continue
+		}
+		linked := make(map[string]bool)
+		for _, requirement := range dep.Requires {
+			target, ok := byID[dependencyID(dep.Ecosystem, requirement)]
+			if !ok || target == id || linked[target] {
+				continue
+			}
+			linked[target], required[target] = true, true
+			doc.Relationships = append(doc.Relationships, SPDXRelationship{SPDXElementID: id, RelationshipType: "DEPENDS_ON", RelatedSPDXElement: target})
+		}
+	}
+	for i, dep := range source.Deps.Details {
+		if pkg := &doc.Packages[i+1]; !dep.Direct && !required[pkg.SPDXID] {
+			pkg.Comment = "transitive dependency; the manifests do not record which package requires it"
+		}
+	}
+
+	for _, id := range sortedKeys(refs) {
+		doc.ExtractedLicenseInfo = append(doc.ExtractedLicenseInfo, SPDXExtractedLicense{LicenseID: id, ExtractedText: refs[id], Name: refs[id]})
+	}
+	return doc
+}
@@ -0,0 +1,342 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	spdxjson "github.com/spdx/tools-golang/json"
+	"github.com/spdx/tools-golang/spdxlib"
+)
+
+func TestLockfileRequires(t *testing.T) {
+	tests := []struct {
+		name     string
+		manifest string
+		content  string
+		want     map[string]string // nombre@versión -> Requires separados por comas
+	}{
+		{
+			name:     "package-lock v3 resolves the closest node_modules",
+			manifest: "package-lock.json",
+			content: `{"lockfileVersion": 3, "packages": {
+				"": {"dependencies": {"a": "^1.0.0"}},
+				"node_modules/a": {"version": "1.0.0", "dependencies": {"b": "^2.0.0", "c": "*"}},
+				"node_modules/a/node_modules/b": {"version": "2.0.0"},
+				"node_modules/b": {"version": "1.0.0", "optionalDependencies": {"missing": "*"}},
+				"node_modules/c": {"version": "3.0.0", "peerDependencies": {"b": "^1.0.0"}}
+			}}`,
+			want: map[string]string{"a@1.0.0": "b@2.0.0,c@3.0.0", "b@2.0.0": "", "b@1.0.0": "", "c@3.0.0": "b@1.0.0"},
+		},
+		{
+			name:     "package-lock v1 uses requires",
+			manifest: "package-lock.json",
+			content: `{"lockfileVersion": 1, "dependencies": {
+				"a": {"version": "1.0.0", "requires": {"b": "^2.0.0"}, "dependencies": {"b": {"version": "2.0.0"}}},
+				"b": {"version": "1.0.0"}
+			}}`,
+			want: map[string]string{"a@1.0.0": "b@2.0.0", "b@2.0.0": "", "b@1.0.0": ""},
+		},
+		{
+			name:     "yarn v1",
+			manifest: "yarn.lock",
+			content: `# yarn lockfile v1
+
+"@scope/a@^1.0.0":
+  version "1.2.0"
+  dependencies:
+    b "^2.0.0"
+    "@scope/c" "~3.0.0"
+
+b@^2.0.0, b@^2.1.0:
+  version "2.1.0"
+  integrity sha512-AAAA
+
+"@scope/c@~3.0.0":
+  version "3.0.1"
+`,
+			want: map[string]string{"@scope/a@1.2.0": "@scope/c@3.0.1,b@2.1.0", "b@2.1.0": "", "@scope/c@3.0.1": ""},
+		},
+		{
+			name:     "yarn berry",
+			manifest: "yarn.lock",
+			content: `__metadata:
+  version: 6
+
+"a@npm:^1.0.0":
+  version: 1.0.0
+  resolution: "a@npm:1.0.0"
+  dependencies:
+    b: "npm:^2.0.0"
+  checksum: abc
+
+"b@npm:^2.0.0":
+  version: 2.0.0
+  resolution: "b@npm:2.0.0"
+`,
+			want: map[string]string{"a@1.0.0": "b@2.0.0", "b@2.0.0": ""},
+		},
+		{
+			name:     "pnpm v6",
+			manifest: "pnpm-lock.yaml",
+			content: `lockfileVersion: '6.0'
+packages:
+  /a@1.0.0:
+    resolution: {integrity: sha512-AAAA}
+    dependencies:
+      b: 2.0.0(react@18.0.0)
+      alias: /@scope/c@3.0.0
+  /b@2.0.0(react@18.0.0):
+    resolution: {integrity: sha512-BBBB}
+  /@scope/c@3.0.0:
+    resolution: {integrity: sha512-CCCC}
+`,
+			want: map[string]string{"a@1.0.0": "@scope/c@3.0.0,b@2.0.0", "b@2.0.0": "", "@scope/c@3.0.0": ""},
+		},
+		{
+			name:     "pnpm v5",
+			manifest: "pnpm-lock.yaml",
+			content: `lockfileVersion: 5.4
+packages:
+  /a/1.0.0_react@18.0.0:
+    resolution: {integrity: sha512-AAAA}
+    dependencies:
+      '@scope/b': 2.0.0
+  /@scope/b/2.0.0:
+    resolution: {integrity: sha512-BBBB}
+`,
+			want: map[string]string{"a@1.0.0": "@scope/b@2.0.0", "@scope/b@2.0.0": ""},
+		},
+		{
+			name:     "pnpm v9 snapshots",
+			manifest: "pnpm-lock.yaml",
+			content: `lockfileVersion: '9.0'
+packages:
+  a@1.0.0:
+    resolution: {integrity: sha512-AAAA}
+  b@2.0.0:
+    resolution: {integrity: sha512-BBBB}
+snapshots:
+  a@1.0.0:
+    dependencies:
+      b: 2.0.0
+      local: link:../local
+  b@2.0.0: {}
+`,
+			want: map[string]string{"a@1.0.0": "b@2.0.0", "b@2.0.0": ""},
+		},
+		{
+			name:     "Cargo.lock with one and several versions",
+			manifest: "Cargo.lock",
+			content: `version = 3
+
+[[package]]
+name = "app"
+version = "0.1.0"
+dependencies = ["serde", "rand 0.8.5"]
+
+[[package]]
+name = "serde"
+version = "1.0.200"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+
+[[package]]
+name = "rand"
+version = "0.8.5"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+dependencies = ["rand_core 0.6.4 (registry+https://github.com/rust-lang/crates.io-index)", "serde"]
+
+[[package]]
+name = "rand"
+version = "0.7.3"
+source = "registry+https://github.com/rust-lang/crates.io-index"
+`,
+			want: map[string]string{"serde@1.0.200": "", "rand@0.8.5": "rand_core@0.6.4,serde@1.0.200", "rand@0.7.3": ""},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deps, err := ParseManifest(tt.manifest, []byte(tt.content))
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make(map[string]string)
+			for _, dep := range deps {
+				requires := slices.Sorted(slices.Values(dep.Requires))
+				got[dep.Name+"@"+dep.Version] = strings.Join(requires, ",")
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("requires = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// sbomProject escribe un proyecto con package-lock.json y go.mod/go.sum y genera su SBOM
+func sbomProject(t *testing.T, format string) []byte {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	root := t.TempDir()
+	files := map[string]string{
+		"package.json": `{"dependencies": {"a": "^1.0.0"}, "devDependencies": {"d": "^1.0.0"}}`,
+		"package-lock.json": `{"lockfileVersion": 3, "packages": {
+			"": {"dependencies": {"a": "^1.0.0"}, "devDependencies": {"d": "^1.0.0"}},
+			"node_modules/a": {"version": "1.0.0", "license": "MIT", "integrity": "sha512-` + strings.Repeat("A", 86) + `==", "dependencies": {"b": "^2.0.0"}},
+			"node_modules/b": {"version": "2.0.0", "license": "(MIT OR Apache-2.0)"},
+			"node_modules/d": {"version": "1.0.0", "dev": true, "license": "ISC"}
+		}}`,
+		"tools/go.mod": "module example.com/tools\n\ngo 1.22\n\nrequire (\n\tgithub.com/x/y v1.2.3\n\tgithub.com/x/z v0.1.0 // indirect\n)\n",
+		"tools/go.sum": "github.com/x/y v1.2.3 h1:" + strings.Repeat("B", 43) + "=\ngithub.com/x/y v1.2.3/go.mod h1:" + strings.Repeat("C", 43) + "=\n",
+	}
+	for name, content := range files {
+		file := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	doc, warnings, err := (&AntoineClient{}).GenerateSBOM(context.Background(), root, SBOMOptions{Format: format, ToolVersion: "1.0.0"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) > 0 {
+		t.Fatalf("warnings: %v", warnings)
+	}
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestCycloneDXSchema(t *testing.T) {
+	compiler := jsonschema.NewCompiler()
+	for _, name := range []string{"bom-1.5.schema.json", "spdx.schema.json", "jsf-0.82.schema.json"} {
+		file, err := os.Open(filepath.Join("testdata", "schema", name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		schema, err := jsonschema.UnmarshalJSON(file)
+		file.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := compiler.AddResource("http://cyclonedx.org/schema/"+name, schema); err != nil {
+			t.Fatal(err)
+		}
+	}
+	schema, err := compiler.Compile("http://cyclonedx.org/schema/bom-1.5.schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := sbomProject(t, SBOMCycloneDX)
+	instance, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := schema.Validate(instance); err != nil {
+		t.Fatalf("CycloneDX document does not match the 1.5 schema: %v", err)
+	}
+	broken, err := jsonschema.UnmarshalJSON(bytes.NewReader(bytes.Replace(data, []byte(`"type":"library"`), []byte(`"type":"lib"`), 1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if schema.Validate(broken) == nil {
+		t.Fatal("schema accepted a component with an invalid type")
+	}
+
+	var bom CycloneDXBOM
+	if err := json.Unmarshal(data, &bom); err != nil {
+		t.Fatal(err)
+	}
+	graph := make(map[string]string)
+	for _, dependency := range bom.Dependencies {
+		graph[dependency.Ref] = strings.Join(dependency.DependsOn, ",")
+	}
+	wantGraph := map[string]string{
+		"root:" + bom.Metadata.Component.Name: "pkg:golang/github.com/x/y@v1.2.3,pkg:npm/a@1.0.0,pkg:npm/d@1.0.0",
+		"pkg:npm/a@1.0.0":                     "pkg:npm/b@2.0.0",
+		"pkg:npm/b@2.0.0":                     "",
+		"pkg:npm/d@1.0.0":                     "",
+	}
+	if fmt.Sprint(graph) != fmt.Sprint(wantGraph) {
+		t.Errorf("dependencies = %v, want %v", graph, wantGraph)
+	}
+
+	for _, component := range bom.Components {
+		if component.Name != "y" {
+			continue
+		}
+		if len(component.Hashes) > 0 {
+			t.Errorf("go module hashes = %v, want the h1 hash only as a property", component.Hashes)
+		}
+		want := CycloneDXProperty{Name: "go:h1", Value: "h1:" + strings.Repeat("B", 43) + "="}
+		if !slices.Contains(component.Properties, want) {
+			t.Errorf("go module properties = %v, want %v", component.Properties, want)
+		}
+	}
+}
+
+// TestSPDXDocument valida el documento con la biblioteca de referencia de SPDX: su lector JSON
+// exige los tipos del modelo 2.3 y ValidateDocument comprueba los SPDXID y las relaciones
+func TestSPDXDocument(t *testing.T) {
+	data := sbomProject(t, SBOMSPDX)
+	doc, err := spdxjson.Read(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("SPDX document cannot be read: %v", err)
+	}
+	if err := spdxlib.ValidateDocument(doc); err != nil {
+		t.Fatalf("invalid SPDX document: %v", err)
+	}
+	if doc.SPDXVersion != "SPDX-2.3" || doc.DataLicense != "CC0-1.0" || doc.DocumentNamespace == "" || len(doc.CreationInfo.Creators) == 0 {
+		t.Errorf("incomplete document header: %+v", doc)
+	}
+
+	names := make(map[string]string)
+	comments := make(map[string]string)
+	for _, pkg := range doc.Packages {
+		names[string(pkg.PackageSPDXIdentifier)] = pkg.PackageName
+		comments[pkg.PackageName] = pkg.PackageComment
+		if pkg.PackageDownloadLocation == "" || pkg.PackageLicenseDeclared == "" {
+			t.Errorf("package %s lacks required fields", pkg.PackageName)
+		}
+		for _, checksum := range pkg.PackageChecksums {
+			if pkg.PackageName == "github.com/x/y" {
+				t.Errorf("go module checksum = %v, want none", checksum)
+			}
+		}
+	}
+	var relationships []string
+	for _, relationship := range doc.Relationships {
+		relationships = append(relationships, fmt.Sprintf("%s %s %s",
+			names[string(relationship.RefA.ElementRefID)], relationship.Relationship, names[string(relationship.RefB.ElementRefID)]))
+	}
+	slices.Sort(relationships)
+	project := names["RootPackage"]
+	want := []string{
+		" DESCRIBES " + project,
+		"a DEPENDS_ON b",
+		"d DEV_DEPENDENCY_OF " + project,
+		project + " DEPENDS_ON a",
+		project + " DEPENDS_ON github.com/x/y",
+	}
+	slices.Sort(want)
+	if fmt.Sprint(relationships) != fmt.Sprint(want) {
+		t.Errorf("relationships = %q, want %q", relationships, want)
+	}
+	if comments["github.com/x/z"] == "" || comments["b"] != "" {
+		t.Errorf("package comments = %q, want one only on the unattached go module", comments)
+	}
+}
@@ -0,0 +1,611 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"antoine-cli/internal/models"
+)
+
+// SecretAllowlistFile es el archivo de la raíz del repositorio con las excepciones del escáner
+const SecretAllowlistFile = ".secretsignore"
+
+// secretLineLimit evita recorrer líneas enormes de archivos minificados o datos embebidos
+const secretLineLimit = 4096
+
+// secretRule es un detector: una expresión regular, el grupo con el secreto y la entropía mínima
+type secretRule struct {
+	ID          string
+	Description string
+	Severity    string
+	Pattern     *regexp.Regexp
+	Group       int            // grupo con el secreto; 0 = toda la coincidencia
+	MinEntropy  float64        // bits por carácter; 0 = sin comprobar
+	Keywords    []string       // en minúsculas; la línea debe contener alguna para evaluar la regla
+	Public      bool           // la coincidencia no es secreta (la cabecera de una clave privada)
+	Generic     bool           // el valor no tiene un prefijo del proveedor: se redacta entero
+	Skip        *regexp.Regexp // descarta la coincidencia completa si también encaja aquí
+}
+
+var secretRules = []secretRule{
+	{ID: "private-key", Description: "Private key", Severity: "critical", Public: true,
+		Pattern:  regexp.MustCompile(`-----BEGIN ((RSA|DSA|EC|OPENSSH|PGP|ENCRYPTED) )?PRIVATE KEY( BLOCK)?-----`),
+		Keywords: []string{"private key"}},
+	{ID: "aws-access-key-id", Description: "AWS access key ID", Severity: "critical",
+		Pattern:  regexp.MustCompile(`\b((AKIA|ASIA|ABIA|ACCA)[0-9A-Z]{16})\b`),
+		Group:    1,
+		Keywords: []string{"akia", "asia", "abia", "acca"}},
+	{ID: "aws-secret-access-key", Description: "AWS secret access key", Severity: "critical", Generic: true,
+		Pattern:    regexp.MustCompile(`(?i)aws.{0,20}?(secret|key).{0,20}?['"=:\s]([A-Za-z0-9/+=]{40})([^A-Za-z0-9/+=]|$)`),
+		Group:      2,
+		MinEntropy: 4,
+		Keywords:   []string{"aws"}},
+	{ID: "github-token", Description: "GitHub token", Severity: "high",
+		Pattern:  regexp.MustCompile(`\b((ghp|gho|ghu|ghs|ghr)_[A-Za-z0-9]{36}|github_pat_[A-Za-z0-9_]{82})\b`),
+		Group:    1,
+		Keywords: []string{"ghp_", "gho_", "ghu_", "ghs_", "ghr_", "github_pat_"}},
+	{ID: "gitlab-token", Description: "GitLab personal access token", Severity: "high",
+		Pattern:  regexp.MustCompile(`\b(glpat-[A-Za-z0-9_-]{20})\b`),
+		Group:    1,
+		Keywords: []string{"glpat-"}},
+	{ID: "slack-token", Description: "Slack token", Severity: "high",
+		Pattern:  regexp.MustCompile(`\b(xox[baprs]-[0-9A-Za-z-]{10,})\b`),
+		Group:    1,
+		Keywords: []string{"xox"}},
+	{ID: "slack-webhook", Description: "Slack webhook URL", Severity: "medium",
+		Pattern:  regexp.MustCompile(`https://hooks\.slack\.com/services/[A-Za-z0-9_/]{20,}`),
+		Keywords: []string{"hooks.slack.com"}},
+	{ID: "stripe-secret-key", Description: "Stripe live secret key", Severity: "critical",
+		Pattern:  regexp.MustCompile(`\b((sk|rk)_live_[0-9A-Za-z]{24,})\b`),
+		Group:    1,
+		Keywords: []string{"_live_"}},
+	{ID: "google-api-key", Description: "Google API key", Severity: "high",
+		Pattern:  regexp.MustCompile(`\b(AIza[0-9A-Za-z_-]{35})`),
+		Group:    1,
+		Keywords: []string{"aiza"}},
+	{ID: "anthropic-api-key", Description: "Anthropic API key", Severity: "high",
+		Pattern:  regexp.MustCompile(`\b(sk-ant-[A-Za-z0-9_-]{32,})`),
+		Group:    1,
+		Keywords: []string{"sk-ant-"}},
+	{ID: "openai-api-key", Description: "OpenAI API key", Severity: "high",
+		Pattern:  regexp.MustCompile(`\b(sk-(proj|svcacct|admin)-[A-Za-z0-9_-]{40,}|sk-[A-Za-z0-9]{48})`),
+		Group:    1,
+		Keywords: []string{"sk-"}},
+	{ID: "huggingface-token", Description: "Hugging Face token", Severity: "high",
+		Pattern:  regexp.MustCompile(`\b(hf_[A-Za-z]{34})\b`),
+		Group:    1,
+		Keywords: []string{"hf_"}},
+	{ID: "sendgrid-api-key", Description: "SendGrid API key", Severity: "high",
+		Pattern:  regexp.MustCompile(`\b(SG\.[A-Za-z0-9_-]{22}\.[A-Za-z0-9_-]{43})`),
+		Group:    1,
+		Keywords: []string{"sg."}},
+	{ID: "twilio-api-key", Description: "Twilio API key", Severity: "high",
+		Pattern:    regexp.MustCompile(`\b(SK[0-9a-f]{32})\b`),
+		Group:      1,
+		MinEntropy: 3,
+		Keywords:   []string{"sk"}},
+	{ID: "npm-token", Description: "npm access token", Severity: "high",
+		Pattern:  regexp.MustCompile(`\b(npm_[A-Za-z0-9]{36})\b`),
+		Group:    1,
+		Keywords: []string{"npm_"}},
+	{ID: "pypi-token", Description: "PyPI upload token", Severity: "high",
+		Pattern:  regexp.MustCompile(`\b(pypi-AgEIcHlwaS5vcmc[A-Za-z0-9_-]{50,})`),
+		Group:    1,
+		Keywords: []string{"pypi-ageichlwas5vcmc"}},
+	{ID: "telegram-bot-token", Description: "Telegram bot token", Severity: "high",
+		Pattern:  regexp.MustCompile(`\b(\d{8,10}:AA[A-Za-z0-9_-]{33})\b`),
+		Group:    1,
+		Keywords: []string{":aa"}},
+	{ID: "mailgun-api-key", Description: "Mailgun API key", Severity: "medium",
+		Pattern:    regexp.MustCompile(`\b(key-[0-9a-z]{32})\b`),
+		Group:      1,
+		MinEntropy: 3.5,
+		Keywords:   []string{"key-"}},
+	{ID: "connection-string", Description: "Password in a connection string", Severity: "high", Generic: true,
+		Pattern: regexp.MustCompile(`\b(postgres|postgresql|mysql|mongodb|mongodb\+srv|redis|rediss|amqp|amqps)://[^:\s/@'"]+:([^@\s'"]{3,})@[^\s'"]+`),
+		Group:   2,
+		// Las credenciales de desarrollo de docker-compose o de la máquina local no son un secreto
+		Skip:     regexp.MustCompile(`@(localhost|127\.0\.0\.1|0\.0\.0\.0|db|database|postgres|mysql|mongo|mongodb|redis|rabbitmq)([:/]|$)`),
+		Keywords: []string{"://"}},
+	{ID: "jwt", Description: "JSON Web Token", Severity: "medium",
+		Pattern:  regexp.MustCompile(`\b(eyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,})`),
+		Group:    1,
+		Keywords: []string{"eyj"}},
+	{ID: "generic-secret", Description: "Hardcoded secret", Severity: "medium", Generic: true,
+		Pattern:    regexp.MustCompile(`(?i)[\w.-]*(api[_-]?key|apikey|secret|token|passwd|password|access[_-]?key|auth[_-]?key)[\w.-]*['"]?\s*(:=|=>|=|:)\s*['"` + "`" + `]([^\s'"` + "`" + `;,]{12,})['"` + "`" + `]`),
+		Group:      3,
+		MinEntropy: 3.5,
+		Keywords:   []string{"key", "secret", "token", "passw"}},
+}
+
+// secretKeyName reconoce las variables de entorno que guardan credenciales
+var secretKeyName = regexp.MustCompile(`(?i)(key|secret|token|passw|pwd|credential|auth|private|dsn|webhook)`)
+
+// envAssignment es una línea KEY=VALUE de un archivo .env
+var envAssignment = regexp.MustCompile(`^\s*(export\s+)?([A-Za-z_][A-Za-z0-9_.]*)\s*=\s*(.*)$`)
+
+// identifierChain es un valor que en realidad es código: config.apiKey, settings.SECRET
+var identifierChain = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*(\.[A-Za-z_$][A-Za-z0-9_$]*)*(\(\))?$`)
+
+// secretPlaceholders delatan valores de ejemplo
+var secretPlaceholders = []string{
+	"example", "xxxx", "your_", "your-", "yourkey", "changeme", "change_me", "placeholder",
+	"dummy", "redacted", "****", "<", "${", "{{", "%s", "insert", "replace", "sample", "fake",
+}
+
+// secretSkipExtensions son archivos generados o de datos donde los falsos positivos superan a los aciertos
+var secretSkipExtensions = map[string]bool{
+	".map": true, ".lock": true, ".sum": true, ".svg": true, ".pdf": true, ".png": true, ".jpg": true,
+	".jpeg": true, ".gif": true, ".ico": true, ".woff": true, ".woff2": true, ".ttf": true, ".zip": true,
+	".gz": true, ".ipynb": true,
+}
+
+// SecretFinding es un secreto encontrado; el valor nunca se guarda, solo su versión redactada
+type SecretFinding struct {
+	Rule        string  `json:"rule"`
+	Description string  `json:"description"`
+	Severity    string  `json:"severity"`
+	File        string  `json:"file"`
+	Line        int     `json:"line"`
+	Column      int     `json:"column"`
+	Redacted    string  `json:"redacted"`
+	Fingerprint string  `json:"fingerprint"` // HMAC con la clave local: identifica el valor sin revelarlo
+	Entropy     float64 `json:"entropy"`
+}
+
+// Vulnerability convierte el hallazgo al modelo de seguridad del repositorio
+func (f SecretFinding) Vulnerability() models.Vulnerability {
+	return models.Vulnerability{
+		Type:        "secret",
+		Severity:    f.Severity,
+		Description: fmt.Sprintf("%s: %s (fingerprint %s)", f.Description, f.Redacted, f.Fingerprint),
+		File:        f.File,
+		Line:        f.Line,
+	}
+}
+
+// SecretScan es el resultado de escanear un árbol
+type SecretScan struct {
+	Root      string          `json:"root"`
+	Files     int             `json:"files"`
+	Findings  []SecretFinding `json:"findings"`
+	Allowed   int             `json:"allowed"` // hallazgos descartados por el allowlist
+	Allowlist string          `json:"allowlist,omitempty"`
+	Walk      WalkStats       `json:"walk"`
+}
+
+// SecretScanOptions configura el escaneo
+type SecretScanOptions struct {
+	Limits    LocalLimits
+	Allowlist string // vacío = .secretsignore en la raíz
+}
+
+// SecretAllowlist descarta hallazgos por ruta (patrones de .gitignore), regla, huella o valor
+type SecretAllowlist struct {
+	paths        GitIgnore
+	rules        map[string]bool
+	fingerprints map[string]bool
+	values       []*regexp.Regexp
+}
+
+var fingerprintPattern = regexp.MustCompile(`^[0-9a-f]{16}$`)
+
+// LoadSecretAllowlist lee un allowlist; si el archivo no existe devuelve uno vacío.
+//
+//	tests/fixtures/          rutas con la sintaxis de .gitignore
+//	rule:jwt                 una regla entera
+//	fingerprint:3f9a0c1d...  un secreto concreto (la huella del informe)
+//	regex:^AKIA.*TEST$       valores que coinciden con la expresión
+func LoadSecretAllowlist(file string) (*SecretAllowlist, error) {
+	allowlist := &SecretAllowlist{rules: make(map[string]bool), fingerprints: make(map[string]bool)}
+	content, err := os.ReadFile(file)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return allowlist, nil
+		}
+		return nil, fmt.Errorf("failed to read allowlist %s: %w", file, err)
+	}
+
+	for n, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		kind, value, _ := strings.Cut(line, ":")
+		switch kind {
+		case "rule":
+			allowlist.rules[strings.TrimSpace(value)] = true
+		case "fingerprint":
+			allowlist.fingerprints[strings.ToLower(strings.TrimSpace(value))] = true
+		case "regex":
+			pattern, err := regexp.Compile(strings.TrimSpace(value))
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: invalid regex: %w", file, n+1, err)
+			}
+			allowlist.values = append(allowlist.values, pattern)
+		case "path":
+			allowlist.paths.AddPattern(strings.TrimSpace(value), "")
+		default:
+			if fingerprintPattern.MatchString(line) {
+				allowlist.fingerprints[line] = true
+			} else {
+				allowlist.paths.AddPattern(line, "")
+			}
+		}
+	}
+	return allowlist, nil
+}
+
+// skipsFile indica si el archivo, o alguno de sus directorios, está excluido
+func (a *SecretAllowlist) skipsFile(rel string) bool {
+	if a.paths.Ignored(rel, false) {
+		return true
+	}
+	for dir := path.Dir(rel); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		if a.paths.Ignored(dir, true) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *SecretAllowlist) allows(finding SecretFinding, secret string) bool {
+	if a.rules[finding.Rule] || a.fingerprints[finding.Fingerprint] {
+		return true
+	}
+	for _, pattern := range a.values {
+		if pattern.MatchString(secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// ScanSecrets busca credenciales en los archivos de root que no ignora .gitignore y en todos
+// los que sigue git, aunque después se hayan añadido al .gitignore
+func ScanSecrets(ctx context.Context, root string, options SecretScanOptions) (*SecretScan, error) {
+	abs, err := filepath.Abs(root)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(abs)
+	if err != nil {
+		return nil, fmt.Errorf("cannot scan %s: %w", root, err)
+	}
+
+	key, err := loadSecretKey()
+	if err != nil {
+		return nil, err
+	}
+
+	scan := &SecretScan{Root: abs}
+	allowlistPath := options.Allowlist
+	if allowlistPath == "" && info.IsDir() {
+		allowlistPath = filepath.Join(abs, SecretAllowlistFile)
+	}
+	allowlist := &SecretAllowlist{}
+	if allowlistPath != "" {
+		if allowlist, err = LoadSecretAllowlist(allowlistPath); err != nil {
+			return nil, err
+		}
+		if _, err := os.Stat(allowlistPath); err == nil {
+			scan.Allowlist = allowlistPath
+		}
+	}
+
+	scanFile := func(file SourceFile) error {
+		if allowlist.skipsFile(file.Path) || skipSecretFile(file.Path) {
+			return nil
+		}
+		content, err := os.ReadFile(file.AbsPath)
+		if err != nil {
+			return err
+		}
+		if bytes.IndexByte(content[:min(len(content), 8000)], 0) >= 0 {
+			scan.Walk.Binary++
+			return nil
+		}
+		scan.Files++
+		findings, allowed := scanSecretContent(file.Path, content, allowlist, key)
+		scan.Findings = append(scan.Findings, findings...)
+		scan.Allowed += allowed
+		return nil
+	}
+
+	if !info.IsDir() {
+		if err := scanFile(SourceFile{Path: filepath.Base(abs), AbsPath: abs, Size: info.Size()}); err != nil {
+			return nil, err
+		}
+	} else if scan.Walk, err = WalkFiles(ctx, abs, options.Limits, scanFile); err != nil {
+		return nil, fmt.Errorf("failed to walk %s: %w", root, err)
+	}
+
+	sort.SliceStable(scan.Findings, func(i, j int) bool {
+		a, b := scan.Findings[i], scan.Findings[j]
+		if severityRank[a.Severity] != severityRank[b.Severity] {
+			return severityRank[a.Severity] > severityRank[b.Severity]
+		}
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.Line < b.Line
+	})
+	return scan, nil
+}
+
+// skipSecretFile descarta lockfiles, minificados y binarios conocidos, y el propio allowlist
+func skipSecretFile(rel string) bool {
+	base := path.Base(rel)
+	if lockfiles[base] || base == SecretAllowlistFile || strings.HasSuffix(base, ".min.js") || strings.HasSuffix(base, ".min.css") {
+		return true
+	}
+	return secretSkipExtensions[strings.ToLower(path.Ext(base))]
+}
+
+// isEnvFile reconoce .env, .env.local, production.env...; las plantillas (.env.example) no cuentan
+func isEnvFile(rel string) bool {
+	base := strings.ToLower(path.Base(rel))
+	if base != ".env" && !strings.HasPrefix(base, ".env.") && !strings.HasSuffix(base, ".env") {
+		return false
+	}
+	for _, template := range []string{"example", "sample", "template", "dist", "defaults", "schema"} {
+		if strings.Contains(base, template) {
+			return false
+		}
+	}
+	return true
+}
+
+// scanSecretContent aplica las reglas línea a línea; devuelve los hallazgos y cuántos descartó el allowlist
+func scanSecretContent(rel string, content []byte, allowlist *SecretAllowlist, key []byte) ([]SecretFinding, int) {
+	var findings []SecretFinding
+	allowed := 0
+	env := isEnvFile(rel)
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		if len(line) > secretLineLimit || strings.Contains(line, "antoine:allow") || strings.Contains(line, "gitleaks:allow") {
+			continue
+		}
+		lower := strings.ToLower(line)
+
+		seen := make(map[string]bool) // un mismo valor solo cuenta una vez por línea
+		report := func(rule secretRule, secret string, column int) {
+			if seen[secret] {
+				return
+			}
+			seen[secret] = true
+			finding := SecretFinding{
+				Rule: rule.ID, Description: rule.Description, Severity: rule.Severity,
+				File: rel, Line: lineNumber, Column: column + 1,
+				Fingerprint: secretFingerprint(key, secret), Entropy: round1(shannonEntropy(secret)),
+			}
+			switch {
+			case rule.Public:
+				// La cabecera es igual en todas las claves: la huella es la del archivo
+				finding.Redacted, finding.Fingerprint = secret, secretFingerprint(key, rel+":"+secret)
+			case rule.Generic:
+				finding.Redacted = strings.Repeat("*", 8)
+			default:
+				finding.Redacted = RedactSecret(secret)
+			}
+			if allowlist.allows(finding, secret) {
+				allowed++
+				return
+			}
+			findings = append(findings, finding)
+		}
+
+		for _, rule := range secretRules {
+			if !containsAny(lower, rule.Keywords) {
+				continue
+			}
+			for _, match := range rule.Pattern.FindAllStringSubmatchIndex(line, -1) {
+				start, end := match[2*rule.Group], match[2*rule.Group+1]
+				if start < 0 {
+					continue
+				}
+				secret := line[start:end]
+				if rule.Skip != nil && rule.Skip.MatchString(line[match[0]:match[1]]) {
+					continue
+				}
+				if !rule.Public && !plausibleSecret(secret, rule.MinEntropy) {
+					continue
+				}
+				report(rule, secret, start)
+			}
+		}
+
+		// En un .env cualquier credencial con valor cuenta, aunque no sea de un proveedor conocido
+		if env && len(seen) == 0 {
+			if match := envAssignment.FindStringSubmatchIndex(line); match != nil {
+				key := line[match[4]:match[5]]
+				value, offset := envValue(line[match[6]:match[7]])
+				// Con nombre de credencial basta un valor con dígitos (no true, 3600 ni keycloak);
+				// sin él, solo los valores largos y aleatorios
+				named := secretKeyName.MatchString(key) && len(value) >= 8 && strings.ContainsAny(value, "0123456789") &&
+					strings.IndexFunc(value, unicode.IsLetter) >= 0
+				random := len(value) >= 20 && shannonEntropy(value) >= 4
+				if (named || random) && plausibleSecret(value, 0) {
+					report(envRule, value, match[6]+offset)
+				}
+			}
+		}
+	}
+	return findings, allowed
+}
+
+var envRule = secretRule{ID: "env-file", Description: "Credential in a committed .env file", Severity: "high", Generic: true}
+
+// envValue quita comillas y comentarios finales; devuelve el valor y su desplazamiento en la línea
+func envValue(raw string) (string, int) {
+	trimmed := strings.TrimLeft(raw, " \t")
+	offset := len(raw) - len(trimmed)
+	if len(trimmed) > 0 && (trimmed[0] == '"' || trimmed[0] == '\'') {
+		if end := strings.IndexByte(trimmed[1:], trimmed[0]); end >= 0 {
+			return trimmed[1 : end+1], offset + 1
+		}
+	}
+	if i := strings.Index(trimmed, " #"); i >= 0 {
+		trimmed = trimmed[:i]
+	}
+	return strings.TrimSpace(trimmed), offset
+}
+
+// plausibleSecret descarta los valores de ejemplo, las referencias a variables y los de poca entropía
+func plausibleSecret(value string, minEntropy float64) bool {
+	lower := strings.ToLower(value)
+	if containsAny(lower, secretPlaceholders) || strings.HasPrefix(value, "$") || strings.HasPrefix(value, "%") {
+		return false
+	}
+	if minEntropy > 0 && (shannonEntropy(value) < minEntropy || identifierChain.MatchString(value)) {
+		return false
+	}
+	// Un valor repetido (aaaa..., 0000...) no es un secreto
+	return strings.Count(value, value[:1]) < len(value)
+}
+
+func containsAny(text string, values []string) bool {
+	for _, value := range values {
+		if strings.Contains(text, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// shannonEntropy mide los bits por carácter del valor
+func shannonEntropy(value string) float64 {
+	if value == "" {
+		return 0
+	}
+	counts := make(map[rune]int)
+	total := 0
+	for _, r := range value {
+		counts[r]++
+		total++
+	}
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
+
+// RedactSecret deja ver el prefijo, que suele identificar el proveedor, y oculta el resto
+func RedactSecret(secret string) string {
+	if len(secret) <= 8 {
+		return strings.Repeat("*", 8)
+	}
+	return secret[:4] + strings.Repeat("*", min(len(secret)-4, 12))
+}
+
+// secretFingerprint identifica un valor sin revelarlo, igual en todos los archivos donde aparezca.
+// Es un HMAC con la clave de esta instalación: con un hash sin sal, cualquiera que vea el
+// informe podría comprobar si una clave que conoce es la filtrada.
+func secretFingerprint(key []byte, secret string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(secret))
+	return hex.EncodeToString(mac.Sum(nil)[:8])
+}
+
+// secretKeyFile guarda en el directorio de datos la clave de las huellas de secretos
+const secretKeyFile = "secrets.json"
+
+type secretKeyState struct {
+	Key       string    `json:"key"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// loadSecretKey devuelve la clave de las huellas y la crea la primera vez. Es local: las huellas
+// de un allowlist solo sirven en la máquina que las generó; para compartirlo usa rutas o reglas.
+func loadSecretKey() ([]byte, error) {
+	path, err := DataPath(secretKeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var state secretKeyState
+	err = updateJSONFile(path, &state, func(exists bool) error {
+		if exists && state.Key != "" {
+			return errSkipWrite
+		}
+		key, err := randomSalt()
+		if err != nil {
+			return err
+		}
+		state = secretKeyState{Key: key, CreatedAt: time.Now()}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load secret fingerprint key: %w", err)
+	}
+	return []byte(state.Key), nil
+}
+
+// attachSecrets añade los secretos a la seguridad del repositorio, recalcula la puntuación y
+// añade el insight y la recomendación
+func attachSecrets(result *models.AnalysisResult, scan *SecretScan) {
+	repo, ok := RepositoryFromResult(result)
+	if !ok {
+		return
+	}
+	security := &repo.CodeQuality.Security
+	for _, finding := range scan.Findings {
+		security.Vulnerabilities = append(security.Vulnerabilities, finding.Vulnerability())
+	}
+	security.Score = SecurityScore(security.Vulnerabilities)
+	if result.Metadata == nil {
+		result.Metadata = make(map[string]interface{})
+	}
+	result.Metadata["secrets"] = scan
+
+	if len(scan.Findings) == 0 {
+		return
+	}
+	files := make(map[string]bool)
+	severe := 0
+	for _, finding := range scan.Findings {
+		files[finding.File] = true
+		if severityRank[finding.Severity] >= severityRank["high"] {
+			severe++
+		}
+	}
+	impact := "medium"
+	if severe > 0 {
+		impact = "high"
+	}
+	result.Insights = append(result.Insights, models.Insight{
+		Type:        "secrets",
+		Title:       "Leaked credentials",
+		Description: fmt.Sprintf("%d possible secrets in %d files (%d high or critical). Run 'antoine scan secrets' for the locations.", len(scan.Findings), len(files), severe),
+		Impact:      impact,
+		Confidence:  0.8,
+		Data:        scan.Findings,
+	})
+	result.Recommendations = append(result.Recommendations, models.Recommendation{
+		ID: "local-secrets", Type: "security", Category: "secrets",
+		Title:       "Revoke and remove leaked credentials",
+		Description: "Rotate the exposed keys before removing them: they stay in the git history. Load them from environment variables and add .env to .gitignore.",
+		Priority:    "high", Effort: "low", Impact: "high",
+	})
+}
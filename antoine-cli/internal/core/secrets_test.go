@@ -0,0 +1,164 @@
+package core
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"antoine-cli/internal/config"
+	"antoine-cli/internal/models"
+)
+
+// Valores con la forma de credenciales reales, partidos para que no los detecten otros escáneres
+var (
+	testGitHubToken = "ghp_" + "Xk9QmW2vLr7TzP4bN8sYcF1gH6jD3aE5uK0o"
+	testPassword    = "Zq8" + "mV2-xR7tLp4/Wn9Bk"
+)
+
+func writeTestFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		full := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(full), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(full, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestSecretFingerprint(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	key, err := loadSecretKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := loadSecretKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		a, b     string
+		keyA     []byte
+		keyB     []byte
+		wantSame bool
+	}{
+		{"same value, same install", testGitHubToken, testGitHubToken, key, again, true},
+		{"different values", testGitHubToken, testPassword, key, key, false},
+		{"different installs", testGitHubToken, testGitHubToken, key, []byte("another install"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := secretFingerprint(tt.keyA, tt.a), secretFingerprint(tt.keyB, tt.b)
+			if (a == b) != tt.wantSame {
+				t.Errorf("fingerprints %s and %s, want same %v", a, b, tt.wantSame)
+			}
+			if !fingerprintPattern.MatchString(a) {
+				t.Errorf("fingerprint %q does not match the allowlist format", a)
+			}
+		})
+	}
+}
+
+func TestScanSecretContentRedaction(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		line     string
+		rule     string
+		redacted string
+	}{
+		{"provider prefix is kept", "config.js", `const token = "` + testGitHubToken + `"`, "github-token", "ghp_************"},
+		{"generic secret is fully hidden", "config.js", `const apiKey = "` + testPassword + `"`, "generic-secret", "********"},
+		{"env value is fully hidden", ".env", "DB_PASSWORD=" + testPassword, "env-file", "********"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings, _ := scanSecretContent(tt.file, []byte(tt.line+"\n"), &SecretAllowlist{}, []byte("key"))
+			if len(findings) != 1 {
+				t.Fatalf("findings = %+v, want one", findings)
+			}
+			if findings[0].Rule != tt.rule || findings[0].Redacted != tt.redacted {
+				t.Errorf("finding = %s %q, want %s %q", findings[0].Rule, findings[0].Redacted, tt.rule, tt.redacted)
+			}
+		})
+	}
+}
+
+func TestScanSecretsTrackedIgnoredFiles(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	t.Setenv("HOME", t.TempDir())
+
+	root := t.TempDir()
+	leak := `token = "` + testGitHubToken + `"` + "\n"
+	writeTestFiles(t, root, map[string]string{
+		"config/prod.py":  leak,
+		"settings.py":     leak,
+		"README.md":       "# demo\n",
+		".gitignore":      "",
+		"build/output.py": leak,
+	})
+	git := func(args ...string) {
+		t.Helper()
+		cmd := exec.Command("git", append([]string{"-c", "user.name=Ada", "-c", "user.email=ada@example.com"}, args...)...)
+		cmd.Dir = root
+		if output, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, output)
+		}
+	}
+	git("init", "-q")
+	git("add", "config/prod.py", "settings.py", "README.md")
+	git("commit", "-q", "-m", "initial")
+
+	// Ignorarlos después no los saca del repositorio; build/ nunca se subió
+	writeTestFiles(t, root, map[string]string{".gitignore": "config/\nsettings.py\nbuild/\n"})
+
+	scan, err := ScanSecrets(context.Background(), root, SecretScanOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := make(map[string]bool)
+	for _, finding := range scan.Findings {
+		got[finding.File] = true
+	}
+	for _, file := range []string{"config/prod.py", "settings.py"} {
+		if !got[file] {
+			t.Errorf("tracked file %s was not scanned", file)
+		}
+	}
+	if got["build/output.py"] {
+		t.Error("untracked ignored file was scanned")
+	}
+}
+
+func TestAnalyzeLocalRepositorySecretScanWarning(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	root := t.TempDir()
+	writeTestFiles(t, root, map[string]string{
+		"main.go":           "package main\n\nfunc main() {}\n",
+		SecretAllowlistFile: "regex:([unclosed\n",
+	})
+
+	analytics, err := NewAnalyticsManagerAt(filepath.Join(t.TempDir(), "metrics.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &AntoineClient{config: &config.Config{}, analytics: analytics}
+
+	result, err := client.AnalyzeLocalRepository(context.Background(), root, &models.AnalysisOptions{})
+	if err != nil {
+		t.Fatalf("analysis failed: %v", err)
+	}
+	if message, _ := result.Metadata["secret_scan_error"].(string); !strings.Contains(message, "invalid regex") {
+		t.Errorf("secret_scan_error = %q", message)
+	}
+}
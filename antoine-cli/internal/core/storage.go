@@ -0,0 +1,141 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// dataRoot es el directorio base donde Antoine guarda su estado local
+const dataRoot = ".antoine"
+
+// DataPath devuelve una ruta dentro de ~/.antoine, creando el directorio padre si hace falta
+func DataPath(parts ...string) (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve home directory: %w", err)
+	}
+
+	path := filepath.Join(append([]string{home, dataRoot}, parts...)...)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", fmt.Errorf("failed to create data directory: %w", err)
+	}
+
+	return path, nil
+}
+
+// readJSONFile carga un archivo JSON en out. Devuelve false si el archivo no existe.
+func readJSONFile(path string, out interface{}) (bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	if err := json.Unmarshal(data, out); err != nil {
+		return false, fmt.Errorf("failed to decode %s: %w", path, err)
+	}
+
+	return true, nil
+}
+
+// writeJSONFile guarda value como JSON de forma atómica (archivo temporal + rename)
+func writeJSONFile(path string, value interface{}) error {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", path, err)
+	}
+
+	// Un temporal por proceso: dos procesos que guardan a la vez no comparten el .tmp
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// Un lock más antiguo que lockStale es de un proceso que murió sin liberarlo
+const (
+	lockTimeout = 10 * time.Second
+	lockStale   = 30 * time.Second
+)
+
+// lockFile toma un lock entre procesos sobre path creando path.lock en exclusiva.
+// Devuelve la función que lo libera.
+func lockFile(path string) (func(), error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create directory for %s: %w", path, err)
+	}
+
+	lock := path + ".lock"
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		file, err := os.OpenFile(lock, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			file.WriteString(strconv.Itoa(os.Getpid()))
+			file.Close()
+			return func() { os.Remove(lock) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("failed to lock %s: %w", path, err)
+		}
+		if info, err := os.Stat(lock); err == nil && time.Since(info.ModTime()) > lockStale {
+			os.Remove(lock)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("timed out waiting for lock on %s", path)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
+
+// updateJSONFile relee path en value, aplica update y guarda el resultado con el archivo
+// bloqueado, de forma que los cambios de otros procesos no se pierden. value debe llegar vacío;
+// update recibe si el archivo existía y puede devolver errSkipWrite para no guardar.
+func updateJSONFile(path string, value interface{}, update func(exists bool) error) error {
+	unlock, err := lockFile(path)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	exists, err := readJSONFile(path, value)
+	if err != nil {
+		return err
+	}
+	if err := update(exists); err != nil {
+		if errors.Is(err, errSkipWrite) {
+			return nil
+		}
+		return err
+	}
+	return writeJSONFile(path, value)
+}
+
+// errSkipWrite hace que updateJSONFile termine sin reescribir el archivo
+var errSkipWrite = errors.New("skip write")
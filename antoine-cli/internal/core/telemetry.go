@@ -0,0 +1,668 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"antoine-cli/internal/config"
+	"antoine-cli/internal/utils"
+)
+
+// maxQueuedEvents limita el tamaño de la cola en disco; se descartan los eventos más antiguos
+const maxQueuedEvents = 10000
+
+// maxRetryDelay limita la espera entre intentos cuando el endpoint no responde
+const maxRetryDelay = 24 * time.Hour
+
+// flushClaim es el margen que tiene un envío ya lanzado antes de que otro proceso lo repita,
+// aunque la cola tenga un lote completo
+const flushClaim = 2 * time.Minute
+
+// TelemetryEvent es un evento de uso tipado listo para exportar
+type TelemetryEvent struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"` // search, analysis, trend
+	Name      string    `json:"name"`
+	Query     string    `json:"query,omitempty"`
+	RepoURL   string    `json:"repo_url,omitempty"`
+	Count     int       `json:"count"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// TelemetryBatch es el cuerpo que se envía al endpoint de analytics
+type TelemetryBatch struct {
+	Client    string           `json:"client"`
+	Version   string           `json:"version"`
+	InstallID string           `json:"install_id,omitempty"`
+	SentAt    time.Time        `json:"sent_at"`
+	Events    []TelemetryEvent `json:"events"`
+}
+
+// TelemetryOptions configura el pipeline de exportación
+type TelemetryOptions struct {
+	Endpoint      string
+	BatchSize     int
+	FlushInterval time.Duration
+	Anonymous     bool
+	Version       string
+	UserAgent     string
+	QueuePath     string
+	StatePath     string
+	HTTPClient    *http.Client
+}
+
+// telemetryState guarda datos del pipeline entre ejecuciones
+type telemetryState struct {
+	InstallID   string    `json:"install_id"`
+	Salt        string    `json:"salt"`                   // clave HMAC de la anonimización, propia de la instalación
+	ConsentedAt time.Time `json:"consented_at,omitempty"` // cero: el usuario no aceptó exportar
+	LastFlush   time.Time `json:"last_flush"`             // último envío correcto
+	LastAttempt time.Time `json:"last_attempt"`
+	Failures    int       `json:"failures"` // intentos fallidos seguidos
+}
+
+// TelemetryPipeline encola eventos en disco y los envía por lotes a un endpoint HTTP
+type TelemetryPipeline struct {
+	options TelemetryOptions
+	state   telemetryState
+	stop    chan struct{}
+	mu      sync.Mutex
+}
+
+// TelemetryOptionsFromConfig construye las opciones a partir de AnalyticsConfig
+func TelemetryOptionsFromConfig(cfg *config.Config) (TelemetryOptions, error) {
+	queuePath, err := DataPath("analytics", "queue.jsonl")
+	if err != nil {
+		return TelemetryOptions{}, err
+	}
+	statePath, err := DataPath("analytics", "exporter.json")
+	if err != nil {
+		return TelemetryOptions{}, err
+	}
+
+	interval, err := time.ParseDuration(cfg.Analytics.FlushInterval)
+	if err != nil || interval <= 0 {
+		interval = 5 * time.Minute
+	}
+
+	return TelemetryOptions{
+		Endpoint:      cfg.Analytics.Endpoint,
+		BatchSize:     cfg.Analytics.BatchSize,
+		FlushInterval: interval,
+		Anonymous:     cfg.Analytics.Anonymous,
+		Version:       cfg.App.Version,
+		UserAgent:     cfg.API.UserAgent,
+		QueuePath:     queuePath,
+		StatePath:     statePath,
+	}, nil
+}
+
+// NewTelemetryPipeline crea el pipeline y carga su estado persistido
+func NewTelemetryPipeline(options TelemetryOptions) (*TelemetryPipeline, error) {
+	if options.QueuePath == "" {
+		return nil, fmt.Errorf("telemetry queue path cannot be empty")
+	}
+	if options.BatchSize <= 0 {
+		options.BatchSize = 100
+	}
+	if options.HTTPClient == nil {
+		options.HTTPClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	tp := &TelemetryPipeline{options: options}
+	if options.StatePath != "" {
+		if _, err := readJSONFile(options.StatePath, &tp.state); err != nil {
+			return nil, err
+		}
+	}
+	if tp.state.InstallID == "" || tp.state.Salt == "" {
+		salt, err := randomSalt()
+		if err != nil {
+			return nil, err
+		}
+		err = tp.updateStateLocked(func(state *telemetryState) {
+			if state.InstallID == "" {
+				state.InstallID = utils.GenerateUUID()
+				// El primer envío espera un intervalo completo
+				state.LastAttempt = time.Now()
+			}
+			if state.Salt == "" {
+				state.Salt = salt
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tp, nil
+}
+
+func randomSalt() (string, error) {
+	salt := make([]byte, 32)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+	return hex.EncodeToString(salt), nil
+}
+
+// Consented indica si el usuario aceptó exportar analytics ('antoine analytics opt-in')
+func (tp *TelemetryPipeline) Consented() bool {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	return !tp.state.ConsentedAt.IsZero()
+}
+
+// SetConsent guarda la decisión del usuario sobre exportar analytics
+func (tp *TelemetryPipeline) SetConsent(consent bool) error {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	return tp.updateStateLocked(func(state *telemetryState) {
+		state.ConsentedAt = time.Time{}
+		if consent {
+			state.ConsentedAt = time.Now()
+		}
+	})
+}
+
+// Enqueue añade un evento a la cola en disco
+func (tp *TelemetryPipeline) Enqueue(event TelemetryEvent) error {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	if event.ID == "" {
+		event.ID = utils.GenerateUUID()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed to encode telemetry event: %w", err)
+	}
+
+	unlock, err := lockFile(tp.options.QueuePath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	file, err := os.OpenFile(tp.options.QueuePath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open telemetry queue: %w", err)
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed to write telemetry queue: %w", err)
+	}
+
+	return nil
+}
+
+// Pending devuelve los eventos que aún no se han enviado
+func (tp *TelemetryPipeline) Pending() ([]TelemetryEvent, error) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	return tp.readQueueLocked()
+}
+
+// Batches devuelve los lotes que se enviarían, ya anonimizados si corresponde
+func (tp *TelemetryPipeline) Batches() ([]TelemetryBatch, error) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	events, err := tp.readQueueLocked()
+	if err != nil {
+		return nil, err
+	}
+	return tp.batchesLocked(events), nil
+}
+
+// batchesLocked reparte events en lotes de BatchSize, anonimizados como en el envío
+func (tp *TelemetryPipeline) batchesLocked(events []TelemetryEvent) []TelemetryBatch {
+	var batches []TelemetryBatch
+	for start := 0; start < len(events); start += tp.options.BatchSize {
+		end := min(start+tp.options.BatchSize, len(events))
+		batches = append(batches, tp.buildBatch(events[start:end]))
+	}
+	return batches
+}
+
+// DryRun escribe en w los lotes que se enviarían sin contactar el endpoint
+func (tp *TelemetryPipeline) DryRun(w io.Writer) error {
+	batches, err := tp.Batches()
+	if err != nil {
+		return err
+	}
+	return tp.writeBatches(w, batches)
+}
+
+// Preview escribe en w los lotes que se enviarían con events, p. ej. el historial local antes
+// de aceptar la exportación, cuando la cola aún está vacía
+func (tp *TelemetryPipeline) Preview(w io.Writer, events []TelemetryEvent) error {
+	tp.mu.Lock()
+	prepared := make([]TelemetryEvent, len(events))
+	for i, event := range events {
+		if event.ID == "" {
+			event.ID = utils.GenerateUUID()
+		}
+		prepared[i] = event
+	}
+	batches := tp.batchesLocked(prepared)
+	tp.mu.Unlock()
+
+	return tp.writeBatches(w, batches)
+}
+
+func (tp *TelemetryPipeline) writeBatches(w io.Writer, batches []TelemetryBatch) error {
+	if len(batches) == 0 {
+		fmt.Fprintln(w, "No pending analytics events")
+		return nil
+	}
+
+	for i, batch := range batches {
+		data, err := json.MarshalIndent(batch, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to encode batch: %w", err)
+		}
+		fmt.Fprintf(w, "POST %s (batch %d/%d, %d events)\n%s\n", tp.options.Endpoint, i+1, len(batches), len(batch.Events), data)
+	}
+
+	return nil
+}
+
+// Flush envía todos los eventos pendientes por lotes. Los lotes enviados se eliminan
+// de la cola aunque un lote posterior falle; cada intento queda registrado para espaciar
+// los reintentos.
+func (tp *TelemetryPipeline) Flush(ctx context.Context) (int, error) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	if tp.options.Endpoint == "" {
+		return 0, fmt.Errorf("analytics endpoint is not configured")
+	}
+
+	events, err := tp.readQueueLocked()
+	if err != nil {
+		return 0, err
+	}
+
+	// La cola no se bloquea durante el envío: otros procesos pueden seguir encolando
+	sent := 0
+	var sendErr error
+	for sent < len(events) {
+		end := min(sent+tp.options.BatchSize, len(events))
+		if sendErr = tp.send(ctx, tp.buildBatch(events[sent:end])); sendErr != nil {
+			break
+		}
+		sent = end
+	}
+
+	if sent > 0 {
+		if err := tp.removeQueuedLocked(events[:sent]); err != nil {
+			return sent, err
+		}
+	}
+	if err := tp.recordAttemptLocked(sendErr == nil); err != nil {
+		utils.WithError(err).Debug("Failed to save analytics exporter state")
+	}
+	return sent, sendErr
+}
+
+// Due indica si toca enviar: hay eventos y venció el intervalo o hay un lote completo sin un
+// envío en curso (flushClaim). Tras un fallo se espera FlushInterval * 2^fallos (como mucho
+// maxRetryDelay) antes de reintentar.
+func (tp *TelemetryPipeline) Due() (bool, error) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	events, err := tp.readQueueLocked()
+	if err != nil || len(events) == 0 {
+		return false, err
+	}
+
+	if tp.state.Failures > 0 {
+		return time.Since(tp.state.LastAttempt) >= tp.retryDelayLocked(), nil
+	}
+	last := tp.state.LastFlush
+	if tp.state.LastAttempt.After(last) {
+		last = tp.state.LastAttempt
+	}
+	if len(events) >= tp.options.BatchSize {
+		return time.Since(tp.state.LastAttempt) >= flushClaim, nil
+	}
+	return time.Since(last) >= tp.options.FlushInterval, nil
+}
+
+// retryDelayLocked es la espera tras Failures intentos fallidos seguidos
+func (tp *TelemetryPipeline) retryDelayLocked() time.Duration {
+	delay := tp.options.FlushInterval
+	for i := 1; i < tp.state.Failures && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxRetryDelay)
+}
+
+// FlushIfDue envía la cola si Due lo indica
+func (tp *TelemetryPipeline) FlushIfDue(ctx context.Context) error {
+	due, err := tp.Due()
+	if err != nil || !due {
+		return err
+	}
+	if claimed, err := tp.claimFlush(); err != nil || !claimed {
+		return err
+	}
+
+	_, err = tp.Flush(ctx)
+	return err
+}
+
+// FlushInBackground lanza 'antoine analytics flush' en un proceso desacoplado si toca enviar,
+// de forma que la CLI termina sin esperar a la red
+func (tp *TelemetryPipeline) FlushInBackground() error {
+	due, err := tp.Due()
+	if err != nil || !due {
+		return err
+	}
+
+	claimed, err := tp.claimFlush()
+	if err != nil || !claimed {
+		return err
+	}
+
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to locate antoine executable: %w", err)
+	}
+	cmd := exec.Command(executable, "analytics", "flush", "--quiet")
+	cmd.Env = append(os.Environ(), "NO_COLOR=1")
+	detachProcess(cmd)
+	if err := cmd.Start(); err != nil {
+		return fmt.Errorf("failed to start analytics flush: %w", err)
+	}
+	return cmd.Process.Release()
+}
+
+// claimFlush registra el intento con el estado bloqueado antes de lanzar el envío. Si otro
+// proceso registró un intento después del que vio Due, ese envío ya está en marcha.
+func (tp *TelemetryPipeline) claimFlush() (bool, error) {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	seen := tp.state.LastAttempt
+	claimed := false
+	err := tp.updateStateLocked(func(state *telemetryState) {
+		if state.LastAttempt.After(seen) {
+			return
+		}
+		state.LastAttempt = time.Now()
+		claimed = true
+	})
+	return claimed, err
+}
+
+// Start lanza el envío periódico según FlushInterval hasta que se llame a Stop
+func (tp *TelemetryPipeline) Start() {
+	tp.mu.Lock()
+	if tp.stop != nil {
+		tp.mu.Unlock()
+		return
+	}
+	tp.stop = make(chan struct{})
+	stop := tp.stop
+	tp.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(tp.options.FlushInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+				if err := tp.FlushIfDue(ctx); err != nil {
+					utils.WithError(err).Debug("Periodic analytics flush failed")
+				}
+				cancel()
+			}
+		}
+	}()
+}
+
+// Stop detiene el envío periódico
+func (tp *TelemetryPipeline) Stop() {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	if tp.stop != nil {
+		close(tp.stop)
+		tp.stop = nil
+	}
+}
+
+// Clear descarta todos los eventos pendientes
+func (tp *TelemetryPipeline) Clear() error {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	unlock, err := lockFile(tp.options.QueuePath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	return tp.writeQueueLocked(nil)
+}
+
+// LastFlush devuelve el momento del último envío correcto
+func (tp *TelemetryPipeline) LastFlush() time.Time {
+	tp.mu.Lock()
+	defer tp.mu.Unlock()
+
+	return tp.state.LastFlush
+}
+
+func (tp *TelemetryPipeline) buildBatch(events []TelemetryEvent) TelemetryBatch {
+	batch := TelemetryBatch{
+		Client:  "antoine-cli",
+		Version: tp.options.Version,
+		SentAt:  time.Now(),
+		Events:  make([]TelemetryEvent, len(events)),
+	}
+
+	copy(batch.Events, events)
+	if tp.options.Anonymous {
+		for i := range batch.Events {
+			batch.Events[i].Query = anonymizeValue(tp.state.Salt, batch.Events[i].Query)
+			batch.Events[i].RepoURL = anonymizeValue(tp.state.Salt, batch.Events[i].RepoURL)
+		}
+	} else {
+		batch.InstallID = tp.state.InstallID
+	}
+
+	return batch
+}
+
+func (tp *TelemetryPipeline) send(ctx context.Context, batch TelemetryBatch) error {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return fmt.Errorf("failed to encode batch: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tp.options.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create analytics request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if tp.options.UserAgent != "" {
+		req.Header.Set("User-Agent", tp.options.UserAgent)
+	}
+
+	start := time.Now()
+	resp, err := tp.options.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send analytics batch: %w", err)
+	}
+	defer resp.Body.Close()
+
+	utils.LogHTTPRequest(http.MethodPost, tp.options.Endpoint, resp.StatusCode, time.Since(start))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("analytics endpoint returned %s", resp.Status)
+	}
+
+	return nil
+}
+
+func (tp *TelemetryPipeline) readQueueLocked() ([]TelemetryEvent, error) {
+	file, err := os.Open(tp.options.QueuePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to open telemetry queue: %w", err)
+	}
+	defer file.Close()
+
+	var events []TelemetryEvent
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var event TelemetryEvent
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			// Una línea corrupta (p. ej. escritura interrumpida) no bloquea la cola
+			continue
+		}
+		events = append(events, event)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read telemetry queue: %w", err)
+	}
+
+	if len(events) > maxQueuedEvents {
+		events = events[len(events)-maxQueuedEvents:]
+	}
+
+	return events, nil
+}
+
+func (tp *TelemetryPipeline) writeQueueLocked(events []TelemetryEvent) error {
+	var buf bytes.Buffer
+	for _, event := range events {
+		line, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to encode telemetry event: %w", err)
+		}
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(tp.options.QueuePath), filepath.Base(tp.options.QueuePath)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write telemetry queue: %w", err)
+	}
+	if _, err := tmp.Write(buf.Bytes()); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("failed to write telemetry queue: %w", err)
+	}
+	tmp.Close()
+
+	return os.Rename(tmp.Name(), tp.options.QueuePath)
+}
+
+// removeQueuedLocked quita de la cola los eventos enviados, conservando los que otros
+// procesos encolaron mientras tanto
+func (tp *TelemetryPipeline) removeQueuedLocked(sent []TelemetryEvent) error {
+	unlock, err := lockFile(tp.options.QueuePath)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	ids := make(map[string]bool, len(sent))
+	for _, event := range sent {
+		ids[event.ID] = true
+	}
+	events, err := tp.readQueueLocked()
+	if err != nil {
+		return err
+	}
+	remaining := events[:0]
+	for _, event := range events {
+		if !ids[event.ID] {
+			remaining = append(remaining, event)
+		}
+	}
+	return tp.writeQueueLocked(remaining)
+}
+
+// recordAttemptLocked guarda el resultado de un envío
+func (tp *TelemetryPipeline) recordAttemptLocked(success bool) error {
+	return tp.updateStateLocked(func(state *telemetryState) {
+		state.LastAttempt = time.Now()
+		if success {
+			state.LastFlush = state.LastAttempt
+			state.Failures = 0
+		} else {
+			state.Failures++
+		}
+	})
+}
+
+// updateStateLocked aplica update sobre el estado guardado en disco y lo recarga
+func (tp *TelemetryPipeline) updateStateLocked(update func(*telemetryState)) error {
+	if tp.options.StatePath == "" {
+		update(&tp.state)
+		return nil
+	}
+
+	var stored telemetryState
+	err := updateJSONFile(tp.options.StatePath, &stored, func(bool) error {
+		update(&stored)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	tp.state = stored
+	return nil
+}
+
+// anonymizeValue reemplaza un valor identificable por un HMAC con la clave de la instalación:
+// es estable para agrupar eventos, pero sin la clave no se puede comprobar contra un diccionario
+func anonymizeValue(salt, value string) string {
+	if value == "" {
+		return ""
+	}
+
+	mac := hmac.New(sha256.New, []byte(salt))
+	mac.Write([]byte(strings.ToLower(strings.TrimSpace(value))))
+	return "hmac:" + hex.EncodeToString(mac.Sum(nil)[:16])
+}
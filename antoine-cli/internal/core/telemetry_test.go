@@ -0,0 +1,363 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// stubCollector registra los lotes recibidos y falla las primeras failures peticiones
+type stubCollector struct {
+	mu       sync.Mutex
+	failures int
+	batches  []TelemetryBatch
+	bodies   []string
+}
+
+func (sc *stubCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+
+	if sc.failures > 0 {
+		sc.failures--
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	var batch TelemetryBatch
+	if err := json.Unmarshal(body, &batch); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	sc.batches = append(sc.batches, batch)
+	sc.bodies = append(sc.bodies, string(body))
+	w.WriteHeader(http.StatusAccepted)
+}
+
+func newTestPipeline(t *testing.T, dir, endpoint string, batchSize int) *TelemetryPipeline {
+	t.Helper()
+	tp, err := NewTelemetryPipeline(TelemetryOptions{
+		Endpoint:      endpoint,
+		BatchSize:     batchSize,
+		FlushInterval: time.Hour,
+		Anonymous:     true,
+		QueuePath:     filepath.Join(dir, "queue.jsonl"),
+		StatePath:     filepath.Join(dir, "exporter.json"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tp
+}
+
+func enqueueEvents(t *testing.T, tp *TelemetryPipeline, n int) {
+	t.Helper()
+	for i := 0; i < n; i++ {
+		err := tp.Enqueue(TelemetryEvent{Type: "search", Name: "hackathons", Query: fmt.Sprintf("query %d", i), Count: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestTelemetryFlushBatching(t *testing.T) {
+	tests := []struct {
+		name      string
+		events    int
+		batchSize int
+		batches   []int
+	}{
+		{"empty queue", 0, 2, nil},
+		{"partial batch", 1, 2, []int{1}},
+		{"exact batches", 4, 2, []int{2, 2}},
+		{"remainder", 5, 2, []int{2, 2, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := &stubCollector{}
+			server := httptest.NewServer(collector)
+			defer server.Close()
+
+			tp := newTestPipeline(t, t.TempDir(), server.URL, tt.batchSize)
+			enqueueEvents(t, tp, tt.events)
+
+			sent, err := tp.Flush(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if sent != tt.events {
+				t.Errorf("sent = %d, want %d", sent, tt.events)
+			}
+			if len(collector.batches) != len(tt.batches) {
+				t.Fatalf("batches = %d, want %d", len(collector.batches), len(tt.batches))
+			}
+			for i, batch := range collector.batches {
+				if len(batch.Events) != tt.batches[i] {
+					t.Errorf("batch %d has %d events, want %d", i, len(batch.Events), tt.batches[i])
+				}
+			}
+			if pending, _ := tp.Pending(); len(pending) != 0 {
+				t.Errorf("pending after flush = %d, want 0", len(pending))
+			}
+		})
+	}
+}
+
+func TestTelemetryFlushRetry(t *testing.T) {
+	tests := []struct {
+		name        string
+		failures    int
+		sentFirst   int
+		pendingLeft int
+	}{
+		{"first batch fails", 1, 0, 5},
+		{"all batches accepted", 0, 5, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			collector := &stubCollector{failures: tt.failures}
+			server := httptest.NewServer(collector)
+			defer server.Close()
+
+			tp := newTestPipeline(t, t.TempDir(), server.URL, 2)
+			enqueueEvents(t, tp, 5)
+
+			sent, err := tp.Flush(context.Background())
+			if (err != nil) != (tt.failures > 0) {
+				t.Fatalf("err = %v, want failure %v", err, tt.failures > 0)
+			}
+			if sent != tt.sentFirst {
+				t.Errorf("sent = %d, want %d", sent, tt.sentFirst)
+			}
+			pending, _ := tp.Pending()
+			if len(pending) != tt.pendingLeft {
+				t.Errorf("pending = %d, want %d", len(pending), tt.pendingLeft)
+			}
+			if tt.failures == 0 {
+				return
+			}
+
+			// Tras un fallo no toca reintentar hasta que pase la espera
+			if due, _ := tp.Due(); due {
+				t.Error("Due() = true right after a failed flush")
+			}
+			if tp.state.Failures != 1 || tp.state.LastAttempt.IsZero() {
+				t.Errorf("state = %+v, want one recorded failure", tp.state)
+			}
+
+			// El reintento envía lo que quedó en la cola
+			sent, err = tp.Flush(context.Background())
+			if err != nil || sent != 5 {
+				t.Fatalf("retry sent %d, err %v; want 5, nil", sent, err)
+			}
+			if tp.state.Failures != 0 || tp.LastFlush().IsZero() {
+				t.Errorf("state after retry = %+v", tp.state)
+			}
+		})
+	}
+}
+
+func TestTelemetryDue(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name   string
+		events int
+		state  telemetryState
+		want   bool
+	}{
+		{"empty queue", 0, telemetryState{}, false},
+		{"recent attempt, partial batch", 1, telemetryState{LastAttempt: now}, false},
+		{"flush in flight, full batch", 3, telemetryState{LastAttempt: now}, false},
+		{"full batch after the claim window", 3, telemetryState{LastAttempt: now.Add(-flushClaim - time.Second)}, true},
+		{"interval elapsed", 1, telemetryState{LastAttempt: now.Add(-2 * time.Hour), LastFlush: now.Add(-2 * time.Hour)}, true},
+		{"backing off after failures", 3, telemetryState{LastAttempt: now.Add(-3 * time.Hour), Failures: 3}, false},
+		{"backoff elapsed", 1, telemetryState{LastAttempt: now.Add(-5 * time.Hour), Failures: 3}, true},
+		{"backoff is capped", 1, telemetryState{LastAttempt: now.Add(-maxRetryDelay), Failures: 40}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp := newTestPipeline(t, t.TempDir(), "http://127.0.0.1:0", 3)
+			enqueueEvents(t, tp, tt.events)
+			tp.state = tt.state
+
+			due, err := tp.Due()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if due != tt.want {
+				t.Errorf("Due() = %v, want %v", due, tt.want)
+			}
+		})
+	}
+}
+
+func TestTelemetryQueueTrimming(t *testing.T) {
+	tests := []struct {
+		name   string
+		events int
+		want   int
+	}{
+		{"under limit", 10, 10},
+		{"at limit", maxQueuedEvents, maxQueuedEvents},
+		{"over limit", maxQueuedEvents + 25, maxQueuedEvents},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp := newTestPipeline(t, t.TempDir(), "", 100)
+
+			events := make([]TelemetryEvent, tt.events)
+			for i := range events {
+				events[i] = TelemetryEvent{ID: fmt.Sprintf("event-%d", i), Type: "search"}
+			}
+			if err := tp.writeQueueLocked(events); err != nil {
+				t.Fatal(err)
+			}
+
+			pending, err := tp.Pending()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(pending) != tt.want {
+				t.Fatalf("pending = %d, want %d", len(pending), tt.want)
+			}
+			// Se conservan los más recientes
+			if last := pending[len(pending)-1].ID; last != fmt.Sprintf("event-%d", tt.events-1) {
+				t.Errorf("newest event = %s", last)
+			}
+		})
+	}
+}
+
+func TestTelemetryAnonymization(t *testing.T) {
+	collector := &stubCollector{}
+	server := httptest.NewServer(collector)
+	defer server.Close()
+
+	first := newTestPipeline(t, t.TempDir(), server.URL, 10)
+	second := newTestPipeline(t, t.TempDir(), server.URL, 10)
+	for _, tp := range []*TelemetryPipeline{first, second} {
+		if err := tp.Enqueue(TelemetryEvent{Type: "analysis", Query: "secret project", RepoURL: "https://github.com/acme/secret"}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tp.Flush(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for _, body := range collector.bodies {
+		if strings.Contains(body, "secret") {
+			t.Errorf("raw value leaked in batch: %s", body)
+		}
+	}
+	a, b := collector.batches[0].Events[0], collector.batches[1].Events[0]
+	if !strings.HasPrefix(a.Query, "hmac:") {
+		t.Errorf("query = %q, want hmac value", a.Query)
+	}
+	// Cada instalación tiene su propia clave: el mismo valor no se puede cruzar
+	if a.Query == b.Query || a.RepoURL == b.RepoURL {
+		t.Error("anonymized values match across installs")
+	}
+	if got := anonymizeValue(first.state.Salt, " Secret Project "); got != a.Query {
+		t.Errorf("anonymization is not stable within an install: %q vs %q", got, a.Query)
+	}
+}
+
+func TestTelemetryConsent(t *testing.T) {
+	dir := t.TempDir()
+	tp := newTestPipeline(t, dir, "", 10)
+	if tp.Consented() {
+		t.Fatal("new install is consented by default")
+	}
+	if err := tp.SetConsent(true); err != nil {
+		t.Fatal(err)
+	}
+
+	// La decisión y la clave sobreviven entre procesos
+	reloaded := newTestPipeline(t, dir, "", 10)
+	if !reloaded.Consented() {
+		t.Error("consent was not persisted")
+	}
+	if reloaded.state.Salt != tp.state.Salt || reloaded.state.InstallID != tp.state.InstallID {
+		t.Error("install identity changed on reload")
+	}
+
+	if err := reloaded.SetConsent(false); err != nil {
+		t.Fatal(err)
+	}
+	if newTestPipeline(t, dir, "", 10).Consented() {
+		t.Error("opt-out was not persisted")
+	}
+}
+
+func TestTelemetryClaimFlush(t *testing.T) {
+	dir := t.TempDir()
+	first := newTestPipeline(t, dir, "http://127.0.0.1:0", 2)
+	enqueueEvents(t, first, 2)
+	err := first.updateStateLocked(func(state *telemetryState) { state.LastAttempt = time.Now().Add(-time.Hour) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	second := newTestPipeline(t, dir, "http://127.0.0.1:0", 2)
+
+	// Los dos procesos ven el lote completo, pero solo uno lanza el envío
+	for _, tp := range []*TelemetryPipeline{first, second} {
+		if due, err := tp.Due(); err != nil || !due {
+			t.Fatalf("Due() = %v, %v; want true", due, err)
+		}
+	}
+	claimed, err := first.claimFlush()
+	if err != nil || !claimed {
+		t.Fatalf("first claim = %v, %v; want true", claimed, err)
+	}
+	if claimed, err := second.claimFlush(); err != nil || claimed {
+		t.Errorf("second claim = %v, %v; want false", claimed, err)
+	}
+	if due, _ := newTestPipeline(t, dir, "http://127.0.0.1:0", 2).Due(); due {
+		t.Error("Due() = true while the claimed flush is in flight")
+	}
+}
+
+func TestTelemetryPreview(t *testing.T) {
+	tp := newTestPipeline(t, t.TempDir(), "https://collector.example/v1", 2)
+	events := []TelemetryEvent{
+		{Type: EventSearch, Name: "hackathons", Query: "secret idea", Count: 4},
+		{Type: EventAnalysis, Name: "local", RepoURL: "https://github.com/acme/secret", Count: 1},
+		{Type: EventCache, Name: "trends", Count: 1},
+	}
+
+	var out strings.Builder
+	if err := tp.Preview(&out, events); err != nil {
+		t.Fatal(err)
+	}
+	preview := out.String()
+	if strings.Count(preview, "POST https://collector.example/v1") != 2 {
+		t.Errorf("preview does not show two batches:\n%s", preview)
+	}
+	// Se anonimiza igual que en el envío y la cola no cambia
+	if strings.Contains(preview, "secret") || strings.Contains(preview, tp.state.InstallID) {
+		t.Errorf("preview leaks identifiable values:\n%s", preview)
+	}
+	if !strings.Contains(preview, anonymizeValue(tp.state.Salt, "secret idea")) {
+		t.Errorf("preview does not show the anonymized query:\n%s", preview)
+	}
+	if pending, _ := tp.Pending(); len(pending) != 0 {
+		t.Errorf("preview queued %d events", len(pending))
+	}
+}
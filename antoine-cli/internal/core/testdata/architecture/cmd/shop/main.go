@@ -0,0 +1,11 @@
+package main
+
+import (
+	"fmt"
+
+	"example.com/shop/internal/core"
+)
+
+func main() {
+	fmt.Println(core.Total())
+}
@@ -0,0 +1,10 @@
+package core
+
+import (
+	"example.com/shop/internal/models"
+	"example.com/shop/internal/storage"
+)
+
+func Total() int {
+	return len(storage.Orders()) + models.Order{}.Items
+}
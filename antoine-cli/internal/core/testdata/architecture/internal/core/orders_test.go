@@ -0,0 +1,4 @@
+package core
+
+// Los tests no cuentan: este import sería una violación de capa
+import _ "example.com/shop/cmd/shop"
@@ -0,0 +1,5 @@
+package models
+
+type Order struct {
+	Items int
+}
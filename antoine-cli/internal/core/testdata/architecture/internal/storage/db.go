@@ -0,0 +1,8 @@
+package storage
+
+// Ciclo: storage importa core, que importa storage
+import "example.com/shop/internal/core"
+
+func Orders() []int {
+	return make([]int, core.Total())
+}
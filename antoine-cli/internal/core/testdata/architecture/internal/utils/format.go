@@ -0,0 +1,8 @@
+package utils
+
+// Violación de capa: shared importa domain
+import "example.com/shop/internal/models"
+
+func Describe(order models.Order) string {
+	return "order"
+}
@@ -0,0 +1,4 @@
+import React from "react";
+import { format } from "./lib/format.js";
+
+export const App = () => format(React.version);
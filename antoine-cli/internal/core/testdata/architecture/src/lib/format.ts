@@ -0,0 +1,3 @@
+export function format(value: string): string {
+  return value.trim();
+}
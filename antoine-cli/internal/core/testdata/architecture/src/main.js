@@ -0,0 +1,3 @@
+const { format } = require("@/lib/format");
+
+console.log(format("main"));
@@ -0,0 +1,6 @@
+package gen
+
+// Otro módulo: sus imports de example.com/shop no son internos
+import "example.com/shop/internal/models"
+
+var Sample = models.Order{}
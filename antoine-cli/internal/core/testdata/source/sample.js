@@ -0,0 +1,25 @@
+// Utilidades de ejemplo
+/* bloque
+   de comentario */
+const ratio = total / count / 2; // división, no regex
+const pattern = /\/\*not a comment*\//g;
+const message = `value: ${ratio > 1 ? `nested ${"}"}` : "low"} // not a comment`;
+
+function parse(input) {
+  if (input.match(/^\d+$/) && input.length > 0) {
+    return input / 10;
+  }
+  return 0;
+}
+
+class Parser {
+  constructor(options) {
+    this.options = options;
+  }
+
+  run(text) {
+    return text.split(/,/).map((part) => part.trim());
+  }
+}
+
+const helper = (x) => x * 2;
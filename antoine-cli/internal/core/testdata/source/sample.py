@@ -0,0 +1,31 @@
+"""Module docstring
+spanning lines."""
+# comentario
+import functools
+
+
+def decorator(fn):
+    @functools.wraps(fn)
+    def wrapper(*args):
+        return fn(*args)
+    return wrapper
+
+
+@decorator
+def greet(name):
+    '''Docstring with # hash and def fake():'''
+    text = """multi
+def not_a_function():
+    pass
+"""
+    return f"hi {name}" + text
+
+
+class Greeter:
+    @property
+    def name(self):
+        return "x"  # trailing
+
+    @staticmethod
+    def build():
+        return Greeter()
@@ -0,0 +1,25 @@
+//! Crate docs
+/* outer /* nested */ still comment */
+use std::fmt;
+
+pub struct Point<'a> {
+    name: &'a str,
+}
+
+enum Shape { Circle, Square }
+
+trait Area {
+    fn area(&self) -> f64;
+}
+
+impl<'a> fmt::Display for Point<'a> {
+    fn fmt(&self, f: &mut fmt::Formatter<'_>) -> fmt::Result {
+        let raw = r#"fn fake() { "quoted" }"#;
+        let c = '{';
+        write!(f, "{}{}{}", self.name, raw, c)
+    }
+}
+
+fn longest<'a>(a: &'a str, b: &'a str) -> &'a str {
+    if a.len() > b.len() { a } else { b }
+}
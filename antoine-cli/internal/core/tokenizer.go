@@ -0,0 +1,452 @@
+package core
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// tokenKind es el tipo de un token del lexer ligero
+type tokenKind int
+
+const (
+	tokenIdent tokenKind = iota // identificadores y palabras clave
+	tokenNumber
+	tokenString
+	tokenComment
+	tokenPunct
+)
+
+// sourceToken es un token con las líneas que ocupa (1-based)
+type sourceToken struct {
+	kind    tokenKind
+	text    string
+	line    int
+	endLine int
+}
+
+// lexerSyntax describe las particularidades léxicas de un lenguaje
+type lexerSyntax struct {
+	lineComments     []string
+	blockStart       string
+	blockEnd         string
+	nestedBlocks     bool   // /* /* */ */ (Rust)
+	templates        bool   // `...${expr}...` (JS/TS)
+	regexLiterals    bool   // /re/flags (JS/TS)
+	tripleQuotes     bool   // """...""" (Python)
+	multilineStrings bool   // cadenas normales que pueden cruzar líneas (Rust)
+	stringPrefixes   string // letras que pueden preceder a una comilla: rbfu (Python), br (Rust)
+	rawStrings       bool   // r#"..."# (Rust)
+	lifetimes        bool   // 'a (Rust)
+}
+
+// punctuators son los operadores de varios caracteres, del más largo al más corto
+var punctuators = []string{
+	">>>=", "===", "!==", "**=", "...", "??=", "&&=", "||=", ">>=", "<<=", ">>>",
+	"=>", "&&", "||", "??", "?.", "::", "->", "==", "!=", "<=", ">=", "++", "--",
+	"+=", "-=", "*=", "/=", "%=", "&=", "|=", "^=", "**", "<<", ">>",
+}
+
+// regexKeywords son las palabras tras las que una / empieza una expresión regular
+var regexKeywords = map[string]bool{
+	"return": true, "typeof": true, "instanceof": true, "in": true, "of": true, "new": true,
+	"delete": true, "void": true, "throw": true, "case": true, "do": true, "else": true,
+	"yield": true, "await": true,
+}
+
+type lexer struct {
+	src    string
+	pos    int
+	line   int
+	syntax lexerSyntax
+	tokens []sourceToken
+}
+
+// tokenize divide el código en tokens. Nunca falla: lo que no reconoce lo emite como puntuación.
+func tokenize(src []byte, syntax lexerSyntax) []sourceToken {
+	l := &lexer{src: string(src), line: 1, syntax: syntax}
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case c == '\n':
+			l.line++
+			l.pos++
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f' || c == '\v':
+			l.pos++
+		case l.hasLineComment():
+			end := strings.IndexByte(l.src[l.pos:], '\n')
+			if end < 0 {
+				end = len(l.src) - l.pos
+			}
+			l.emit(tokenComment, l.pos+end)
+		case l.syntax.blockStart != "" && strings.HasPrefix(l.src[l.pos:], l.syntax.blockStart):
+			l.scanBlockComment()
+		case c == '"' || c == '\'':
+			if c == '\'' && l.syntax.lifetimes && l.scanLifetime() {
+				continue
+			}
+			l.scanString(l.pos, l.pos, false)
+		case c == '`' && l.syntax.templates:
+			l.emit(tokenString, l.skipTemplate(l.pos))
+		case c == '/' && l.syntax.regexLiterals && l.regexAllowed():
+			if end, ok := l.skipRegex(l.pos); ok {
+				l.emit(tokenString, end)
+			} else {
+				l.scanPunct()
+			}
+		case isIdentStart(c) || c >= utf8.RuneSelf:
+			if l.scanPrefixedString() {
+				continue
+			}
+			end := l.pos
+			for end < len(l.src) {
+				r, size := utf8.DecodeRuneInString(l.src[end:])
+				if r != '_' && r != '$' && !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+					break
+				}
+				end += size
+			}
+			if end == l.pos { // carácter no ASCII que no es letra
+				_, size := utf8.DecodeRuneInString(l.src[l.pos:])
+				end += size
+				l.emit(tokenPunct, end)
+				continue
+			}
+			l.emit(tokenIdent, end)
+		case c >= '0' && c <= '9' || (c == '.' && l.pos+1 < len(l.src) && l.src[l.pos+1] >= '0' && l.src[l.pos+1] <= '9'):
+			end := l.pos + 1
+			for end < len(l.src) && (isIdentStart(l.src[end]) || (l.src[end] >= '0' && l.src[end] <= '9') || l.src[end] == '.') {
+				end++
+			}
+			l.emit(tokenNumber, end)
+		default:
+			l.scanPunct()
+		}
+	}
+	return l.tokens
+}
+
+// emit añade el token src[pos:end] y avanza contando los saltos de línea que contiene
+func (l *lexer) emit(kind tokenKind, end int) {
+	text := l.src[l.pos:end]
+	newlines := strings.Count(text, "\n")
+	l.tokens = append(l.tokens, sourceToken{kind: kind, text: text, line: l.line, endLine: l.line + newlines})
+	l.line += newlines
+	l.pos = end
+}
+
+func (l *lexer) hasLineComment() bool {
+	for _, prefix := range l.syntax.lineComments {
+		if strings.HasPrefix(l.src[l.pos:], prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *lexer) scanBlockComment() {
+	start, end := l.syntax.blockStart, l.syntax.blockEnd
+	depth := 0
+	i := l.pos
+	for i < len(l.src) {
+		switch {
+		case strings.HasPrefix(l.src[i:], start) && (depth == 0 || l.syntax.nestedBlocks):
+			depth++
+			i += len(start)
+		case strings.HasPrefix(l.src[i:], end):
+			depth--
+			i += len(end)
+			if depth == 0 {
+				l.emit(tokenComment, i)
+				return
+			}
+		default:
+			i++
+		}
+	}
+	l.emit(tokenComment, len(l.src))
+}
+
+// scanString lee una cadena cuya comilla está en quote; start incluye el prefijo (r, b, f...)
+func (l *lexer) scanString(start, quote int, raw bool) {
+	q := l.src[quote]
+	delimiter := string(q)
+	if l.syntax.tripleQuotes && strings.HasPrefix(l.src[quote:], strings.Repeat(delimiter, 3)) {
+		delimiter = strings.Repeat(delimiter, 3)
+	}
+
+	i := quote + len(delimiter)
+	for i < len(l.src) {
+		switch {
+		case l.src[i] == '\\' && !raw:
+			i += 2
+			continue
+		case strings.HasPrefix(l.src[i:], delimiter):
+			l.pos = start
+			l.emit(tokenString, i+len(delimiter))
+			return
+		case l.src[i] == '\n' && len(delimiter) == 1 && !l.syntax.multilineStrings:
+			// Cadena sin cerrar: termina en el salto de línea
+			l.pos = start
+			l.emit(tokenString, i)
+			return
+		}
+		i++
+	}
+	l.pos = start
+	l.emit(tokenString, len(l.src))
+}
+
+// scanPrefixedString reconoce cadenas con prefijo: r"..", f'..' (Python), b"..", r#".."# (Rust)
+func (l *lexer) scanPrefixedString() bool {
+	if l.syntax.stringPrefixes == "" {
+		return false
+	}
+	i := l.pos
+	for i < len(l.src) && i-l.pos < 3 && strings.IndexByte(l.syntax.stringPrefixes, l.src[i]) >= 0 {
+		i++
+	}
+	if i == l.pos || i >= len(l.src) {
+		return false
+	}
+	prefix := strings.ToLower(l.src[l.pos:i])
+	raw := strings.Contains(prefix, "r")
+
+	// Rust: r#"..."# con cualquier número de #
+	if l.syntax.rawStrings && raw && l.src[i] == '#' {
+		hashes := 0
+		for i+hashes < len(l.src) && l.src[i+hashes] == '#' {
+			hashes++
+		}
+		if i+hashes >= len(l.src) || l.src[i+hashes] != '"' {
+			return false
+		}
+		closing := "\"" + strings.Repeat("#", hashes)
+		end := strings.Index(l.src[i+hashes+1:], closing)
+		if end < 0 {
+			l.emit(tokenString, len(l.src))
+		} else {
+			l.emit(tokenString, i+hashes+1+end+len(closing))
+		}
+		return true
+	}
+
+	if l.src[i] != '"' && l.src[i] != '\'' {
+		return false
+	}
+	if l.src[i] == '\'' && l.syntax.lifetimes { // b'x' en Rust
+		if end, ok := l.charLiteralEnd(i); ok {
+			l.emit(tokenString, end)
+			return true
+		}
+		return false
+	}
+	l.scanString(l.pos, i, raw)
+	return true
+}
+
+// scanLifetime distingue en Rust un carácter ('a', '\n') de un lifetime ('a, 'static)
+func (l *lexer) scanLifetime() bool {
+	if end, ok := l.charLiteralEnd(l.pos); ok {
+		l.emit(tokenString, end)
+		return true
+	}
+	end := l.pos + 1
+	for end < len(l.src) && (isIdentStart(l.src[end]) || (l.src[end] >= '0' && l.src[end] <= '9')) {
+		end++
+	}
+	if end == l.pos+1 {
+		return false
+	}
+	l.emit(tokenIdent, end)
+	return true
+}
+
+func (l *lexer) charLiteralEnd(quote int) (int, bool) {
+	i := quote + 1
+	if i >= len(l.src) {
+		return 0, false
+	}
+	if l.src[i] == '\\' {
+		end := strings.IndexByte(l.src[i+1:], '\'')
+		if end < 0 || end > 10 {
+			return 0, false
+		}
+		return i + 1 + end + 1, true
+	}
+	_, size := utf8.DecodeRuneInString(l.src[i:])
+	if i+size < len(l.src) && l.src[i+size] == '\'' {
+		return i + size + 1, true
+	}
+	return 0, false
+}
+
+// skipTemplate devuelve el final de una plantilla `...` con sus ${...} anidados
+func (l *lexer) skipTemplate(start int) int {
+	i := start + 1
+	for i < len(l.src) {
+		switch {
+		case l.src[i] == '\\':
+			i += 2
+			continue
+		case l.src[i] == '`':
+			return i + 1
+		case strings.HasPrefix(l.src[i:], "${"):
+			i = l.skipTemplateExpression(i + 2)
+			continue
+		}
+		i++
+	}
+	return len(l.src)
+}
+
+func (l *lexer) skipTemplateExpression(i int) int {
+	depth := 1
+	for i < len(l.src) {
+		switch c := l.src[i]; c {
+		case '{':
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		case '`':
+			i = l.skipTemplate(i)
+			continue
+		case '"', '\'':
+			end := i + 1
+			for end < len(l.src) && l.src[end] != c && l.src[end] != '\n' {
+				if l.src[end] == '\\' {
+					end++
+				}
+				end++
+			}
+			i = end
+		}
+		i++
+	}
+	return len(l.src)
+}
+
+// regexAllowed indica si una / puede empezar una expresión regular según el token anterior
+func (l *lexer) regexAllowed() bool {
+	for i := len(l.tokens) - 1; i >= 0; i-- {
+		previous := l.tokens[i]
+		switch previous.kind {
+		case tokenComment:
+			continue
+		case tokenPunct:
+			return previous.text != ")" && previous.text != "]" && previous.text != "}"
+		case tokenIdent:
+			return regexKeywords[previous.text]
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+func (l *lexer) skipRegex(start int) (int, bool) {
+	inClass := false
+	for i := start + 1; i < len(l.src); i++ {
+		switch l.src[i] {
+		case '\\':
+			i++
+		case '\n':
+			return 0, false
+		case '[':
+			inClass = true
+		case ']':
+			inClass = false
+		case '/':
+			if inClass {
+				continue
+			}
+			if i == start+1 { // "//" es un comentario, no una expresión vacía
+				return 0, false
+			}
+			end := i + 1
+			for end < len(l.src) && isIdentStart(l.src[end]) {
+				end++
+			}
+			return end, true
+		}
+	}
+	return 0, false
+}
+
+func (l *lexer) scanPunct() {
+	for _, punct := range punctuators {
+		if strings.HasPrefix(l.src[l.pos:], punct) {
+			l.emit(tokenPunct, l.pos+len(punct))
+			return
+		}
+	}
+	l.emit(tokenPunct, l.pos+1)
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// significantTokens quita los comentarios
+func significantTokens(tokens []sourceToken) []sourceToken {
+	significant := make([]sourceToken, 0, len(tokens))
+	for _, token := range tokens {
+		if token.kind != tokenComment {
+			significant = append(significant, token)
+		}
+	}
+	return significant
+}
+
+// lineClasses clasifica cada línea: con código, solo comentario o en blanco
+type lineClasses struct {
+	code    []bool // índice = número de línea
+	comment []bool
+	total   int
+}
+
+func classifyLines(content []byte, tokens []sourceToken) lineClasses {
+	total := strings.Count(string(content), "\n")
+	if len(content) > 0 && content[len(content)-1] != '\n' {
+		total++
+	}
+	classes := lineClasses{code: make([]bool, total+2), comment: make([]bool, total+2), total: total}
+	for _, token := range tokens {
+		target := classes.code
+		if token.kind == tokenComment {
+			target = classes.comment
+		}
+		for line := token.line; line <= token.endLine && line < len(target); line++ {
+			target[line] = true
+		}
+	}
+	return classes
+}
+
+// apply rellena las cuentas de líneas de metrics
+func (c lineClasses) apply(metrics *FileMetrics) {
+	for line := 1; line <= c.total; line++ {
+		switch {
+		case c.code[line]:
+			metrics.Lines++
+		case c.comment[line]:
+			metrics.CommentLines++
+		default:
+			metrics.BlankLines++
+		}
+	}
+}
+
+// codeLinesBetween cuenta las líneas con código en [from, to]
+func (c lineClasses) codeLinesBetween(from, to int) int {
+	count := 0
+	for line := max(from, 1); line <= to && line <= c.total; line++ {
+		if c.code[line] {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// tokenSummary resume los tokens como tipo:texto, con I, N, S, C y P por tipo
+func tokenSummary(tokens []sourceToken) string {
+	parts := make([]string, len(tokens))
+	for i, token := range tokens {
+		parts[i] = string("INSCP"[token.kind]) + ":" + token.text
+	}
+	return strings.Join(parts, " ")
+}
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		name   string
+		syntax lexerSyntax
+		src    string
+		want   string
+	}{
+		{"js division", scriptSyntax, "a / b / 2", "I:a P:/ I:b P:/ N:2"},
+		{"js regex after paren", scriptSyntax, "s.split(/,/)", "I:s P:. I:split P:( S:/,/ P:)"},
+		{"js regex after return", scriptSyntax, `return /a\/b/i`, `I:return S:/a\/b/i`},
+		{"js regex with slash in class", scriptSyntax, "x = /[/*]/", "I:x P:= S:/[/*]/"},
+		{"js division after paren", scriptSyntax, "(a) / b // end", "P:( I:a P:) P:/ I:b C:// end"},
+		{"js nested template", scriptSyntax, "`a ${x ? `b ${\"}\"}` : 1} // c` + d", "S:`a ${x ? `b ${\"}\"}` : 1} // c` P:+ I:d"},
+		{"python triple quotes", pythonSyntax, "x = \"\"\"a \"b\" # c\n\"\"\"", "I:x P:= S:\"\"\"a \"b\" # c\n\"\"\""},
+		{"python prefixed string", pythonSyntax, `p = rb'\d#'`, `I:p P:= S:rb'\d#'`},
+		{"python decorator", pythonSyntax, "@app.route('/')", "P:@ I:app P:. I:route P:( S:'/' P:)"},
+		{"rust raw string", rustSyntax, `r#"a "b" */"# x`, `S:r#"a "b" */"# I:x`},
+		{"rust nested block comment", rustSyntax, "/* a /* b */ c */ x", "C:/* a /* b */ c */ I:x"},
+		{"rust lifetime and char", rustSyntax, "&'a str, '{', '\\n'", `P:& I:'a I:str P:, S:'{' P:, S:'\n'`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tokenSummary(tokenize([]byte(tt.src), tt.syntax)); got != tt.want {
+				t.Errorf("tokens = %s\nwant     %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSourceAnalyzerFixtures(t *testing.T) {
+	tests := []struct {
+		file      string
+		language  string
+		functions []string
+		classes   int
+		comments  int
+	}{
+		{
+			// Regex y división, plantillas con ${} y comentarios dentro de cadenas
+			file:      "sample.js",
+			language:  "JavaScript",
+			functions: []string{"parse", "Parser.constructor", "Parser.run", "<arrow>", "helper"},
+			classes:   1,
+			comments:  3,
+		},
+		{
+			// Docstrings, cadenas triples con def dentro y decoradores
+			file:      "sample.py",
+			language:  "Python",
+			functions: []string{"decorator", "wrapper", "greet", "Greeter.name", "Greeter.build"},
+			classes:   1,
+			comments:  4,
+		},
+		{
+			// Cadenas raw con llaves, comentarios anidados, lifetimes y '{'
+			file:      "sample.rs",
+			language:  "Rust",
+			functions: []string{"Point::fmt", "longest"},
+			classes:   3,
+			comments:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.file, func(t *testing.T) {
+			content, err := os.ReadFile(filepath.Join("testdata", "source", tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+			metrics := AnalyzeSourceFile(SourceFile{Path: tt.file, Language: tt.language}, content)
+
+			var names []string
+			for _, function := range metrics.FunctionComplexity {
+				names = append(names, function.Name)
+			}
+			if strings.Join(names, ",") != strings.Join(tt.functions, ",") || metrics.Functions != len(tt.functions) {
+				t.Errorf("functions = %d %v, want %v", metrics.Functions, names, tt.functions)
+			}
+			if metrics.Classes != tt.classes {
+				t.Errorf("classes = %d, want %d", metrics.Classes, tt.classes)
+			}
+			if metrics.CommentLines != tt.comments {
+				t.Errorf("comment lines = %d, want %d", metrics.CommentLines, tt.comments)
+			}
+		})
+	}
+}
@@ -0,0 +1,309 @@
+package core
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CompareVersions compara dos versiones con las reglas del ecosistema: semver para Go, npm
+// y crates.io, PEP 440 para PyPI y el orden de Maven. Devuelve -1, 0 o 1.
+func CompareVersions(ecosystem, a, b string) int {
+	switch ecosystem {
+	case EcosystemPyPI:
+		return comparePEP440(a, b)
+	case EcosystemMaven:
+		return compareMaven(a, b)
+	default:
+		return compareSemver(a, b)
+	}
+}
+
+// concreteVersion reconoce una versión exacta (no un rango como ^1.2, >=2 o 1.x)
+var concreteVersion = regexp.MustCompile(`^v?\d+(\.\d+)*([-+._!]?[0-9A-Za-z]+([.+-][0-9A-Za-z]+)*)?$`)
+
+// PinnedVersion devuelve la versión exacta de una dependencia, quitando == y =, o false si es un rango
+func PinnedVersion(version string) (string, bool) {
+	version = strings.TrimSpace(version)
+	version = strings.TrimPrefix(strings.TrimPrefix(version, "=="), "=")
+	version = strings.TrimSpace(version)
+	if version == "" || strings.ContainsAny(version, "^~<>*| ,") || strings.Contains(version, ".x") {
+		return "", false
+	}
+	return version, concreteVersion.MatchString(version)
+}
+
+// --- semver ---
+
+type semverVersion struct {
+	release    []int
+	prerelease []string
+}
+
+func parseSemver(version string) semverVersion {
+	version = strings.TrimPrefix(strings.TrimSpace(version), "v")
+	version = strings.TrimPrefix(version, "=")
+	if i := strings.IndexByte(version, '+'); i >= 0 { // los metadatos de build no ordenan
+		version = version[:i]
+	}
+	var parsed semverVersion
+	core, pre, hasPre := strings.Cut(version, "-")
+	for _, part := range strings.Split(core, ".") {
+		n, _ := strconv.Atoi(part)
+		parsed.release = append(parsed.release, n)
+	}
+	if hasPre {
+		parsed.prerelease = strings.Split(pre, ".")
+	}
+	return parsed
+}
+
+func compareSemver(a, b string) int {
+	va, vb := parseSemver(a), parseSemver(b)
+	if c := compareInts(va.release, vb.release); c != 0 {
+		return c
+	}
+	// Una versión sin prerelease es mayor que cualquiera de sus prereleases
+	switch {
+	case len(va.prerelease) == 0 && len(vb.prerelease) == 0:
+		return 0
+	case len(va.prerelease) == 0:
+		return 1
+	case len(vb.prerelease) == 0:
+		return -1
+	}
+	for i := 0; i < len(va.prerelease) && i < len(vb.prerelease); i++ {
+		pa, pb := va.prerelease[i], vb.prerelease[i]
+		na, errA := strconv.Atoi(pa)
+		nb, errB := strconv.Atoi(pb)
+		switch {
+		case errA == nil && errB == nil:
+			if na != nb {
+				return sign(na - nb)
+			}
+		case errA == nil: // los identificadores numéricos van antes
+			return -1
+		case errB == nil:
+			return 1
+		case pa != pb:
+			return strings.Compare(pa, pb)
+		}
+	}
+	return sign(len(va.prerelease) - len(vb.prerelease))
+}
+
+// compareInts compara segmentos numéricos; los que faltan cuentan como 0 (1.0 == 1.0.0)
+func compareInts(a, b []int) int {
+	for i := 0; i < len(a) || i < len(b); i++ {
+		x, y := 0, 0
+		if i < len(a) {
+			x = a[i]
+		}
+		if i < len(b) {
+			y = b[i]
+		}
+		if x != y {
+			return sign(x - y)
+		}
+	}
+	return 0
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
+
+// --- PEP 440 ---
+
+var pep440Pattern = regexp.MustCompile(`^v?(?:(\d+)!)?(\d+(?:\.\d+)*)` +
+	`(?:[-_.]?(a|alpha|b|beta|c|rc|pre|preview)[-_.]?(\d*))?` +
+	`(?:-(\d+)|[-_.]?(post|rev|r)[-_.]?(\d*))?` +
+	`(?:[-_.]?(dev)[-_.]?(\d*))?` +
+	`(?:\+.*)?$`)
+
+// pep440Version guarda la clave de orden: las dev sin pre van antes que las pre, y estas antes que la final
+type pep440Version struct {
+	epoch   int
+	release []int
+	preRank int // 0 = dev sin pre, 1 = a, 2 = b, 3 = rc, 4 = final
+	pre     int
+	post    int // -1 sin post
+	dev     int // math.MaxInt sin dev
+}
+
+func parsePEP440(version string) (pep440Version, bool) {
+	match := pep440Pattern.FindStringSubmatch(strings.ToLower(strings.TrimSpace(version)))
+	if match == nil {
+		return pep440Version{}, false
+	}
+	parsed := pep440Version{preRank: 4, post: -1, dev: math.MaxInt}
+	parsed.epoch, _ = strconv.Atoi(match[1])
+	for _, part := range strings.Split(match[2], ".") {
+		n, _ := strconv.Atoi(part)
+		parsed.release = append(parsed.release, n)
+	}
+	switch match[3] {
+	case "a", "alpha":
+		parsed.preRank = 1
+	case "b", "beta":
+		parsed.preRank = 2
+	case "c", "rc", "pre", "preview":
+		parsed.preRank = 3
+	}
+	parsed.pre, _ = strconv.Atoi(match[4])
+	switch {
+	case match[5] != "":
+		parsed.post, _ = strconv.Atoi(match[5])
+	case match[6] != "":
+		parsed.post, _ = strconv.Atoi(match[7])
+	}
+	if match[8] != "" {
+		parsed.dev, _ = strconv.Atoi(match[9])
+		if match[3] == "" && parsed.post < 0 {
+			parsed.preRank = 0
+		}
+	}
+	return parsed, true
+}
+
+func comparePEP440(a, b string) int {
+	va, okA := parsePEP440(a)
+	vb, okB := parsePEP440(b)
+	if !okA || !okB {
+		return compareSemver(a, b)
+	}
+	for _, c := range []int{
+		sign(va.epoch - vb.epoch),
+		compareInts(va.release, vb.release),
+		sign(va.preRank - vb.preRank),
+		sign(va.pre - vb.pre),
+		sign(va.post - vb.post),
+	} {
+		if c != 0 {
+			return c
+		}
+	}
+	switch {
+	case va.dev == vb.dev:
+		return 0
+	case va.dev < vb.dev:
+		return -1
+	}
+	return 1
+}
+
+// --- Maven ---
+
+// mavenQualifiers ordena los calificadores conocidos; la versión final tiene el rango de ""
+var mavenQualifiers = map[string]int{
+	"alpha": 1, "a": 1, "beta": 2, "b": 2, "milestone": 3, "m": 3, "rc": 4, "cr": 4,
+	"snapshot": 5, "": 6, "ga": 6, "final": 6, "release": 6, "sp": 7,
+}
+
+// mavenItem es un número o un calificador de una versión de Maven
+type mavenItem struct {
+	number    int
+	qualifier string
+	numeric   bool
+}
+
+func parseMaven(version string) []mavenItem {
+	var items []mavenItem
+	var current strings.Builder
+	flush := func() {
+		if current.Len() == 0 {
+			return
+		}
+		text := strings.ToLower(current.String())
+		if n, err := strconv.Atoi(text); err == nil {
+			items = append(items, mavenItem{number: n, numeric: true})
+		} else {
+			items = append(items, mavenItem{qualifier: text})
+		}
+		current.Reset()
+	}
+	for i, r := range version {
+		if r == '.' || r == '-' || r == '_' {
+			flush()
+			continue
+		}
+		// Las transiciones entre dígitos y letras también separan: 1.0rc1 = 1.0-rc-1
+		if i > 0 && current.Len() > 0 {
+			previous := current.String()[current.Len()-1]
+			if (previous >= '0' && previous <= '9') != (r >= '0' && r <= '9') {
+				flush()
+			}
+		}
+		current.WriteRune(r)
+	}
+	flush()
+
+	// Los ceros y calificadores de versión final del final no cuentan: 1.0.0 == 1 == 1-final
+	for len(items) > 0 {
+		last := items[len(items)-1]
+		if (last.numeric && last.number == 0) || (!last.numeric && mavenQualifiers[last.qualifier] == 6) {
+			items = items[:len(items)-1]
+			continue
+		}
+		break
+	}
+	return items
+}
+
+func compareMaven(a, b string) int {
+	ia, ib := parseMaven(a), parseMaven(b)
+	for i := 0; i < len(ia) || i < len(ib); i++ {
+		var x, y *mavenItem
+		if i < len(ia) {
+			x = &ia[i]
+		}
+		if i < len(ib) {
+			y = &ib[i]
+		}
+		if c := compareMavenItems(x, y); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// compareMavenItems compara dos elementos; nil es el final de la versión
+func compareMavenItems(x, y *mavenItem) int {
+	switch {
+	case x == nil:
+		return -compareMavenItems(y, x)
+	case y == nil:
+		if x.numeric {
+			return sign(x.number) // 1.0.1 > 1.0
+		}
+		return sign(mavenRank(x.qualifier) - 6) // 1.0-rc < 1.0 < 1.0-sp
+	case x.numeric && y.numeric:
+		return sign(x.number - y.number)
+	case x.numeric:
+		return 1 // 1.0.1 > 1.0-rc
+	case y.numeric:
+		return -1
+	}
+	if c := sign(mavenRank(x.qualifier) - mavenRank(y.qualifier)); c != 0 {
+		return c
+	}
+	// Los alias conocidos son el mismo calificador (cr = rc, m = milestone)
+	if _, known := mavenQualifiers[x.qualifier]; known {
+		return 0
+	}
+	return strings.Compare(x.qualifier, y.qualifier)
+}
+
+func mavenRank(qualifier string) int {
+	if rank, ok := mavenQualifiers[qualifier]; ok {
+		return rank
+	}
+	return 8 // los calificadores desconocidos van después de los conocidos
+}
@@ -0,0 +1,89 @@
+package core
+
+import "testing"
+
+// ascendingVersions comprueba que cada versión es menor que todas las siguientes
+func ascendingVersions(t *testing.T, compare func(a, b string) int, versions []string) {
+	t.Helper()
+	for i := range versions {
+		for j := range versions {
+			want := sign(i - j)
+			if got := compare(versions[i], versions[j]); got != want {
+				t.Errorf("compare(%q, %q) = %d, want %d", versions[i], versions[j], got, want)
+			}
+		}
+	}
+}
+
+func TestCompareSemver(t *testing.T) {
+	ascendingVersions(t, compareSemver, []string{
+		"0.9.9", "1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta", "1.0.0-beta.2",
+		"1.0.0-beta.11", "1.0.0-rc.1", "1.0.0", "1.2.0", "1.9.0", "1.10.0", "2.0.0",
+	})
+
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"v1.2.3", "1.2.3", 0},
+		{"1.2.3+build.5", "1.2.3", 0},
+		{"1.0", "1.0.0", 0},
+		{"=2.1.0", "2.1.0", 0},
+		{"v0.0.0-20240101000000-abcdef123456", "v0.0.0-20231201000000-abcdef123456", 1},
+	}
+	for _, tt := range tests {
+		if got := compareSemver(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareSemver(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestComparePEP440(t *testing.T) {
+	ascendingVersions(t, comparePEP440, []string{
+		"1.0.dev0", "1.0a1.dev1", "1.0a1", "1.0a2", "1.0b1", "1.0rc1", "1.0", "1.0.post1.dev1",
+		"1.0.post1", "1.0.post2", "1.1.dev0", "1.1", "1.10", "2.0", "1!0.5",
+	})
+
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0", "1.0.0", 0},
+		{"1.0-1", "1.0.post1", 0},
+		{"1.0c1", "1.0rc1", 0},
+		{"1.0alpha1", "1.0a1", 0},
+		{"1.0.RC1", "1.0rc1", 0},
+		{"v2.0", "2.0", 0},
+	}
+	for _, tt := range tests {
+		if got := comparePEP440(tt.a, tt.b); got != tt.want {
+			t.Errorf("comparePEP440(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestCompareMaven(t *testing.T) {
+	ascendingVersions(t, compareMaven, []string{
+		"1.0-alpha-1", "1.0-alpha-2", "1.0-beta-1", "1.0-milestone-1", "1.0-rc-1", "1.0-rc-2",
+		"1.0-SNAPSHOT", "1.0", "1.0-sp-1", "1.0.1", "1.1", "1.10", "2.0",
+	})
+
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1", "1.0.0", 0},
+		{"1.0-final", "1.0", 0},
+		{"1.0.0.GA", "1.0", 0},
+		{"1.0rc1", "1.0-rc-1", 0},
+		{"1.0-cr-1", "1.0-rc-1", 0},
+		{"1.0-m1", "1.0-milestone-1", 0},
+		{"1.0-foo", "1.0-sp", 1},
+		{"1.0-bar", "1.0-foo", -1},
+	}
+	for _, tt := range tests {
+		if got := compareMaven(tt.a, tt.b); got != tt.want {
+			t.Errorf("compareMaven(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
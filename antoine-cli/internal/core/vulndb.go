@@ -0,0 +1,732 @@
+package core
+
+import (
+	"archive/zip"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"antoine-cli/internal/models"
+)
+
+// VulnEcosystems son los ecosistemas de OSV que se importan: los mismos que los manifiestos
+var VulnEcosystems = []string{EcosystemGo, EcosystemNpm, EcosystemPyPI, EcosystemCargo, EcosystemMaven}
+
+// severityPenalty es lo que resta cada vulnerabilidad a la puntuación de seguridad
+var severityPenalty = map[string]float64{"critical": 25, "high": 15, "medium": 7, "low": 3, "unknown": 5}
+
+var severityRank = map[string]int{"low": 1, "unknown": 2, "medium": 3, "high": 4, "critical": 5}
+
+// advisorySummaryLimit recorta los resúmenes largos para que el índice no crezca
+const advisorySummaryLimit = 200
+
+// Advisory es una entrada de OSV reducida a lo que hace falta para decidir si una versión está afectada
+type Advisory struct {
+	ID       string          `json:"id"`
+	Aliases  []string        `json:"aliases,omitempty"`
+	Summary  string          `json:"summary,omitempty"`
+	Severity string          `json:"severity"` // critical, high, medium, low, unknown
+	Ranges   []AdvisoryRange `json:"ranges,omitempty"`
+	Versions []string        `json:"versions,omitempty"`
+}
+
+// AdvisoryRange es un rango de OSV: SEMVER, ECOSYSTEM o GIT
+type AdvisoryRange struct {
+	Type   string          `json:"type"`
+	Events []AdvisoryEvent `json:"events"`
+}
+
+type AdvisoryEvent struct {
+	Introduced   string `json:"introduced,omitempty"`
+	Fixed        string `json:"fixed,omitempty"`
+	LastAffected string `json:"last_affected,omitempty"`
+}
+
+func (e AdvisoryEvent) version() string {
+	switch {
+	case e.Introduced != "":
+		return e.Introduced
+	case e.Fixed != "":
+		return e.Fixed
+	}
+	return e.LastAffected
+}
+
+// VulnDBEcosystem describe los datos importados de un ecosistema
+type VulnDBEcosystem struct {
+	Advisories int       `json:"advisories"`
+	Packages   int       `json:"packages"`
+	Source     string    `json:"source"`
+	UpdatedAt  time.Time `json:"updated_at"`
+}
+
+// VulnDBStatus es el índice de la base de datos local: qué ecosistemas hay y de dónde vienen
+type VulnDBStatus struct {
+	Path       string                     `json:"-"`
+	Ecosystems map[string]VulnDBEcosystem `json:"ecosystems"`
+}
+
+// VulnDBImport resume una importación
+type VulnDBImport struct {
+	Files      int            `json:"files"`
+	Ecosystems map[string]int `json:"ecosystems"` // advisories por ecosistema
+	Withdrawn  int            `json:"withdrawn"`
+	Skipped    int            `json:"skipped"` // otros ecosistemas o registros ilegibles
+}
+
+// osvRecord es el formato de https://ossf.github.io/osv-schema/
+type osvRecord struct {
+	ID        string   `json:"id"`
+	Aliases   []string `json:"aliases"`
+	Summary   string   `json:"summary"`
+	Details   string   `json:"details"`
+	Withdrawn string   `json:"withdrawn"`
+	Severity  []struct {
+		Type  string `json:"type"`
+		Score string `json:"score"`
+	} `json:"severity"`
+	Affected []struct {
+		Package struct {
+			Ecosystem string `json:"ecosystem"`
+			Name      string `json:"name"`
+		} `json:"package"`
+		Ranges   []AdvisoryRange `json:"ranges"`
+		Versions []string        `json:"versions"`
+	} `json:"affected"`
+	DatabaseSpecific map[string]interface{} `json:"database_specific"`
+}
+
+func vulnDBIndexPath() (string, error) {
+	return DataPath("vulndb", "index.json")
+}
+
+// vulnDBFile es el archivo de un ecosistema: crates.io -> crates-io.json.gz
+func vulnDBFile(ecosystem string) (string, error) {
+	return DataPath("vulndb", strings.ReplaceAll(strings.ToLower(ecosystem), ".", "-")+".json.gz")
+}
+
+// vulnPackageKey normaliza el nombre de un paquete como lo hace su registro
+func vulnPackageKey(ecosystem, name string) string {
+	if ecosystem == EcosystemPyPI {
+		return normalizePythonName(name)
+	}
+	return name
+}
+
+// LoadVulnDBStatus lee el índice de la base de datos; sin importar nada devuelve un estado vacío
+func LoadVulnDBStatus() (*VulnDBStatus, error) {
+	path, err := vulnDBIndexPath()
+	if err != nil {
+		return nil, err
+	}
+	status := &VulnDBStatus{}
+	if _, err := readJSONFile(path, status); err != nil {
+		return nil, err
+	}
+	if status.Ecosystems == nil {
+		status.Ecosystems = make(map[string]VulnDBEcosystem)
+	}
+	status.Path = filepath.Dir(path)
+	return status, nil
+}
+
+// ImportOSV importa exportaciones de OSV (all.zip de un ecosistema, un .json o un directorio de .json).
+// Los ecosistemas presentes sustituyen a los que ya había; el resto se conservan.
+func ImportOSV(sources []string) (*VulnDBImport, error) {
+	summary := &VulnDBImport{Ecosystems: make(map[string]int)}
+	packages := make(map[string]map[string][]Advisory)
+	origins := make(map[string][]string) // archivos de los que sale cada ecosistema
+	var current string
+	add := func(data []byte) {
+		summary.Files++
+		var record osvRecord
+		if err := json.Unmarshal(data, &record); err != nil || record.ID == "" {
+			summary.Skipped++
+			return
+		}
+		if record.Withdrawn != "" {
+			summary.Withdrawn++
+			return
+		}
+		ecosystems := addOSVRecord(packages, record)
+		if len(ecosystems) == 0 {
+			summary.Skipped++
+		}
+		for _, ecosystem := range ecosystems {
+			if !containsString(origins[ecosystem], current) {
+				origins[ecosystem] = append(origins[ecosystem], current)
+			}
+		}
+	}
+
+	for _, source := range sources {
+		current = filepath.Base(source)
+		if err := readOSVSource(source, add); err != nil {
+			return nil, err
+		}
+	}
+	if len(packages) == 0 {
+		return nil, fmt.Errorf("no advisories for %s found in %s", strings.Join(VulnEcosystems, ", "), strings.Join(sources, ", "))
+	}
+
+	status, err := LoadVulnDBStatus()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	for _, ecosystem := range sortedKeys(packages) {
+		entries := packages[ecosystem]
+		path, err := vulnDBFile(ecosystem)
+		if err != nil {
+			return nil, err
+		}
+		if err := writeGzipJSON(path, entries); err != nil {
+			return nil, err
+		}
+		count := 0
+		for _, advisories := range entries {
+			count += len(advisories)
+		}
+		summary.Ecosystems[ecosystem] = count
+		status.Ecosystems[ecosystem] = VulnDBEcosystem{
+			Advisories: count, Packages: len(entries), Source: strings.Join(origins[ecosystem], ", "), UpdatedAt: now,
+		}
+	}
+
+	path, err := vulnDBIndexPath()
+	if err != nil {
+		return nil, err
+	}
+	if err := writeJSONFile(path, status); err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+// readOSVSource llama a add con cada registro JSON de un zip, un archivo o un directorio
+func readOSVSource(source string, add func([]byte)) error {
+	info, err := os.Stat(source)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", source, err)
+	}
+
+	switch {
+	case info.IsDir():
+		return filepath.WalkDir(source, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() || !strings.HasSuffix(path, ".json") {
+				return err
+			}
+			data, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("failed to read %s: %w", path, err)
+			}
+			add(data)
+			return nil
+		})
+	case strings.HasSuffix(strings.ToLower(source), ".json"):
+		data, err := os.ReadFile(source)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", source, err)
+		}
+		add(data)
+		return nil
+	}
+
+	archive, err := zip.OpenReader(source)
+	if err != nil {
+		return fmt.Errorf("failed to open %s as an OSV zip: %w", source, err)
+	}
+	defer archive.Close()
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() || !strings.HasSuffix(file.Name, ".json") {
+			continue
+		}
+		reader, err := file.Open()
+		if err != nil {
+			return fmt.Errorf("failed to read %s in %s: %w", file.Name, source, err)
+		}
+		data, err := io.ReadAll(reader)
+		reader.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read %s in %s: %w", file.Name, source, err)
+		}
+		add(data)
+	}
+	return nil
+}
+
+// addOSVRecord añade una entrada por paquete afectado y devuelve los ecosistemas soportados que tenía
+func addOSVRecord(packages map[string]map[string][]Advisory, record osvRecord) []string {
+	summary := record.Summary
+	if summary == "" {
+		summary, _, _ = strings.Cut(strings.TrimSpace(record.Details), "\n")
+	}
+	if len(summary) > advisorySummaryLimit {
+		summary = strings.TrimSpace(summary[:advisorySummaryLimit-3]) + "..."
+	}
+	severity := osvSeverity(record)
+
+	var added []string
+	for _, affected := range record.Affected {
+		ecosystem := affected.Package.Ecosystem
+		if !containsString(VulnEcosystems, ecosystem) || affected.Package.Name == "" {
+			continue
+		}
+		advisory := Advisory{ID: record.ID, Aliases: record.Aliases, Summary: summary, Severity: severity}
+		for _, r := range affected.Ranges {
+			if r.Type != "GIT" && len(r.Events) > 0 {
+				advisory.Ranges = append(advisory.Ranges, r)
+			}
+		}
+		// La lista de versiones solo hace falta si no hay rangos que evaluar
+		if len(advisory.Ranges) == 0 {
+			advisory.Versions = affected.Versions
+		}
+		if len(advisory.Ranges) == 0 && len(advisory.Versions) == 0 {
+			continue
+		}
+		if packages[ecosystem] == nil {
+			packages[ecosystem] = make(map[string][]Advisory)
+		}
+		key := vulnPackageKey(ecosystem, affected.Package.Name)
+		packages[ecosystem][key] = append(packages[ecosystem][key], advisory)
+		if !containsString(added, ecosystem) {
+			added = append(added, ecosystem)
+		}
+	}
+	return added
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// osvSeverity usa la severidad de la base de datos de origen (GHSA) o la calcula del vector CVSS v3
+func osvSeverity(record osvRecord) string {
+	if severity, ok := record.DatabaseSpecific["severity"].(string); ok {
+		switch strings.ToLower(severity) {
+		case "critical":
+			return "critical"
+		case "high":
+			return "high"
+		case "moderate", "medium":
+			return "medium"
+		case "low":
+			return "low"
+		}
+	}
+	for _, s := range record.Severity {
+		if s.Type != "CVSS_V3" {
+			continue
+		}
+		if score, ok := cvss3BaseScore(s.Score); ok {
+			return cvssSeverity(score)
+		}
+	}
+	return "unknown"
+}
+
+func cvssSeverity(score float64) string {
+	switch {
+	case score >= 9:
+		return "critical"
+	case score >= 7:
+		return "high"
+	case score >= 4:
+		return "medium"
+	}
+	return "low"
+}
+
+// cvss3BaseScore calcula la puntuación base de un vector CVSS:3.x (especificación 3.1)
+func cvss3BaseScore(vector string) (float64, bool) {
+	metrics := make(map[string]string)
+	for _, part := range strings.Split(vector, "/") {
+		if key, value, ok := strings.Cut(part, ":"); ok {
+			metrics[key] = value
+		}
+	}
+	if !strings.HasPrefix(metrics["CVSS"], "3") {
+		return 0, false
+	}
+	changed := metrics["S"] == "C"
+	weights := map[string]map[string]float64{
+		"AV": {"N": 0.85, "A": 0.62, "L": 0.55, "P": 0.2},
+		"AC": {"L": 0.77, "H": 0.44},
+		"PR": {"N": 0.85, "L": 0.62, "H": 0.27},
+		"UI": {"N": 0.85, "R": 0.62},
+		"C":  {"H": 0.56, "L": 0.22, "N": 0},
+		"I":  {"H": 0.56, "L": 0.22, "N": 0},
+		"A":  {"H": 0.56, "L": 0.22, "N": 0},
+	}
+	if changed {
+		weights["PR"]["L"], weights["PR"]["H"] = 0.68, 0.5
+	}
+	value := make(map[string]float64)
+	for metric, options := range weights {
+		weight, ok := options[metrics[metric]]
+		if !ok {
+			return 0, false
+		}
+		value[metric] = weight
+	}
+
+	iss := 1 - (1-value["C"])*(1-value["I"])*(1-value["A"])
+	impact := 6.42 * iss
+	if changed {
+		impact = 7.52*(iss-0.029) - 3.25*math.Pow(iss-0.02, 15)
+	}
+	if impact <= 0 {
+		return 0, true
+	}
+	exploitability := 8.22 * value["AV"] * value["AC"] * value["PR"] * value["UI"]
+	score := impact + exploitability
+	if changed {
+		score *= 1.08
+	}
+	return math.Ceil(math.Min(score, 10)*10-1e-9) / 10, true
+}
+
+func writeGzipJSON(path string, value interface{}) error {
+	tmp := path + ".tmp"
+	file, err := os.Create(tmp)
+	if err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	writer := gzip.NewWriter(file)
+	if err := json.NewEncoder(writer).Encode(value); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to encode %s: %w", path, err)
+	}
+	if err := writer.Close(); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return os.Rename(tmp, path)
+}
+
+// Affects indica si una versión concreta está afectada, según los rangos o la lista de versiones
+func (a Advisory) Affects(ecosystem, version string) bool {
+	for _, v := range a.Versions {
+		if v == version || CompareVersions(ecosystem, v, version) == 0 {
+			return true
+		}
+	}
+	for _, r := range a.Ranges {
+		if r.affects(ecosystem, version) {
+			return true
+		}
+	}
+	return false
+}
+
+// Fixed devuelve la primera versión corregida posterior a version, o "" si no hay
+func (a Advisory) Fixed(ecosystem, version string) string {
+	fixed := ""
+	for _, r := range a.Ranges {
+		for _, event := range r.Events {
+			if event.Fixed == "" || r.compare(ecosystem, event.Fixed, version) <= 0 {
+				continue
+			}
+			if fixed == "" || r.compare(ecosystem, event.Fixed, fixed) < 0 {
+				fixed = event.Fixed
+			}
+		}
+	}
+	return fixed
+}
+
+// compare usa semver en los rangos SEMVER y el orden del ecosistema en los ECOSYSTEM
+func (r AdvisoryRange) compare(ecosystem, a, b string) int {
+	if r.Type == "SEMVER" {
+		return compareSemver(a, b)
+	}
+	return CompareVersions(ecosystem, a, b)
+}
+
+// affects evalúa los eventos ordenados como indica el esquema de OSV: introduced abre el rango,
+// fixed lo cierra en esa versión y last_affected justo después
+func (r AdvisoryRange) affects(ecosystem, version string) bool {
+	events := append([]AdvisoryEvent(nil), r.Events...)
+	sort.SliceStable(events, func(i, j int) bool {
+		a, b := events[i].version(), events[j].version()
+		if a == "0" || b == "0" {
+			return a == "0" && b != "0"
+		}
+		return r.compare(ecosystem, a, b) < 0
+	})
+
+	affected := false
+	for _, event := range events {
+		switch {
+		case event.Introduced != "":
+			if event.Introduced == "0" || r.compare(ecosystem, version, event.Introduced) >= 0 {
+				affected = true
+			}
+		case event.Fixed != "":
+			if r.compare(ecosystem, version, event.Fixed) >= 0 {
+				affected = false
+			}
+		case event.LastAffected != "":
+			if r.compare(ecosystem, version, event.LastAffected) > 0 {
+				affected = false
+			}
+		}
+	}
+	return affected
+}
+
+// VulnDB da acceso a la base de datos local; cada ecosistema se carga la primera vez que se consulta
+type VulnDB struct {
+	Status   *VulnDBStatus
+	packages map[string]map[string][]Advisory
+}
+
+// OpenVulnDB abre la base de datos local de vulnerabilidades
+func OpenVulnDB() (*VulnDB, error) {
+	status, err := LoadVulnDBStatus()
+	if err != nil {
+		return nil, err
+	}
+	return &VulnDB{Status: status, packages: make(map[string]map[string][]Advisory)}, nil
+}
+
+// Covers indica si hay datos importados para el ecosistema
+func (db *VulnDB) Covers(ecosystem string) bool {
+	_, ok := db.Status.Ecosystems[ecosystem]
+	return ok
+}
+
+// Lookup devuelve las advisories de un paquete
+func (db *VulnDB) Lookup(ecosystem, name string) ([]Advisory, error) {
+	if !db.Covers(ecosystem) {
+		return nil, nil
+	}
+	entries, ok := db.packages[ecosystem]
+	if !ok {
+		path, err := vulnDBFile(ecosystem)
+		if err != nil {
+			return nil, err
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("vulnerability database for %s is missing, run 'antoine vulndb update': %w", ecosystem, err)
+		}
+		defer file.Close()
+		reader, err := gzip.NewReader(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if err := json.NewDecoder(reader).Decode(&entries); err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", path, err)
+		}
+		db.packages[ecosystem] = entries
+	}
+	return entries[vulnPackageKey(ecosystem, name)], nil
+}
+
+// VulnerabilityFinding es una dependencia afectada por una advisory
+type VulnerabilityFinding struct {
+	Dependency models.DependencyDetail `json:"dependency"`
+	Advisory   Advisory                `json:"advisory"`
+	Fixed      string                  `json:"fixed,omitempty"`
+}
+
+// DependencyAudit es el resultado de cruzar las dependencias con la base de datos
+type DependencyAudit struct {
+	Findings   []VulnerabilityFinding `json:"findings"`
+	Checked    int                    `json:"checked"`
+	Unresolved int                    `json:"unresolved"` // dependencias sin versión exacta (rangos)
+	Uncovered  []string               `json:"uncovered"`  // ecosistemas sin datos importados
+}
+
+// Audit busca advisories para cada dependencia con versión exacta y marca las vulnerables
+func (db *VulnDB) Audit(deps *models.DependencyAnalysis) (DependencyAudit, error) {
+	var audit DependencyAudit
+	uncovered := make(map[string]bool)
+	deps.Vulnerable = 0
+	for i := range deps.Details {
+		dep := &deps.Details[i]
+		if !db.Covers(dep.Ecosystem) {
+			uncovered[dep.Ecosystem] = true
+			continue
+		}
+		version, ok := PinnedVersion(dep.Version)
+		if !ok {
+			audit.Unresolved++
+			continue
+		}
+		advisories, err := db.Lookup(dep.Ecosystem, dep.Name)
+		if err != nil {
+			return audit, err
+		}
+		audit.Checked++
+
+		// La misma vulnerabilidad puede venir de varias bases (GHSA, PYSEC) con alias cruzados
+		seen := make(map[string]bool)
+		for _, advisory := range advisories {
+			if seen[advisory.ID] || !advisory.Affects(dep.Ecosystem, version) {
+				continue
+			}
+			duplicate := false
+			for _, alias := range advisory.Aliases {
+				duplicate = duplicate || seen[alias]
+			}
+			seen[advisory.ID] = true
+			for _, alias := range advisory.Aliases {
+				seen[alias] = true
+			}
+			if duplicate {
+				continue
+			}
+
+			if !dep.Vulnerable {
+				deps.Vulnerable++
+			}
+			dep.Vulnerable = true
+			if severityRank[advisory.Severity] > severityRank[dep.Severity] {
+				dep.Severity = advisory.Severity
+			}
+			audit.Findings = append(audit.Findings, VulnerabilityFinding{
+				Dependency: *dep, Advisory: advisory, Fixed: advisory.Fixed(dep.Ecosystem, version),
+			})
+		}
+	}
+	for ecosystem := range uncovered {
+		if ecosystem != "" {
+			audit.Uncovered = append(audit.Uncovered, ecosystem)
+		}
+	}
+	sort.Strings(audit.Uncovered)
+	sort.SliceStable(audit.Findings, func(i, j int) bool {
+		return severityRank[audit.Findings[i].Advisory.Severity] > severityRank[audit.Findings[j].Advisory.Severity]
+	})
+	return audit, nil
+}
+
+// Vulnerability convierte el hallazgo al modelo de seguridad del repositorio
+func (f VulnerabilityFinding) Vulnerability() models.Vulnerability {
+	id := f.Advisory.ID
+	for _, alias := range f.Advisory.Aliases {
+		if strings.HasPrefix(alias, "CVE-") {
+			id += " (" + alias + ")"
+			break
+		}
+	}
+	description := fmt.Sprintf("%s in %s@%s", id, f.Dependency.Name, f.Dependency.Version)
+	if f.Advisory.Summary != "" {
+		description += ": " + f.Advisory.Summary
+	}
+	if f.Fixed != "" {
+		description += fmt.Sprintf(" (fixed in %s)", f.Fixed)
+	}
+	return models.Vulnerability{
+		Type:        "dependency",
+		Severity:    f.Advisory.Severity,
+		Description: description,
+		File:        f.Dependency.Manifest,
+	}
+}
+
+// SecurityScore parte de 100 y resta una penalización por cada vulnerabilidad según su severidad
+func SecurityScore(vulnerabilities []models.Vulnerability) float64 {
+	score := 100.0
+	for _, vuln := range vulnerabilities {
+		penalty, ok := severityPenalty[strings.ToLower(vuln.Severity)]
+		if !ok {
+			penalty = severityPenalty["unknown"]
+		}
+		score -= penalty
+	}
+	return math.Max(score, 0)
+}
+
+// applyVulnerabilities cruza las dependencias con la base de datos local, añade las vulnerabilidades
+// encontradas y calcula la puntuación de seguridad. Devuelve el insight con el resumen.
+func applyVulnerabilities(security *models.SecurityAnalysis) (models.Insight, bool) {
+	deps := &security.Dependencies
+	if deps.Total == 0 {
+		return models.Insight{}, false
+	}
+	insight := models.Insight{Type: "vulnerabilities", Title: "Known vulnerabilities", Impact: "low", Confidence: 0.9}
+
+	db, err := OpenVulnDB()
+	if err == nil && len(db.Status.Ecosystems) == 0 {
+		insight.Description = "Dependencies were not checked for known vulnerabilities: import OSV data with 'antoine vulndb update --from <all.zip>'."
+		insight.Confidence = 1
+		return insight, true
+	}
+	var audit DependencyAudit
+	if err == nil {
+		audit, err = db.Audit(deps)
+	}
+	if err != nil {
+		insight.Description = fmt.Sprintf("Vulnerability check failed: %v", err)
+		insight.Confidence = 0.5
+		return insight, true
+	}
+	if audit.Checked == 0 && audit.Unresolved == 0 {
+		insight.Description = fmt.Sprintf("No vulnerability data for %s: import it with 'antoine vulndb update --from <all.zip>'.", strings.Join(audit.Uncovered, ", "))
+		return insight, true
+	}
+
+	for _, finding := range audit.Findings {
+		security.Vulnerabilities = append(security.Vulnerabilities, finding.Vulnerability())
+	}
+	security.Score = SecurityScore(security.Vulnerabilities)
+	insight.Data = audit
+
+	if len(audit.Findings) == 0 {
+		insight.Description = fmt.Sprintf("No known vulnerabilities in %d dependencies.", audit.Checked)
+	} else {
+		counts := make(map[string]int)
+		var affected []string
+		for _, finding := range audit.Findings {
+			counts[finding.Advisory.Severity]++
+			ref := finding.Dependency.Name + "@" + finding.Dependency.Version
+			if !containsString(affected, ref) {
+				affected = append(affected, ref)
+			}
+		}
+		var parts []string
+		for _, severity := range []string{"critical", "high", "medium", "low", "unknown"} {
+			if counts[severity] > 0 {
+				parts = append(parts, fmt.Sprintf("%d %s", counts[severity], severity))
+			}
+		}
+		if len(affected) > 5 {
+			affected = append(affected[:5], fmt.Sprintf("and %d more", len(affected)-5))
+		}
+		insight.Description = fmt.Sprintf("%d known vulnerabilities (%s) in %d of %d dependencies: %s.",
+			len(audit.Findings), strings.Join(parts, ", "), deps.Vulnerable, audit.Checked, strings.Join(affected, ", "))
+		switch {
+		case counts["critical"] > 0 || counts["high"] > 0:
+			insight.Impact = "high"
+		default:
+			insight.Impact = "medium"
+		}
+	}
+	if audit.Unresolved > 0 {
+		insight.Description += fmt.Sprintf(" %d dependencies without an exact version (no lockfile) were not checked.", audit.Unresolved)
+	}
+	if len(audit.Uncovered) > 0 {
+		insight.Description += fmt.Sprintf(" No data imported for %s.", strings.Join(audit.Uncovered, ", "))
+	}
+	return insight, true
+}
@@ -0,0 +1,58 @@
+package core
+
+import "testing"
+
+func TestAdvisoryAffects(t *testing.T) {
+	semverRange := func(events ...AdvisoryEvent) Advisory {
+		return Advisory{ID: "GHSA-test", Ranges: []AdvisoryRange{{Type: "SEMVER", Events: events}}}
+	}
+	ecosystemRange := func(events ...AdvisoryEvent) Advisory {
+		return Advisory{ID: "PYSEC-test", Ranges: []AdvisoryRange{{Type: "ECOSYSTEM", Events: events}}}
+	}
+
+	tests := []struct {
+		name      string
+		advisory  Advisory
+		ecosystem string
+		version   string
+		want      bool
+		wantFixed string
+	}{
+		{"before fix", semverRange(AdvisoryEvent{Introduced: "0"}, AdvisoryEvent{Fixed: "1.2.3"}), EcosystemNpm, "1.2.2", true, "1.2.3"},
+		{"at fix", semverRange(AdvisoryEvent{Introduced: "0"}, AdvisoryEvent{Fixed: "1.2.3"}), EcosystemNpm, "1.2.3", false, ""},
+		{"before introduced", semverRange(AdvisoryEvent{Introduced: "2.0.0"}, AdvisoryEvent{Fixed: "2.1.0"}), EcosystemNpm, "1.9.0", false, "2.1.0"},
+		{"prerelease of fix", semverRange(AdvisoryEvent{Introduced: "0"}, AdvisoryEvent{Fixed: "2.0.0"}), EcosystemNpm, "2.0.0-rc.1", true, "2.0.0"},
+		{"no fix yet", semverRange(AdvisoryEvent{Introduced: "1.0.0"}), EcosystemGo, "v9.0.0", true, ""},
+		{"last affected is inclusive", semverRange(AdvisoryEvent{Introduced: "0"}, AdvisoryEvent{LastAffected: "1.4.0"}), EcosystemCargo, "1.4.0", true, ""},
+		{"after last affected", semverRange(AdvisoryEvent{Introduced: "0"}, AdvisoryEvent{LastAffected: "1.4.0"}), EcosystemCargo, "1.4.1", false, ""},
+		{
+			name: "between two affected ranges",
+			advisory: semverRange(AdvisoryEvent{Introduced: "1.0.0"}, AdvisoryEvent{Fixed: "1.5.0"},
+				AdvisoryEvent{Introduced: "2.0.0"}, AdvisoryEvent{Fixed: "2.5.0"}),
+			ecosystem: EcosystemNpm, version: "1.7.0", want: false, wantFixed: "2.5.0",
+		},
+		{
+			name: "second range, events out of order",
+			advisory: semverRange(AdvisoryEvent{Fixed: "2.5.0"}, AdvisoryEvent{Introduced: "2.0.0"},
+				AdvisoryEvent{Fixed: "1.5.0"}, AdvisoryEvent{Introduced: "1.0.0"}),
+			ecosystem: EcosystemNpm, version: "2.4.9", want: true, wantFixed: "2.5.0",
+		},
+		{"pep 440 release candidate", ecosystemRange(AdvisoryEvent{Introduced: "2.0"}, AdvisoryEvent{Fixed: "2.0.1"}), EcosystemPyPI, "2.0rc1", false, "2.0.1"},
+		{"pep 440 post release", ecosystemRange(AdvisoryEvent{Introduced: "0"}, AdvisoryEvent{Fixed: "2.0.post1"}), EcosystemPyPI, "2.0", true, "2.0.post1"},
+		{"maven qualifier", ecosystemRange(AdvisoryEvent{Introduced: "0"}, AdvisoryEvent{Fixed: "2.17.1"}), EcosystemMaven, "2.17.1-rc1", true, "2.17.1"},
+		{"maven release alias", ecosystemRange(AdvisoryEvent{Introduced: "0"}, AdvisoryEvent{Fixed: "5.3.18"}), EcosystemMaven, "5.3.18.RELEASE", false, ""},
+		{"explicit version list", Advisory{Versions: []string{"1.0.0", "1.0.1"}}, EcosystemNpm, "v1.0.1", true, ""},
+		{"not in version list", Advisory{Versions: []string{"1.0.0", "1.0.1"}}, EcosystemNpm, "1.0.2", false, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.advisory.Affects(tt.ecosystem, tt.version); got != tt.want {
+				t.Errorf("Affects(%s) = %v, want %v", tt.version, got, tt.want)
+			}
+			if got := tt.advisory.Fixed(tt.ecosystem, tt.version); got != tt.wantFixed {
+				t.Errorf("Fixed(%s) = %q, want %q", tt.version, got, tt.wantFixed)
+			}
+		})
+	}
+}
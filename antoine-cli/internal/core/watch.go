@@ -0,0 +1,363 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/utils"
+)
+
+// savedSearchesFile guarda las búsquedas con nombre dentro de ~/.antoine
+const savedSearchesFile = "searches.json"
+
+// ErrSearchNotFound indica que no existe una búsqueda guardada con ese nombre
+var ErrSearchNotFound = errors.New("saved search not found")
+
+// SavedSearch es una búsqueda de hackathons con nombre que se puede repetir y vigilar
+type SavedSearch struct {
+	Name         string    `json:"name"`
+	Query        string    `json:"query"`
+	Technologies []string  `json:"technologies,omitempty"`
+	Location     string    `json:"location,omitempty"`
+	Near         string    `json:"near,omitempty"` // ciudad o lat,lng para filtrar por distancia
+	RadiusKm     float64   `json:"radius_km,omitempty"`
+	PrizeMin     int       `json:"prize_min,omitempty"`
+	DateFrom     string    `json:"date_from,omitempty"`
+	DateTo       string    `json:"date_to,omitempty"`
+	Online       bool      `json:"online,omitempty"`
+	Difficulty   string    `json:"difficulty,omitempty"`
+	SortBy       string    `json:"sort_by,omitempty"`
+	SortOrder    string    `json:"sort_order,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+	LastRun      time.Time `json:"last_run,omitempty"`
+}
+
+type savedSearches struct {
+	Searches []*SavedSearch `json:"searches"`
+}
+
+// Filters convierte la búsqueda en los filtros de AntoineClient.SearchHackathons.
+// PrizeMin no se envía: los premios vienen en monedas distintas y se filtran en local (Refine).
+func (s *SavedSearch) Filters() map[string]interface{} {
+	filters := make(map[string]interface{})
+	if len(s.Technologies) > 0 {
+		filters["technologies"] = s.Technologies
+	}
+	if s.Location != "" {
+		filters["location"] = s.Location
+	}
+	// Con Near, Online significa incluir también los eventos online: se filtra en local
+	if s.Online && s.Near == "" {
+		filters["online"] = true
+	}
+	if s.Difficulty != "" {
+		filters["difficulty"] = s.Difficulty
+	}
+	if s.DateFrom != "" {
+		filters["date_from"] = s.DateFrom
+	}
+	if s.DateTo != "" {
+		filters["date_to"] = s.DateTo
+	}
+	return filters
+}
+
+// Describe resume la búsqueda en una línea
+func (s *SavedSearch) Describe() string {
+	var parts []string
+	if s.Query != "" {
+		parts = append(parts, fmt.Sprintf("%q", s.Query))
+	}
+	if len(s.Technologies) > 0 {
+		parts = append(parts, "tech: "+strings.Join(s.Technologies, ","))
+	}
+	if s.Location != "" {
+		parts = append(parts, "location: "+s.Location)
+	}
+	if s.Near != "" {
+		near := "near " + s.Near
+		if s.RadiusKm > 0 {
+			near += fmt.Sprintf(" ≤ %s", FormatDistance(s.RadiusKm))
+		}
+		if s.Online {
+			near += " + online"
+		}
+		parts = append(parts, near)
+	} else if s.Online {
+		parts = append(parts, "online")
+	}
+	if s.Difficulty != "" {
+		parts = append(parts, "difficulty: "+s.Difficulty)
+	}
+	if s.PrizeMin > 0 {
+		parts = append(parts, "prize ≥ "+FormatMoney(float64(s.PrizeMin), Prizes().Base()))
+	}
+	if s.DateFrom != "" || s.DateTo != "" {
+		parts = append(parts, fmt.Sprintf("dates: %s..%s", s.DateFrom, s.DateTo))
+	}
+	if s.SortBy != "" {
+		parts = append(parts, "sort: "+s.SortBy+" "+s.SortOrder)
+	}
+	if len(parts) == 0 {
+		return "all hackathons"
+	}
+	return strings.Join(parts, " • ")
+}
+
+// GeoFilter devuelve el filtro por distancia de la búsqueda, o nil si no tiene Near
+func (s *SavedSearch) GeoFilter() (*GeoFilter, error) {
+	if s.Near == "" {
+		return nil, nil
+	}
+	return NewGeoFilter(s.Near, s.RadiusKm, s.Online)
+}
+
+// Refine aplica en local lo que la búsqueda remota no resuelve: premio mínimo en la
+// moneda base, orden y filtro geográfico (que reordena por distancia)
+func (s *SavedSearch) Refine(hackathons []*models.Hackathon, geo *GeoFilter) []*models.Hackathon {
+	hackathons = Prizes().FilterByPrize(hackathons, float64(s.PrizeMin))
+	SortHackathons(hackathons, s.SortBy, s.SortOrder)
+	if geo != nil {
+		hackathons = geo.Apply(hackathons)
+	}
+	return hackathons
+}
+
+// LoadSavedSearches devuelve las búsquedas guardadas ordenadas por nombre
+func LoadSavedSearches() ([]*SavedSearch, error) {
+	path, err := DataPath(savedSearchesFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var stored savedSearches
+	if _, err := readJSONFile(path, &stored); err != nil {
+		return nil, err
+	}
+
+	sort.Slice(stored.Searches, func(i, j int) bool {
+		return stored.Searches[i].Name < stored.Searches[j].Name
+	})
+	return stored.Searches, nil
+}
+
+// GetSavedSearch busca una búsqueda guardada por nombre
+func GetSavedSearch(name string) (*SavedSearch, error) {
+	searches, err := LoadSavedSearches()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, search := range searches {
+		if search.Name == name {
+			return search, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrSearchNotFound, name)
+}
+
+// SaveSearch guarda la búsqueda, sustituyendo la que tenga el mismo nombre
+func SaveSearch(search *SavedSearch) error {
+	if search.Name == "" || utils.SlugifyString(search.Name) != search.Name {
+		return fmt.Errorf("invalid search name %q: use lowercase letters, numbers and dashes", search.Name)
+	}
+
+	searches, err := LoadSavedSearches()
+	if err != nil {
+		return err
+	}
+
+	if search.CreatedAt.IsZero() {
+		search.CreatedAt = time.Now()
+	}
+
+	replaced := false
+	for i, existing := range searches {
+		if existing.Name == search.Name {
+			search.CreatedAt = existing.CreatedAt
+			searches[i] = search
+			replaced = true
+		}
+	}
+	if !replaced {
+		searches = append(searches, search)
+	}
+
+	return writeSavedSearches(searches)
+}
+
+// DeleteSavedSearch borra una búsqueda guardada y su última instantánea
+func DeleteSavedSearch(name string) error {
+	searches, err := LoadSavedSearches()
+	if err != nil {
+		return err
+	}
+
+	kept := searches[:0]
+	for _, search := range searches {
+		if search.Name != name {
+			kept = append(kept, search)
+		}
+	}
+	if len(kept) == len(searches) {
+		return fmt.Errorf("%w: %s", ErrSearchNotFound, name)
+	}
+
+	if path, err := DataPath("watch", name+".json"); err == nil {
+		os.Remove(path)
+	}
+
+	return writeSavedSearches(kept)
+}
+
+func writeSavedSearches(searches []*SavedSearch) error {
+	path, err := DataPath(savedSearchesFile)
+	if err != nil {
+		return err
+	}
+	return writeJSONFile(path, savedSearches{Searches: searches})
+}
+
+// WatchSnapshot son los resultados de la última ejecución de una búsqueda vigilada
+type WatchSnapshot struct {
+	Search     string              `json:"search"`
+	TakenAt    time.Time           `json:"taken_at"`
+	Hackathons []*models.Hackathon `json:"hackathons"`
+}
+
+// HackathonChange es un hackathon ya conocido cuyos datos cambiaron
+type HackathonChange struct {
+	Hackathon *models.Hackathon `json:"hackathon"`
+	Changes   []string          `json:"changes"`
+}
+
+// WatchResult es el resultado de comprobar una búsqueda guardada
+type WatchResult struct {
+	Search   *SavedSearch        `json:"search"`
+	Total    int                 `json:"total"`
+	Baseline bool                `json:"baseline"` // primera ejecución: no hay con qué comparar
+	New      []*models.Hackathon `json:"new"`
+	Changed  []HackathonChange   `json:"changed"`
+	Removed  int                 `json:"removed"`
+	Error    string              `json:"error,omitempty"`
+}
+
+// HasUpdates indica si hay hackathons nuevos o modificados
+func (r *WatchResult) HasUpdates() bool {
+	return len(r.New) > 0 || len(r.Changed) > 0
+}
+
+// CheckSavedSearch repite la búsqueda, la compara con la última instantánea y guarda la nueva
+func (c *AntoineClient) CheckSavedSearch(ctx context.Context, search *SavedSearch) (*WatchResult, error) {
+	geo, err := search.GeoFilter()
+	if err != nil {
+		return nil, err
+	}
+
+	hackathons, err := c.RefreshHackathons(ctx, search.Query, search.Filters())
+	if err != nil {
+		return nil, err
+	}
+	return recordWatchSnapshot(search, search.Refine(hackathons, geo))
+}
+
+// recordWatchSnapshot compara los resultados con la última instantánea y la sustituye
+func recordWatchSnapshot(search *SavedSearch, hackathons []*models.Hackathon) (*WatchResult, error) {
+	path, err := DataPath("watch", search.Name+".json")
+	if err != nil {
+		return nil, err
+	}
+
+	var previous WatchSnapshot
+	found, err := readJSONFile(path, &previous)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &WatchResult{Search: search, Total: len(hackathons), Baseline: !found}
+	if found {
+		result.New, result.Changed, result.Removed = DiffHackathons(previous.Hackathons, hackathons)
+	}
+
+	snapshot := WatchSnapshot{Search: search.Name, TakenAt: time.Now(), Hackathons: hackathons}
+	if err := writeJSONFile(path, snapshot); err != nil {
+		return nil, err
+	}
+
+	search.LastRun = snapshot.TakenAt
+	if err := SaveSearch(search); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DiffHackathons compara dos listas de resultados y devuelve los nuevos, los modificados
+// y cuántos desaparecieron
+func DiffHackathons(previous, current []*models.Hackathon) ([]*models.Hackathon, []HackathonChange, int) {
+	known := make(map[string]*models.Hackathon, len(previous))
+	for _, hackathon := range previous {
+		known[hackathonKey(hackathon)] = hackathon
+	}
+
+	var added []*models.Hackathon
+	var changed []HackathonChange
+	seen := make(map[string]bool, len(current))
+
+	for _, hackathon := range current {
+		key := hackathonKey(hackathon)
+		seen[key] = true
+
+		before, ok := known[key]
+		if !ok {
+			added = append(added, hackathon)
+			continue
+		}
+		if changes := hackathonChanges(before, hackathon); len(changes) > 0 {
+			changed = append(changed, HackathonChange{Hackathon: hackathon, Changes: changes})
+		}
+	}
+
+	removed := 0
+	for key := range known {
+		if !seen[key] {
+			removed++
+		}
+	}
+
+	return added, changed, removed
+}
+
+// hackathonChanges describe los campos relevantes que cambiaron entre dos versiones
+func hackathonChanges(before, after *models.Hackathon) []string {
+	var changes []string
+	date := func(t time.Time) string {
+		if t.IsZero() {
+			return "TBA"
+		}
+		return t.Format("2006-01-02")
+	}
+	compare := func(field, old, new string) {
+		if old != new {
+			changes = append(changes, fmt.Sprintf("%s: %s → %s", field, old, new))
+		}
+	}
+
+	compare("name", before.Name, after.Name)
+	compare("start", date(before.StartDate), date(after.StartDate))
+	compare("end", date(before.EndDate), date(after.EndDate))
+	compare("registration deadline", date(before.RegistrationDeadline), date(after.RegistrationDeadline))
+	compare("submission deadline", date(before.SubmissionDeadline), date(after.SubmissionDeadline))
+	compare("prize", fmt.Sprintf("%d", before.PrizePool.Total), fmt.Sprintf("%d", after.PrizePool.Total))
+	compare("status", before.Status, after.Status)
+	compare("location", before.Location.Type+" "+before.Location.City, after.Location.Type+" "+after.Location.City)
+	compare("registration", before.RegistrationURL, after.RegistrationURL)
+
+	return changes
+}
@@ -0,0 +1,183 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"antoine-cli/internal/config"
+	"antoine-cli/internal/models"
+)
+
+func watchHackathon(id, url, name string, prize int) *models.Hackathon {
+	return &models.Hackathon{
+		ID:        id,
+		URL:       url,
+		Name:      name,
+		StartDate: time.Date(2024, 5, 10, 9, 0, 0, 0, time.FixedZone("CEST", 2*3600)),
+		EndDate:   time.Date(2024, 5, 12, 18, 0, 0, 0, time.FixedZone("CEST", 2*3600)),
+		Status:    "upcoming",
+		PrizePool: models.PrizeInfo{Total: prize},
+		Location:  models.Location{Type: "in-person", City: "Madrid"},
+	}
+}
+
+func TestDiffHackathons(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous []*models.Hackathon
+		current  func() []*models.Hackathon
+		added    []string
+		changed  []string // nombre: cambios
+		removed  int
+	}{
+		{
+			name:     "identical snapshots",
+			previous: []*models.Hackathon{watchHackathon("a", "", "Alpha", 1000), watchHackathon("", "https://b.dev", "Beta", 0)},
+			current: func() []*models.Hackathon {
+				return []*models.Hackathon{watchHackathon("", "https://b.dev", "Beta", 0), watchHackathon("a", "", "Alpha", 1000)}
+			},
+		},
+		{
+			name:     "new, changed and removed",
+			previous: []*models.Hackathon{watchHackathon("a", "", "Alpha", 1000), watchHackathon("", "", "Gamma", 0)},
+			current: func() []*models.Hackathon {
+				alpha := watchHackathon("a", "", "Alpha", 5000)
+				alpha.Status = "active"
+				return []*models.Hackathon{alpha, watchHackathon("d", "", "Delta", 0)}
+			},
+			added:   []string{"Delta"},
+			changed: []string{"Alpha: [prize: 1000 → 5000 status: upcoming → active]"},
+			removed: 1,
+		},
+		{
+			name:     "url key ignores case and trailing slash",
+			previous: []*models.Hackathon{watchHackathon("", "https://B.dev/", "Beta", 0)},
+			current: func() []*models.Hackathon {
+				beta := watchHackathon("", "https://b.dev", "Beta", 0)
+				beta.EndDate = beta.EndDate.AddDate(0, 0, 1)
+				return []*models.Hackathon{beta}
+			},
+			changed: []string{"Beta: [end: 2024-05-12 → 2024-05-13]"},
+		},
+		{
+			name:     "same instant in another zone is not a change",
+			previous: []*models.Hackathon{watchHackathon("a", "", "Alpha", 0)},
+			current: func() []*models.Hackathon {
+				alpha := watchHackathon("a", "", "Alpha", 0)
+				alpha.StartDate = alpha.StartDate.UTC()
+				return []*models.Hackathon{alpha}
+			},
+		},
+		{
+			name:     "everything gone",
+			previous: []*models.Hackathon{watchHackathon("a", "", "Alpha", 0), watchHackathon("b", "", "Beta", 0)},
+			current:  func() []*models.Hackathon { return nil },
+			removed:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			added, changed, removed := DiffHackathons(tt.previous, tt.current())
+
+			var addedNames, changes []string
+			for _, hackathon := range added {
+				addedNames = append(addedNames, hackathon.Name)
+			}
+			for _, change := range changed {
+				changes = append(changes, fmt.Sprintf("%s: %v", change.Hackathon.Name, change.Changes))
+			}
+			if fmt.Sprint(addedNames) != fmt.Sprint(tt.added) {
+				t.Errorf("added = %v, want %v", addedNames, tt.added)
+			}
+			if fmt.Sprint(changes) != fmt.Sprint(tt.changed) {
+				t.Errorf("changed = %v, want %v", changes, tt.changed)
+			}
+			if removed != tt.removed {
+				t.Errorf("removed = %d, want %d", removed, tt.removed)
+			}
+		})
+	}
+}
+
+func TestWatchSecondRunSendsNothing(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	var webhooks atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		webhooks.Add(1)
+	}))
+	defer server.Close()
+
+	var terminal bytes.Buffer
+	notifier := NewNotifier(config.NotificationsConfig{Enabled: true, Terminal: "osc9", Webhook: server.URL}, &terminal)
+	search := &SavedSearch{Name: "madrid", Query: "ai"}
+
+	// Como checkSearches en la vista: solo se notifica si hay novedades
+	run := func(hackathons []*models.Hackathon) *WatchResult {
+		t.Helper()
+		result, err := recordWatchSnapshot(search, hackathons)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.HasUpdates() {
+			if err := notifier.Notify(context.Background(), Notification{Title: "Antoine", Message: search.Name, Event: "watch.new"}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		return result
+	}
+
+	first := []*models.Hackathon{watchHackathon("a", "", "Alpha", 1000)}
+	if result := run(first); !result.Baseline || result.HasUpdates() {
+		t.Fatalf("first run = %+v, want a baseline without updates", result)
+	}
+
+	second := []*models.Hackathon{watchHackathon("a", "", "Alpha", 1000), watchHackathon("b", "", "Beta", 0)}
+	if result := run(second); result.Baseline || len(result.New) != 1 {
+		t.Fatalf("second run = %+v, want one new hackathon", result)
+	}
+	if webhooks.Load() != 1 || terminal.Len() == 0 {
+		t.Fatalf("webhooks = %d, terminal = %q, want one notification", webhooks.Load(), terminal.String())
+	}
+
+	// Los mismos resultados tras pasar por el JSON de la instantánea no son novedades
+	sent := terminal.String()
+	if result := run(second); result.HasUpdates() || result.Removed != 0 || result.Total != 2 {
+		t.Errorf("unchanged run = %+v, want no updates", result)
+	}
+	if webhooks.Load() != 1 || terminal.String() != sent {
+		t.Errorf("unchanged run sent webhooks = %d, terminal = %q", webhooks.Load(), terminal.String())
+	}
+
+	saved, err := GetSavedSearch(search.Name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.LastRun.IsZero() {
+		t.Error("last run not recorded")
+	}
+}
+
+func TestNotifyDisabledSendsNothing(t *testing.T) {
+	var webhooks atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		webhooks.Add(1)
+	}))
+	defer server.Close()
+
+	var terminal bytes.Buffer
+	notifier := NewNotifier(config.NotificationsConfig{Terminal: "bell", Webhook: server.URL}, &terminal)
+	if err := notifier.Notify(context.Background(), Notification{Title: "Antoine"}); err != nil {
+		t.Fatal(err)
+	}
+	if webhooks.Load() != 0 || terminal.Len() != 0 || notifier.Channels() != nil {
+		t.Errorf("disabled notifier sent webhooks = %d, terminal = %q", webhooks.Load(), terminal.String())
+	}
+}
@@ -2,7 +2,14 @@ package mcp
 
 import (
 	"antoine-cli/internal/models"
+	"antoine-cli/internal/utils"
 	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -66,11 +73,25 @@ func (e *ExaClient) SearchProjects(ctx context.Context, query string, filters ma
 	return projects, nil
 }
 
-func (e *ExaClient) SearchTrends(ctx context.Context, tech []string, timeframe string) (interface{}, error) {
+// SearchTrends busca la actividad de cada tecnología; con market se limita a ese mercado (defi, gaming...)
+func (e *ExaClient) SearchTrends(ctx context.Context, tech []string, timeframe, market string) (*models.TrendReport, error) {
+	period, bucket := trendPeriod(timeframe)
+	now := time.Now()
+
+	query := "(" + strings.Join(tech, " OR ") + ") hackathon project"
+	if market != "" {
+		query = market + " " + query
+	}
 	params := map[string]interface{}{
-		"technologies": tech,
-		"timeframe":    timeframe,
-		"type":         "trends",
+		"technologies":         tech,
+		"timeframe":            timeframe,
+		"type":                 "trends",
+		"query":                query,
+		"start_published_date": now.Add(-period).Format(time.RFC3339),
+		"num_results":          100,
+	}
+	if market != "" {
+		params["market"] = market
 	}
 
 	response, err := e.Call(ctx, "search", params)
@@ -78,5 +99,424 @@ func (e *ExaClient) SearchTrends(ctx context.Context, tech []string, timeframe s
 		return nil, err
 	}
 
-	return response.Result, nil
+	// Si el servidor ya devuelve un informe estructurado se usa tal cual
+	var report models.TrendReport
+	if err := mapToStruct(response.Result, &report); err == nil && len(report.Technologies) > 0 {
+		for i := range report.Technologies {
+			if report.Technologies[i].Momentum == "" {
+				report.Technologies[i].ComputeGrowth()
+			}
+		}
+		report.Market = market
+		return &report, nil
+	}
+
+	var search exaSearchResponse
+	if err := mapToStruct(response.Result, &search); err != nil {
+		return nil, fmt.Errorf("failed to parse trend results: %w", err)
+	}
+
+	trends := buildTrendReport(search.Results, tech, timeframe, now.Add(-period), bucket)
+	trends.Market = market
+	return trends, nil
+}
+
+// exaSearchResponse es la respuesta de búsqueda de Exa
+type exaSearchResponse struct {
+	Results []exaResult `json:"results"`
+}
+
+type exaResult struct {
+	Title         string   `json:"title"`
+	URL           string   `json:"url"`
+	PublishedDate string   `json:"publishedDate"`
+	Text          string   `json:"text"`
+	Highlights    []string `json:"highlights"`
+}
+
+// knownTechnologies es el vocabulario usado para detectar tecnologías relacionadas
+var knownTechnologies = []string{
+	"javascript", "typescript", "python", "go", "rust", "java", "c++", "c#",
+	"php", "ruby", "swift", "kotlin", "dart", "react", "vue", "angular", "svelte",
+	"nextjs", "django", "flask", "fastapi", "postgresql", "mongodb", "redis",
+	"docker", "kubernetes", "aws", "gcp", "azure", "blockchain", "solidity",
+	"web3", "ethereum", "defi", "ai", "ml", "llm", "tensorflow", "pytorch",
+	"opencv", "nlp", "iot", "ar", "vr", "flutter", "unity", "graphql",
+}
+
+// trendPeriod traduce el timeframe a la ventana de búsqueda y al tamaño de cada punto de la serie
+func trendPeriod(timeframe string) (time.Duration, time.Duration) {
+	const day = 24 * time.Hour
+
+	switch timeframe {
+	case "1month":
+		return 30 * day, 7 * day
+	case "3months":
+		return 90 * day, 15 * day
+	case "1year":
+		return 365 * day, 30 * day
+	default: // 6months
+		return 180 * day, 30 * day
+	}
+}
+
+// buildTrendReport agrega los resultados de búsqueda en series temporales por tecnología
+func buildTrendReport(results []exaResult, tech []string, timeframe string, since time.Time, bucket time.Duration) *models.TrendReport {
+	buckets := int(time.Since(since)/bucket) + 1
+
+	report := &models.TrendReport{
+		Timeframe:   timeframe,
+		Sources:     len(results),
+		GeneratedAt: time.Now(),
+	}
+
+	for _, name := range tech {
+		trend := models.TechnologyTrend{Name: name}
+		for i := 0; i < buckets; i++ {
+			trend.Series = append(trend.Series, models.TrendPoint{Date: since.Add(time.Duration(i) * bucket)})
+		}
+
+		related := make(map[string]int)
+		for _, result := range results {
+			content := strings.ToLower(result.Title + " " + result.Text + " " + strings.Join(result.Highlights, " "))
+			if !mentionsTechnology(content, name) {
+				continue
+			}
+
+			trend.Mentions++
+			if published, err := time.Parse(time.RFC3339, result.PublishedDate); err == nil && !published.Before(since) {
+				index := int(published.Sub(since) / bucket)
+				if index >= 0 && index < buckets {
+					trend.Series[index].Value++
+				}
+			}
+
+			lowerURL := strings.ToLower(result.URL)
+			if strings.Contains(content, "hackathon") || strings.Contains(lowerURL, "hackathon") {
+				trend.HackathonCount++
+			}
+			if strings.Contains(lowerURL, "github.com") || strings.Contains(lowerURL, "devpost.com") {
+				trend.ProjectCount++
+			}
+
+			for _, other := range knownTechnologies {
+				if !strings.EqualFold(other, name) && mentionsTechnology(content, other) {
+					related[other]++
+				}
+			}
+		}
+
+		for other, count := range related {
+			trend.Related = append(trend.Related, models.RelatedTechnology{Name: other, Cooccurring: count})
+		}
+		sort.Slice(trend.Related, func(i, j int) bool {
+			if trend.Related[i].Cooccurring == trend.Related[j].Cooccurring {
+				return trend.Related[i].Name < trend.Related[j].Name
+			}
+			return trend.Related[i].Cooccurring > trend.Related[j].Cooccurring
+		})
+		if len(trend.Related) > 5 {
+			trend.Related = trend.Related[:5]
+		}
+
+		trend.ComputeGrowth()
+		report.Technologies = append(report.Technologies, trend)
+	}
+
+	return report
+}
+
+// mentionsTechnology busca la tecnología como palabra completa para no confundir "go" con "google"
+func mentionsTechnology(content, tech string) bool {
+	tech = strings.ToLower(tech)
+	for start := 0; ; {
+		index := strings.Index(content[start:], tech)
+		if index < 0 {
+			return false
+		}
+		index += start
+		end := index + len(tech)
+
+		before := index == 0 || !isWordChar(content[index-1])
+		after := end == len(content) || !isWordChar(content[end])
+		if before && after {
+			return true
+		}
+		start = index + 1
+	}
+}
+
+func isWordChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_'
+}
+
+// SearchMarket busca competidores y noticias recientes de financiación de un dominio
+// y extrae de ellos los datos básicos del mercado
+func (e *ExaClient) SearchMarket(ctx context.Context, domain string) (*models.MarketReport, error) {
+	since := time.Now().AddDate(-1, 0, 0)
+
+	queries := map[string]string{
+		"competitors": domain + " startups companies competitors",
+		"funding":     domain + " startup raises funding round",
+		"market":      domain + " market size forecast",
+	}
+
+	results := make(map[string][]exaResult)
+	for kind, query := range queries {
+		params := map[string]interface{}{
+			"query":                query,
+			"type":                 "market",
+			"category":             kind,
+			"start_published_date": since.Format(time.RFC3339),
+			"num_results":          50,
+		}
+
+		response, err := e.Call(ctx, "search", params)
+		if err != nil {
+			return nil, err
+		}
+
+		var search exaSearchResponse
+		if err := mapToStruct(response.Result, &search); err != nil {
+			return nil, fmt.Errorf("failed to parse market results: %w", err)
+		}
+		results[kind] = search.Results
+	}
+
+	return buildMarketReport(domain, results), nil
+}
+
+// newsSources son medios cuyo dominio no cuenta como competidor
+var newsSources = map[string]bool{
+	"techcrunch": true, "crunchbase": true, "forbes": true, "bloomberg": true,
+	"reuters": true, "venturebeat": true, "businessinsider": true, "medium": true,
+	"wikipedia": true, "linkedin": true, "twitter": true, "x": true, "youtube": true,
+	"github": true, "producthunt": true, "ycombinator": true, "reddit": true,
+	"theverge": true, "wired": true, "cnbc": true, "statista": true, "gartner": true,
+	"marketsandmarkets": true, "grandviewresearch": true, "fortunebusinessinsights": true,
+}
+
+var (
+	moneyPattern = regexp.MustCompile(`\$\s?(\d{1,3}(?:,\d{3})+(?:\.\d+)?|\d+(?:\.\d+)?)(?:\s?(k|m|b|bn|million|billion|thousand)\b)?`)
+	roundPattern = regexp.MustCompile(`\b(pre-seed|seed|series [a-f])\b`)
+)
+
+// buildMarketReport agrega las búsquedas en noticias, rondas, competidores y tamaño de mercado
+func buildMarketReport(domain string, results map[string][]exaResult) *models.MarketReport {
+	report := &models.MarketReport{
+		Domain:      domain,
+		GeneratedAt: time.Now(),
+	}
+
+	seenURL := make(map[string]bool)
+	competitors := make(map[string]int)
+	competitorNames := make(map[string]string)
+	addCompetitor := func(name string) {
+		key := strings.ToLower(name)
+		if key == "" || newsSources[key] {
+			return
+		}
+		if _, ok := competitorNames[key]; !ok {
+			competitorNames[key] = name
+		}
+		competitors[key]++
+	}
+	var marketSize float64
+
+	for _, kind := range []string{"competitors", "funding", "market"} {
+		for _, result := range results[kind] {
+			if seenURL[result.URL] {
+				continue
+			}
+			seenURL[result.URL] = true
+			report.Sources++
+
+			content := strings.ToLower(result.Title + " " + result.Text + " " + strings.Join(result.Highlights, " "))
+			published, _ := time.Parse(time.RFC3339, result.PublishedDate)
+			source := siteName(result.URL)
+
+			// Competidores: empresas con web propia en los resultados, fichas de Crunchbase
+			// y empresas que anuncian rondas; los artículos sobre el sector no cuentan
+			addCompetitor(companyHomepage(result))
+			addCompetitor(crunchbaseCompany(result.URL))
+
+			if kind == "funding" || roundPattern.MatchString(content) {
+				report.News = append(report.News, models.MarketNews{
+					Title:     result.Title,
+					URL:       result.URL,
+					Source:    source,
+					Published: published,
+				})
+
+				if amount := largestAmount(strings.ToLower(result.Title + " " + result.Text)); amount > 0 && amount < 1e10 {
+					round := roundPattern.FindString(content)
+					company := fundedCompany(result.Title)
+					addCompetitor(company)
+					report.Funding = append(report.Funding, models.FundingRound{
+						Company:   company,
+						Amount:    amount,
+						Round:     round,
+						URL:       result.URL,
+						Published: published,
+					})
+					report.TotalFunding += amount
+				}
+			}
+
+			// El tamaño de mercado suele citarse como "$X billion market"
+			if kind == "market" && strings.Contains(content, "market") {
+				if amount := largestAmount(content); amount > marketSize {
+					marketSize = amount
+				}
+			}
+		}
+	}
+
+	sort.Slice(report.News, func(i, j int) bool {
+		return report.News[i].Published.After(report.News[j].Published)
+	})
+
+	keys := make([]string, 0, len(competitors))
+	for key := range competitors {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if competitors[keys[i]] == competitors[keys[j]] {
+			return keys[i] < keys[j]
+		}
+		return competitors[keys[i]] > competitors[keys[j]]
+	})
+	if len(keys) > 10 {
+		keys = keys[:10]
+	}
+	for _, key := range keys {
+		report.Analysis.Competitors = append(report.Analysis.Competitors, competitorNames[key])
+	}
+
+	if marketSize > 0 {
+		report.Analysis.MarketSize = utils.FormatUSD(marketSize)
+	}
+
+	// Tecnologías que aparecen en el dominio
+	trendCount := make(map[string]int)
+	for _, list := range results {
+		for _, result := range list {
+			content := strings.ToLower(result.Title + " " + result.Text)
+			for _, tech := range knownTechnologies {
+				if mentionsTechnology(content, tech) {
+					trendCount[tech]++
+				}
+			}
+		}
+	}
+	for tech := range trendCount {
+		report.Analysis.Trends = append(report.Analysis.Trends, tech)
+	}
+	sort.Slice(report.Analysis.Trends, func(i, j int) bool {
+		a, b := report.Analysis.Trends[i], report.Analysis.Trends[j]
+		if trendCount[a] == trendCount[b] {
+			return a < b
+		}
+		return trendCount[a] > trendCount[b]
+	})
+	if len(report.Analysis.Trends) > 5 {
+		report.Analysis.Trends = report.Analysis.Trends[:5]
+	}
+
+	return report
+}
+
+// secondLevelDomains son los segundos niveles genéricos de los dominios de país (acme.co.uk, acme.com.au)
+var secondLevelDomains = map[string]bool{
+	"co": true, "com": true, "org": true, "net": true, "ac": true, "gov": true, "edu": true,
+}
+
+// siteName devuelve el nombre del sitio sin www ni dominio de primer nivel
+func siteName(rawURL string) string {
+	parsed, err := url.Parse(rawURL)
+	if err != nil || parsed.Hostname() == "" {
+		return ""
+	}
+
+	parts := strings.Split(strings.TrimPrefix(strings.ToLower(parsed.Hostname()), "www."), ".")
+	if len(parts) < 2 {
+		return parts[0]
+	}
+	if len(parts) >= 3 && len(parts[len(parts)-1]) == 2 && secondLevelDomains[parts[len(parts)-2]] {
+		return parts[len(parts)-3]
+	}
+	return parts[len(parts)-2]
+}
+
+// companyHomepage devuelve el nombre de la empresa cuando el resultado es la portada de su
+// propia web ("Acme | AI tutoring for kids" en acme.com/), no un artículo sobre el sector
+func companyHomepage(result exaResult) string {
+	parsed, err := url.Parse(result.URL)
+	if err != nil || strings.Trim(parsed.Path, "/") != "" {
+		return ""
+	}
+	site := siteName(result.URL)
+	if site == "" || newsSources[site] {
+		return ""
+	}
+
+	name := result.Title
+	for _, separator := range []string{" | ", " - ", " – ", " — ", ": "} {
+		if index := strings.Index(name, separator); index > 0 {
+			name = name[:index]
+		}
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 40 {
+		return site
+	}
+	return name
+}
+
+// crunchbaseCompany extrae la empresa de una URL crunchbase.com/organization/<empresa>
+func crunchbaseCompany(rawURL string) string {
+	const prefix = "/organization/"
+
+	parsed, err := url.Parse(rawURL)
+	if err != nil || !strings.HasSuffix(parsed.Hostname(), "crunchbase.com") {
+		return ""
+	}
+	if index := strings.Index(parsed.Path, prefix); index >= 0 {
+		return strings.Trim(parsed.Path[index+len(prefix):], "/")
+	}
+	return ""
+}
+
+// fundedCompany toma el nombre de la empresa de titulares tipo "Acme raises $5M ..."
+func fundedCompany(title string) string {
+	lower := strings.ToLower(title)
+	for _, verb := range []string{" raises ", " secures ", " lands ", " closes ", " gets "} {
+		if index := strings.Index(lower, verb); index > 0 {
+			return strings.TrimSpace(title[:index])
+		}
+	}
+	return ""
+}
+
+// largestAmount devuelve la mayor cantidad en dólares citada en el texto
+func largestAmount(text string) float64 {
+	largest := 0.0
+	for _, match := range moneyPattern.FindAllStringSubmatch(text, -1) {
+		value, err := strconv.ParseFloat(strings.ReplaceAll(match[1], ",", ""), 64)
+		if err != nil {
+			continue
+		}
+		switch match[2] {
+		case "k", "thousand":
+			value *= 1e3
+		case "m", "million":
+			value *= 1e6
+		case "b", "bn", "billion":
+			value *= 1e9
+		}
+		if value > largest {
+			largest = value
+		}
+	}
+	return largest
 }
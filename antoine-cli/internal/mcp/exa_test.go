@@ -0,0 +1,69 @@
+package mcp
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSiteName(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://www.acme.com/pricing", "acme"},
+		{"https://blog.acme.io", "acme"},
+		{"https://www.acme.co.uk/", "acme"},
+		{"https://shop.acme.com.au/about", "acme"},
+		{"https://localhost:8080", "localhost"},
+		{"not a url", ""},
+	}
+
+	for _, tt := range tests {
+		if got := siteName(tt.url); got != tt.want {
+			t.Errorf("siteName(%q) = %q, want %q", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestLargestAmount(t *testing.T) {
+	tests := []struct {
+		text string
+		want float64
+	}{
+		{"acme raises $5m seed round", 5e6},
+		{"a prize pool of $10,000 for the winners", 1e4},
+		{"$1,250,000.50 in grants", 1250000.50},
+		{"$2.5 billion market by 2030, up from $900 million", 2.5e9},
+		{"$12k in prizes", 12e3},
+		{"no amounts here", 0},
+	}
+
+	for _, tt := range tests {
+		if got := largestAmount(tt.text); got != tt.want {
+			t.Errorf("largestAmount(%q) = %v, want %v", tt.text, got, tt.want)
+		}
+	}
+}
+
+func TestMarketCompetitors(t *testing.T) {
+	results := map[string][]exaResult{
+		"competitors": {
+			{Title: "Tutorly | AI tutoring for kids", URL: "https://tutorly.co.uk/"},
+			{Title: "Top 10 AI tutoring startups", URL: "https://techcrunch.com/2026/ai-tutoring"},
+			{Title: "Why AI tutoring works", URL: "https://someblog.com/posts/ai-tutoring"},
+			{Title: "LearnBot", URL: "https://www.crunchbase.com/organization/learnbot"},
+		},
+		"funding": {
+			{Title: "Brainly raises $10,000,000 Series A", URL: "https://news.example.com/brainly", Text: "series a"},
+		},
+	}
+
+	report := buildMarketReport("ai tutoring", results)
+	want := []string{"Brainly", "learnbot", "Tutorly"}
+	if !reflect.DeepEqual(report.Analysis.Competitors, want) {
+		t.Errorf("competitors = %v, want %v", report.Analysis.Competitors, want)
+	}
+	if report.TotalFunding != 1e7 {
+		t.Errorf("total funding = %v, want 1e7", report.TotalFunding)
+	}
+}
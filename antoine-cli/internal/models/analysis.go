@@ -19,6 +19,7 @@ type AnalysisOptions struct {
 	Technologies        []string `json:"technologies"`
 	Market              string   `json:"market"`
 	CompareWith         []string `json:"compare_with"`
+	Hackathon           string   `json:"hackathon,omitempty"` // ID, URL o nombre de un hackathon de la caché
 }
 
 type AnalysisResult struct {
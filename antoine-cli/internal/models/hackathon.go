@@ -5,27 +5,29 @@ import (
 )
 
 type Hackathon struct {
-	ID               string                 `json:"id"`
-	Name             string                 `json:"name"`
-	Description      string                 `json:"description"`
-	URL              string                 `json:"url"`
-	StartDate        time.Time              `json:"start_date"`
-	EndDate          time.Time              `json:"end_date"`
-	RegistrationURL  string                 `json:"registration_url"`
-	Location         Location               `json:"location"`
-	Technologies     []string               `json:"technologies"`
-	Categories       []string               `json:"categories"`
-	PrizePool        PrizeInfo              `json:"prize_pool"`
-	Organizer        Organizer              `json:"organizer"`
-	Difficulty       string                 `json:"difficulty"`
-	TeamSize         TeamSizeInfo           `json:"team_size"`
-	Requirements     []string               `json:"requirements"`
-	Themes           []string               `json:"themes"`
-	Status           string                 `json:"status"` // upcoming, active, completed
-	ParticipantCount int                    `json:"participant_count"`
-	ProjectCount     int                    `json:"project_count"`
-	Tags             []string               `json:"tags"`
-	Metadata         map[string]interface{} `json:"metadata"`
+	ID                   string                 `json:"id"`
+	Name                 string                 `json:"name"`
+	Description          string                 `json:"description"`
+	URL                  string                 `json:"url"`
+	StartDate            time.Time              `json:"start_date"`
+	EndDate              time.Time              `json:"end_date"`
+	RegistrationURL      string                 `json:"registration_url"`
+	RegistrationDeadline time.Time              `json:"registration_deadline"`
+	SubmissionDeadline   time.Time              `json:"submission_deadline"`
+	Location             Location               `json:"location"`
+	Technologies         []string               `json:"technologies"`
+	Categories           []string               `json:"categories"`
+	PrizePool            PrizeInfo              `json:"prize_pool"`
+	Organizer            Organizer              `json:"organizer"`
+	Difficulty           string                 `json:"difficulty"`
+	TeamSize             TeamSizeInfo           `json:"team_size"`
+	Requirements         []string               `json:"requirements"`
+	Themes               []string               `json:"themes"`
+	Status               string                 `json:"status"` // upcoming, active, completed
+	ParticipantCount     int                    `json:"participant_count"`
+	ProjectCount         int                    `json:"project_count"`
+	Tags                 []string               `json:"tags"`
+	Metadata             map[string]interface{} `json:"metadata"`
 }
 
 type Location struct {
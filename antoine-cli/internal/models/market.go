@@ -0,0 +1,29 @@
+package models
+
+import "time"
+
+type MarketReport struct {
+	Domain       string                 `json:"domain"`
+	Analysis     MarketAnalysis         `json:"analysis"`
+	News         []MarketNews           `json:"news"`
+	Funding      []FundingRound         `json:"funding"`
+	TotalFunding float64                `json:"total_funding_usd"`
+	Sources      int                    `json:"sources"`
+	GeneratedAt  time.Time              `json:"generated_at"`
+	Metadata     map[string]interface{} `json:"metadata,omitempty"`
+}
+
+type MarketNews struct {
+	Title     string    `json:"title"`
+	URL       string    `json:"url"`
+	Source    string    `json:"source"`
+	Published time.Time `json:"published"`
+}
+
+type FundingRound struct {
+	Company   string    `json:"company"`
+	Amount    float64   `json:"amount_usd"`
+	Round     string    `json:"round"` // seed, series a, ...
+	URL       string    `json:"url"`
+	Published time.Time `json:"published"`
+}
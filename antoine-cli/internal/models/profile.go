@@ -0,0 +1,65 @@
+package models
+
+import "time"
+
+type UserProfile struct {
+	Name            string           `json:"name"`
+	Skills          []string         `json:"skills"`
+	Technologies    []string         `json:"technologies"`     // tecnologías preferidas
+	Interests       []string         `json:"interests"`        // temas: fintech, salud, clima...
+	ExperienceLevel string           `json:"experience_level"` // beginner, intermediate, advanced
+	Timezone        string           `json:"timezone"`         // nombre IANA, p. ej. Europe/Madrid
+	Location        string           `json:"location"`
+	Travel          TravelPreference `json:"travel"`
+	Team            TeamPreference   `json:"team"`
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+}
+
+type TravelPreference struct {
+	Willing     bool `json:"willing"`
+	MaxDistance int  `json:"max_distance_km,omitempty"` // 0 = sin límite
+	OnlineOnly  bool `json:"online_only"`
+}
+
+type TeamPreference struct {
+	PreferredSize  int      `json:"preferred_size"`
+	Roles          []string `json:"roles"` // roles que el usuario quiere cubrir
+	LookingForTeam bool     `json:"looking_for_team"`
+}
+
+// Summary describe el perfil en una frase para dar contexto al mentor
+func (p *UserProfile) Summary() string {
+	summary := "a developer"
+	if p.ExperienceLevel != "" {
+		summary = "an " + p.ExperienceLevel + " developer"
+		if p.ExperienceLevel == "beginner" {
+			summary = "a beginner developer"
+		}
+	}
+
+	if len(p.Technologies) > 0 {
+		summary += " working with " + joinList(p.Technologies)
+	}
+	if p.Location != "" {
+		summary += " based in " + p.Location
+	}
+
+	return summary
+}
+
+// joinList une una lista en lenguaje natural: "a, b and c"
+func joinList(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	}
+
+	list := items[0]
+	for _, item := range items[1 : len(items)-1] {
+		list += ", " + item
+	}
+	return list + " and " + items[len(items)-1]
+}
@@ -66,8 +66,10 @@ type CodeQuality struct {
 	Complexity      ComplexityMetrics `json:"complexity"`
 	Documentation   float64           `json:"documentation_coverage"`
 	TestCoverage    float64           `json:"test_coverage"`
+	TestRatio       float64           `json:"test_ratio,omitempty"` // estimación por líneas de test; no es cobertura medida
 	Security        SecurityAnalysis  `json:"security"`
 	Maintainability float64           `json:"maintainability"`
+	Duplication     float64           `json:"duplication"` // porcentaje de líneas duplicadas
 	Architecture    ArchitectureScore `json:"architecture"`
 }
 
@@ -102,12 +104,19 @@ type DependencyAnalysis struct {
 }
 
 type DependencyDetail struct {
-	Name       string `json:"name"`
-	Version    string `json:"version"`
-	Latest     string `json:"latest"`
-	License    string `json:"license"`
-	Vulnerable bool   `json:"vulnerable"`
-	Severity   string `json:"severity,omitempty"`
+	Name       string   `json:"name"`
+	Version    string   `json:"version"`
+	Latest     string   `json:"latest"`
+	License    string   `json:"license"`
+	Vulnerable bool     `json:"vulnerable"`
+	Severity   string   `json:"severity,omitempty"`
+	Ecosystem  string   `json:"ecosystem,omitempty"` // Go, npm, PyPI, crates.io, Maven
+	Manifest   string   `json:"manifest,omitempty"`
+	Checksum   string   `json:"checksum,omitempty"` // integridad del lockfile en formato SRI (sha512-<base64>)
+	Path       string   `json:"path,omitempty"`     // ubicación en el lockfile de npm (node_modules/a/node_modules/b)
+	Requires   []string `json:"requires,omitempty"` // dependencias propias según el lockfile (nombre@versión)
+	Direct     bool     `json:"direct"`
+	Dev        bool     `json:"dev,omitempty"`
 }
 
 type ArchitectureScore struct {
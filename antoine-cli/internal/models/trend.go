@@ -0,0 +1,113 @@
+package models
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+type TrendReport struct {
+	Timeframe    string            `json:"timeframe"`
+	Market       string            `json:"market,omitempty"`
+	Technologies []TechnologyTrend `json:"technologies"`
+	Sources      int               `json:"sources"`
+	GeneratedAt  time.Time         `json:"generated_at"`
+}
+
+type TechnologyTrend struct {
+	Name           string              `json:"name"`
+	Series         []TrendPoint        `json:"series"`
+	GrowthRate     float64             `json:"growth_rate"` // % entre la primera y la segunda mitad del periodo
+	Momentum       string              `json:"momentum"`    // rising, stable, declining
+	Mentions       int                 `json:"mentions"`
+	HackathonCount int                 `json:"hackathon_count"`
+	ProjectCount   int                 `json:"project_count"`
+	Related        []RelatedTechnology `json:"related"`
+}
+
+type TrendPoint struct {
+	Date  time.Time `json:"date"`
+	Value float64   `json:"value"`
+}
+
+type RelatedTechnology struct {
+	Name        string `json:"name"`
+	Cooccurring int    `json:"cooccurring"`
+}
+
+// Technology busca la tendencia de una tecnología por nombre (sin distinguir mayúsculas)
+func (r *TrendReport) Technology(name string) (*TechnologyTrend, bool) {
+	for i := range r.Technologies {
+		if strings.EqualFold(r.Technologies[i].Name, name) {
+			return &r.Technologies[i], true
+		}
+	}
+	return nil, false
+}
+
+// SortByGrowth ordena las tecnologías de mayor a menor crecimiento
+func (r *TrendReport) SortByGrowth() {
+	sort.SliceStable(r.Technologies, func(i, j int) bool {
+		return r.Technologies[i].GrowthRate > r.Technologies[j].GrowthRate
+	})
+}
+
+// ComputeGrowth calcula GrowthRate y Momentum comparando las dos mitades de la serie
+func (t *TechnologyTrend) ComputeGrowth() {
+	t.computeGrowthAt(time.Now())
+}
+
+func (t *TechnologyTrend) computeGrowthAt(now time.Time) {
+	series := completeSeries(t.Series, now)
+	if len(series) < 2 {
+		t.GrowthRate = 0
+		t.Momentum = "stable"
+		return
+	}
+
+	half := len(series) / 2
+	var first, second float64
+	for i, point := range series {
+		if i < half {
+			first += point.Value
+		} else if i >= len(series)-half {
+			second += point.Value
+		}
+	}
+
+	switch {
+	case first == 0 && second == 0:
+		t.GrowthRate = 0
+	case first == 0:
+		t.GrowthRate = 100
+	default:
+		t.GrowthRate = (second - first) / first * 100
+	}
+
+	switch {
+	case t.GrowthRate >= 10:
+		t.Momentum = "rising"
+	case t.GrowthRate <= -10:
+		t.Momentum = "declining"
+	default:
+		t.Momentum = "stable"
+	}
+}
+
+// completeSeries quita los puntos finales cuyo periodo aún no terminó: un periodo a medias
+// tiene menos actividad y haría parecer que la tecnología está en declive
+func completeSeries(series []TrendPoint, now time.Time) []TrendPoint {
+	if len(series) < 2 {
+		return series
+	}
+
+	width := series[1].Date.Sub(series[0].Date)
+	if width <= 0 {
+		return series
+	}
+	end := len(series)
+	for end > 0 && series[end-1].Date.Add(width).After(now) {
+		end--
+	}
+	return series[:end]
+}
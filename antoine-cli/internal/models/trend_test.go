@@ -0,0 +1,43 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestComputeGrowthIgnoresPartialPeriod(t *testing.T) {
+	const week = 7 * 24 * time.Hour
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	series := func(values ...float64) []TrendPoint {
+		points := make([]TrendPoint, len(values))
+		for i, value := range values {
+			points[i] = TrendPoint{Date: start.Add(time.Duration(i) * week), Value: value}
+		}
+		return points
+	}
+
+	tests := []struct {
+		name     string
+		values   []float64
+		now      time.Time
+		growth   float64
+		momentum string
+	}{
+		// La última semana solo lleva un día: sin excluirla saldría "declining"
+		{"partial last period is skipped", []float64{10, 10, 10, 10, 1}, start.Add(4*week + 24*time.Hour), 0, "stable"},
+		{"all periods complete", []float64{10, 10, 20, 20}, start.Add(4 * week), 100, "rising"},
+		{"declining complete periods", []float64{20, 20, 5, 5, 0}, start.Add(4*week + time.Hour), -75, "declining"},
+		{"single complete period", []float64{3, 1}, start.Add(week + time.Hour), 0, "stable"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trend := TechnologyTrend{Series: series(tt.values...)}
+			trend.computeGrowthAt(tt.now)
+			if trend.GrowthRate != tt.growth || trend.Momentum != tt.momentum {
+				t.Errorf("growth = %.1f %s, want %.1f %s", trend.GrowthRate, trend.Momentum, tt.growth, tt.momentum)
+			}
+		})
+	}
+}
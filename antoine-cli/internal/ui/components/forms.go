@@ -600,6 +600,14 @@ func (f *Form) FocusPrev() {
 	}
 }
 
+// FocusedField returns the ID of the focused field, or "" when an action button is focused
+func (f *Form) FocusedField() string {
+	if f.focusedField < len(f.config.Fields) {
+		return f.config.Fields[f.focusedField].ID
+	}
+	return ""
+}
+
 // isFieldSkippable checks if a field should be skipped during navigation
 func (f *Form) isFieldSkippable(fieldIndex int) bool {
 	if fieldIndex >= len(f.config.Fields) {
@@ -522,6 +522,13 @@ func (mp *MultiProgress) IncrementProgress(id string, delta float64) {
 	}
 }
 
+// SetColor sets the color of a specific bar
+func (mp *MultiProgress) SetColor(id string, color lipgloss.Color) {
+	if bar, exists := mp.bars[id]; exists {
+		bar.SetColor(color)
+	}
+}
+
 // RemoveProgress removes a progress bar
 func (mp *MultiProgress) RemoveProgress(id string) {
 	delete(mp.bars, id)
@@ -769,10 +776,10 @@ func UploadProgress(filename string) *Progress {
 }
 
 // InstallProgress creates a progress bar for installation operations
-func InstallProgress(package string) *Progress {
+func InstallProgress(pkg string) *Progress {
 return NewProgressBuilder().
 Type(ProgressTypeSteps).
-Label(fmt.Sprintf("Installing %s", package)).
+Label(fmt.Sprintf("Installing %s", pkg)).
 ShowPercent(true).
 Color(styles.Green).
 Build()
@@ -347,7 +347,7 @@ func (t *Table) calculateColumnWidths() {
 	autoColumns := 0
 
 	// First pass: account for fixed-width columns
-	for i, col := range t.config.Columns {
+	for _, col := range t.config.Columns {
 		if col.Width > 0 {
 			availableWidth -= col.Width
 		} else {
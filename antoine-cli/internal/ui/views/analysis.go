@@ -2,6 +2,7 @@ package views
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -13,6 +14,8 @@ import (
 
 	"antoine-cli/internal/core"
 	"antoine-cli/internal/models"
+	"antoine-cli/internal/ui/styles"
+	"antoine-cli/internal/utils"
 	"antoine-cli/pkg/ascii"
 )
 
@@ -22,6 +25,8 @@ type AnalysisView struct {
 
 type AnalysisOptions struct {
 	RepoURL             string
+	Path                string // árbol de trabajo local: se analiza sin red
+	Repos               []string
 	Depth               string
 	IncludeDependencies bool
 	GenerateReport      bool
@@ -29,6 +34,9 @@ type AnalysisOptions struct {
 	Tech                []string
 	Timeframe           string
 	Metrics             bool
+	Market              string
+	ProjectFile         string
+	Hackathon           string // hackathon de la caché cuyos requisitos se comprueban
 	Format              string
 }
 
@@ -78,14 +86,325 @@ func (av *AnalysisView) AnalyzeTrends(options *AnalysisOptions) {
 	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
 	defer cancel()
 
-	trends, err := av.client.GetTrends(ctx, options.Tech, options.Timeframe)
+	trends, err := av.client.GetTrends(ctx, options.Tech, options.Timeframe, options.Market)
 	if err != nil {
 		fmt.Printf("Error analyzing trends: %v\n", err)
 		return
 	}
+	trends.SortByGrowth()
 
-	fmt.Printf("📈 Technology Trends (%s)\n\n", options.Timeframe)
-	fmt.Printf("Results: %+v\n", trends)
+	if options.Format == "json" {
+		printJSON(trends)
+		return
+	}
+
+	fmt.Println(renderTrendReport(trends, options.Metrics))
+}
+
+// AnalyzeMarket muestra competidores, financiación y potencial de mercado de un dominio
+func (av *AnalysisView) AnalyzeMarket(options *AnalysisOptions) {
+	ctx, cancel := context.WithTimeout(context.Background(), 90*time.Second)
+	defer cancel()
+
+	var project *models.Project
+	if options.ProjectFile != "" {
+		var err error
+		project, err = core.LoadProjectFile(options.ProjectFile)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+	}
+
+	report, err := av.client.AnalyzeMarket(ctx, options.Market, project)
+	if err != nil {
+		fmt.Printf("❌ Market analysis failed: %v\n", err)
+		return
+	}
+
+	if project != nil {
+		if err := core.SaveProjectFile(options.ProjectFile, project); err != nil {
+			fmt.Printf("❌ Failed to update project: %v\n", err)
+			return
+		}
+	}
+
+	if options.Format == "json" {
+		printJSON(report)
+		return
+	}
+
+	fmt.Println(renderMarketReport(report))
+	if project != nil {
+		fmt.Printf("\n🔗 Market potential saved to %s (%s)\n", options.ProjectFile, project.Name)
+	}
+}
+
+// renderMarketReport dibuja las puntuaciones, competidores y noticias del mercado
+func renderMarketReport(report *models.MarketReport) string {
+	var s strings.Builder
+	analysis := report.Analysis
+
+	sectionStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+
+	s.WriteString(ascii.GetBanner("Market Analysis: "+report.Domain, ascii.EmojiTarget, 80))
+	s.WriteString("\n\n")
+
+	marketSize := analysis.MarketSize
+	if marketSize == "" {
+		marketSize = "unknown"
+	}
+	s.WriteString(fmt.Sprintf("💰 Market size:       %s\n", marketSize))
+	s.WriteString(fmt.Sprintf("⚔️  Competition level: %d/10\n\n", analysis.Competition))
+
+	s.WriteString(sectionStyle.Render("📊 Scores"))
+	s.WriteString("\n")
+	for _, score := range []struct {
+		label string
+		value float64
+	}{
+		{"Overall", analysis.Score},
+		{"Opportunity", analysis.Opportunity},
+		{"Commercial viability", analysis.Viability},
+		{"User need", analysis.UserNeed},
+	} {
+		s.WriteString(fmt.Sprintf("  %-22s %s\n", score.label, ascii.GetProgressBar(int(score.value), 100, 30)))
+	}
+	s.WriteString("\n")
+
+	if len(analysis.Competitors) > 0 {
+		s.WriteString(sectionStyle.Render("🏢 Competitors"))
+		s.WriteString("\n")
+		s.WriteString("  " + strings.Join(analysis.Competitors, ", ") + "\n\n")
+	}
+
+	if len(report.Funding) > 0 {
+		s.WriteString(sectionStyle.Render(fmt.Sprintf("💸 Recent Funding (%s total)", utils.FormatUSD(report.TotalFunding))))
+		s.WriteString("\n")
+		for _, round := range report.Funding[:min(len(report.Funding), 5)] {
+			s.WriteString(fmt.Sprintf("  • %-25s %8s  %s\n",
+				utils.TruncateString(round.Company, 25), utils.FormatUSD(round.Amount), round.Round))
+		}
+		s.WriteString("\n")
+	}
+
+	if len(report.News) > 0 {
+		s.WriteString(sectionStyle.Render("📰 Recent News"))
+		s.WriteString("\n")
+		for _, news := range report.News[:min(len(report.News), 5)] {
+			published := ""
+			if !news.Published.IsZero() {
+				published = " (" + utils.TimeAgo(news.Published) + ")"
+			}
+			s.WriteString(fmt.Sprintf("  • %s — %s%s\n", utils.TruncateString(news.Title, 60), news.Source, published))
+		}
+		s.WriteString("\n")
+	}
+
+	if len(analysis.Trends) > 0 {
+		s.WriteString(sectionStyle.Render("📈 Trends"))
+		s.WriteString("\n")
+		s.WriteString("  " + strings.Join(analysis.Trends, ", ") + "\n\n")
+	}
+
+	if len(analysis.Advantages) > 0 {
+		s.WriteString(sectionStyle.Render("✨ Advantages"))
+		s.WriteString("\n")
+		for _, advantage := range analysis.Advantages {
+			s.WriteString("  • " + advantage + "\n")
+		}
+	}
+
+	return s.String()
+}
+
+// renderTrendReport dibuja una fila por tecnología con su serie, crecimiento y contexto
+func renderTrendReport(report *models.TrendReport, metrics bool) string {
+	var s strings.Builder
+
+	headerStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	risingStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#9ece6a"))
+	decliningStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#f7768e"))
+	mutedStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#565f89"))
+
+	title := fmt.Sprintf("Technology Trends (%s)", report.Timeframe)
+	if report.Market != "" {
+		title = fmt.Sprintf("Technology Trends in %s (%s)", report.Market, report.Timeframe)
+	}
+	s.WriteString(ascii.GetBanner(title, ascii.EmojiRocket, 80))
+	s.WriteString("\n\n")
+
+	if len(report.Technologies) == 0 {
+		s.WriteString("No trend data available for the selected technologies\n")
+		return s.String()
+	}
+
+	s.WriteString(headerStyle.Render(fmt.Sprintf("%-16s %-14s %9s %-10s %8s %10s %8s",
+		"TECHNOLOGY", "ACTIVITY", "GROWTH", "MOMENTUM", "MENTIONS", "HACKATHONS", "PROJECTS")))
+	s.WriteString("\n")
+
+	for _, trend := range report.Technologies {
+		momentum := fmt.Sprintf("%-10s", trend.Momentum)
+		switch trend.Momentum {
+		case "rising":
+			momentum = risingStyle.Render("↗ " + fmt.Sprintf("%-8s", trend.Momentum))
+		case "declining":
+			momentum = decliningStyle.Render("↘ " + fmt.Sprintf("%-8s", trend.Momentum))
+		default:
+			momentum = "→ " + fmt.Sprintf("%-8s", trend.Momentum)
+		}
+
+		s.WriteString(fmt.Sprintf("%-16s %-14s %8.1f%% %s %8d %10d %8d\n",
+			utils.TruncateString(trend.Name, 16),
+			sparkline(trend.Series, 14),
+			trend.GrowthRate,
+			momentum,
+			trend.Mentions,
+			trend.HackathonCount,
+			trend.ProjectCount))
+
+		if len(trend.Related) > 0 {
+			var related []string
+			for _, other := range trend.Related[:min(3, len(trend.Related))] {
+				related = append(related, fmt.Sprintf("%s (%d)", other.Name, other.Cooccurring))
+			}
+			s.WriteString(mutedStyle.Render(fmt.Sprintf("%-16s related: %s", "", strings.Join(related, ", "))))
+			s.WriteString("\n")
+		}
+
+		if metrics {
+			for _, point := range trend.Series {
+				s.WriteString(mutedStyle.Render(fmt.Sprintf("%-16s %s  %.0f", "", point.Date.Format("2006-01-02"), point.Value)))
+				s.WriteString("\n")
+			}
+		}
+	}
+
+	s.WriteString(fmt.Sprintf("\n📚 Based on %d sources · generated %s\n", report.Sources, report.GeneratedAt.Format("2006-01-02 15:04")))
+	return s.String()
+}
+
+// sparkline representa la serie con bloques de altura proporcional
+func sparkline(series []models.TrendPoint, width int) string {
+	if len(series) == 0 {
+		return strings.Repeat(" ", width)
+	}
+
+	levels := []rune("▁▂▃▄▅▆▇█")
+	maxValue := 0.0
+	for _, point := range series {
+		if point.Value > maxValue {
+			maxValue = point.Value
+		}
+	}
+
+	var line []rune
+	for _, point := range series {
+		level := 0
+		if maxValue > 0 {
+			level = int(point.Value / maxValue * float64(len(levels)-1))
+		}
+		line = append(line, levels[level])
+	}
+	if len(line) > width {
+		line = line[len(line)-width:]
+	}
+
+	return string(line) + strings.Repeat(" ", width-len(line))
+}
+
+// CompareRepositories analiza varios repositorios y muestra la matriz de comparación
+func (av *AnalysisView) CompareRepositories(options *AnalysisOptions) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	analysisOptions := &models.AnalysisOptions{
+		Depth:               options.Depth,
+		IncludeDependencies: options.IncludeDependencies,
+	}
+	if options.Focus != "" {
+		analysisOptions.Focus = strings.Split(options.Focus, ",")
+	}
+
+	if options.Format != "json" {
+		fmt.Printf("🔬 Comparing %d repositories...\n\n", len(options.Repos))
+	}
+
+	comparison, err := av.client.CompareRepositories(ctx, options.Repos, analysisOptions)
+	if err != nil {
+		fmt.Printf("❌ Comparison failed: %v\n", err)
+		return
+	}
+
+	if options.Format == "json" {
+		printJSON(comparison)
+		return
+	}
+
+	fmt.Println(renderComparisonMatrix(comparison))
+}
+
+// renderComparisonMatrix dibuja la matriz resaltando el mejor valor de cada fila
+func renderComparisonMatrix(comparison *core.RepositoryComparison) string {
+	const labelWidth = 20
+	const columnWidth = 18
+
+	var s strings.Builder
+
+	headerStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	sectionStyle := lipgloss.NewStyle().Foreground(ascii.Cyan).Bold(true)
+	bestStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	errorStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#f7768e"))
+
+	s.WriteString(ascii.GetBanner("Repository Comparison", ascii.EmojiTarget, 80))
+	s.WriteString("\n\n")
+
+	header := fmt.Sprintf("%-*s", labelWidth, "")
+	for _, target := range comparison.Targets {
+		header += fmt.Sprintf("%*s", columnWidth, utils.TruncateString(target.Name, columnWidth-2))
+	}
+	s.WriteString(headerStyle.Render(header))
+	s.WriteString("\n")
+
+	section := ""
+	for _, row := range comparison.Matrix {
+		if row.Section != section {
+			section = row.Section
+			s.WriteString("\n")
+			s.WriteString(sectionStyle.Render(section))
+			s.WriteString("\n")
+		}
+
+		s.WriteString(fmt.Sprintf("  %-*s", labelWidth-2, row.Metric))
+		for i, value := range row.Display {
+			cell := fmt.Sprintf("%*s", columnWidth, utils.TruncateString(value, columnWidth-2))
+			if containsIndex(row.Best, i) {
+				cell = bestStyle.Render(fmt.Sprintf("%*s", columnWidth, "★ "+utils.TruncateString(value, columnWidth-4)))
+			}
+			s.WriteString(cell)
+		}
+		s.WriteString("\n")
+	}
+
+	// Repositorios que no se pudieron analizar
+	for _, target := range comparison.Targets {
+		if target.Error != "" {
+			s.WriteString("\n")
+			s.WriteString(errorStyle.Render(fmt.Sprintf("⚠️  %s: %s", target.Name, target.Error)))
+		}
+	}
+	s.WriteString("\n★ best value in each row")
+
+	return s.String()
+}
+
+func containsIndex(indexes []int, index int) bool {
+	for _, i := range indexes {
+		if i == index {
+			return true
+		}
+	}
+	return false
 }
 
 func (av *AnalysisView) createAnalysisModel(options *AnalysisOptions) analysisModel {
@@ -98,7 +417,7 @@ func (av *AnalysisView) createAnalysisModel(options *AnalysisOptions) analysisMo
 	return analysisModel{
 		spinner:  s,
 		progress: prog,
-		repoURL:  options.RepoURL,
+		repoURL:  options.target(),
 		options:  options,
 		loading:  true,
 		step:     "Initializing analysis...",
@@ -219,8 +538,7 @@ func (m analysisModel) performAnalysis() tea.Cmd {
 			analysisOptions.Focus = strings.Split(m.options.Focus, ",")
 		}
 
-		//result, err := av.client.AnalyzeRepository(ctx, m.repoURL, analysisOptions)
-		result, err := m.client.AnalyzeRepository(ctx, m.repoURL, analysisOptions)
+		result, err := analyzeRepository(ctx, m.client, m.options, analysisOptions)
 		return analysisCompleteMsg{result: result, err: err}
 	}
 }
@@ -263,6 +581,8 @@ func (m analysisModel) renderResults() string {
 		}
 	}
 
+	s.WriteString(renderLicenseSection(result))
+
 	// Estadísticas de tiempo
 	s.WriteString(fmt.Sprintf("⏱️  Analysis completed in %v\n", result.Duration))
 
@@ -273,7 +593,7 @@ func (av *AnalysisView) analyzeRepositoryNonInteractive(options *AnalysisOptions
 	ctx, cancel := context.WithTimeout(context.Background(), 120*time.Second)
 	defer cancel()
 
-	fmt.Printf("🔬 Analyzing repository: %s\n", options.RepoURL)
+	fmt.Printf("🔬 Analyzing repository: %s\n", options.target())
 
 	analysisOptions := &models.AnalysisOptions{
 		Depth:               options.Depth,
@@ -284,7 +604,7 @@ func (av *AnalysisView) analyzeRepositoryNonInteractive(options *AnalysisOptions
 		analysisOptions.Focus = strings.Split(options.Focus, ",")
 	}
 
-	result, err := av.client.AnalyzeRepository(ctx, options.RepoURL, analysisOptions)
+	result, err := analyzeRepository(ctx, av.client, options, analysisOptions)
 	if err != nil {
 		fmt.Printf("❌ Analysis failed: %v\n", err)
 		return
@@ -308,5 +628,81 @@ func (av *AnalysisView) analyzeRepositoryNonInteractive(options *AnalysisOptions
 				fmt.Printf("• %s: %s\n", insight.Title, insight.Description)
 			}
 		}
+		fmt.Print("\n" + renderLicenseSection(result))
+	}
+}
+
+// licenseSectionIssues limita los problemas de licencia que se listan
+const licenseSectionIssues = 5
+
+// renderLicenseSection muestra la licencia del proyecto, las categorías de las dependencias y
+// los problemas encontrados; vacío si el análisis no trae informe de licencias
+func renderLicenseSection(result *models.AnalysisResult) string {
+	var report core.LicenseReport
+	switch value := result.Metadata["licenses"].(type) {
+	case core.LicenseReport:
+		report = value
+	case nil:
+		return ""
+	default: // resultados leídos de disco (jobs)
+		data, err := json.Marshal(value)
+		if err != nil || json.Unmarshal(data, &report) != nil {
+			return ""
+		}
+	}
+
+	var s strings.Builder
+	s.WriteString("⚖️  Licenses:\n\n")
+	project := report.Project.License
+	switch {
+	case project == "":
+		project = lipgloss.NewStyle().Foreground(styles.Red).Render("none")
+	case len(report.Project.Files) > 0:
+		project += fmt.Sprintf(" (%s)", strings.Join(report.Project.Files, ", "))
+	case report.Project.Manifest != "":
+		project += fmt.Sprintf(" (declared in %s)", report.Project.Manifest)
+	}
+	s.WriteString(fmt.Sprintf("Project: %s\n", project))
+
+	var categories []string
+	for _, category := range []string{core.LicensePermissive, core.LicenseWeakCopyleft, core.LicenseStrongCopyleft,
+		core.LicenseNetworkCopyleft, core.LicenseProprietary, core.LicenseUnknown} {
+		if count := report.Categories[category]; count > 0 {
+			categories = append(categories, fmt.Sprintf("%d %s", count, category))
+		}
+	}
+	if len(categories) > 0 {
+		s.WriteString(fmt.Sprintf("Dependencies: %s\n", strings.Join(categories, " · ")))
+	}
+
+	if len(report.Issues) == 0 {
+		s.WriteString(lipgloss.NewStyle().Foreground(styles.Green).Render("✓ No license issues") + "\n\n")
+		return s.String()
+	}
+	for _, issue := range report.Issues[:min(licenseSectionIssues, len(report.Issues))] {
+		label := severityStyle(issue.Severity).Render(fmt.Sprintf("%-6s", strings.ToUpper(issue.Severity)))
+		s.WriteString(fmt.Sprintf("%s %s\n", label, issue.Message))
+	}
+	if more := len(report.Issues) - licenseSectionIssues; more > 0 {
+		s.WriteString(fmt.Sprintf("... and %d more\n", more))
+	}
+	s.WriteString("\n")
+	return s.String()
+}
+
+// analyzeRepository analiza el repositorio remoto o, con Path, el árbol de trabajo local
+func analyzeRepository(ctx context.Context, client *core.AntoineClient, options *AnalysisOptions, analysisOptions *models.AnalysisOptions) (*models.AnalysisResult, error) {
+	analysisOptions.Hackathon = options.Hackathon
+	if options.Path != "" {
+		return client.AnalyzeLocalRepository(ctx, options.Path, analysisOptions)
+	}
+	return client.AnalyzeRepository(ctx, options.RepoURL, analysisOptions)
+}
+
+// target es lo que se analiza: la URL del repositorio o la ruta local
+func (o *AnalysisOptions) target() string {
+	if o.Path != "" {
+		return o.Path
 	}
+	return o.RepoURL
 }
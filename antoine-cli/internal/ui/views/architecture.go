@@ -0,0 +1,118 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/ui/styles"
+	"antoine-cli/internal/utils"
+	"antoine-cli/pkg/ascii"
+)
+
+type ArchitectureView struct{}
+
+type ArchitectureOptions struct {
+	Path   string
+	Graph  string // archivo .dot, .gv, .mmd o .md donde exportar el grafo
+	Limits core.LocalLimits
+	Format string
+}
+
+func NewArchitectureView() *ArchitectureView {
+	return &ArchitectureView{}
+}
+
+// Show construye el grafo de imports de Path y lista el acoplamiento, los ciclos y las violaciones de capa
+func (av *ArchitectureView) Show(options *ArchitectureOptions) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// Validar el formato antes de recorrer el árbol
+	if options.Graph != "" {
+		if _, err := core.GraphFormatForFile(options.Graph); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+	}
+
+	graph, err := core.BuildImportGraph(ctx, options.Path, options.Limits)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	if options.Graph != "" {
+		if err := graph.WriteGraph(options.Graph); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+	}
+
+	if options.Format == "json" {
+		printJSON(struct {
+			*core.ImportGraph
+			Coupling models.CouplingMetrics `json:"coupling"`
+		}{graph, graph.Coupling()})
+		return
+	}
+
+	titleStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	headerStyle := lipgloss.NewStyle().Foreground(ascii.Cyan).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+
+	coupling := graph.Coupling()
+	fmt.Println(titleStyle.Render(fmt.Sprintf("🏛️  Architecture of %s", graph.Root)))
+	fmt.Printf("   %d files • %d packages • %d imports • max Ca %d • max Ce %d • stability %.2f\n\n",
+		graph.Files, len(graph.Packages), len(graph.Edges), coupling.Afferent, coupling.Efferent, coupling.Stability)
+
+	fmt.Println(headerStyle.Render(fmt.Sprintf("%-44s %-12s %5s %4s %4s %5s  %s", "package", "layer", "files", "Ca", "Ce", "I", "language")))
+	for _, pkg := range graph.Packages {
+		layer := pkg.Layer
+		if layer == "" {
+			layer = "-"
+		}
+		fmt.Printf("%-44s %-12s %5d %4d %4d %s  %s\n", utils.TruncateString(pkg.Name, 44), layer, pkg.Files,
+			pkg.Afferent, pkg.Efferent, instabilityStyle(pkg).Render(fmt.Sprintf("%5.2f", pkg.Instability)), dimStyle.Render(pkg.Language))
+	}
+
+	fmt.Println()
+	if len(graph.Cycles) == 0 {
+		fmt.Println(lipgloss.NewStyle().Foreground(styles.Green).Render("✅ No import cycles"))
+	} else {
+		fmt.Println(lipgloss.NewStyle().Foreground(styles.Red).Bold(true).Render(fmt.Sprintf("🔁 %d import cycles", len(graph.Cycles))))
+		for _, cycle := range graph.Cycles {
+			fmt.Printf("   %s\n", strings.Join(cycle, " → "))
+		}
+	}
+	if len(graph.Violations) == 0 {
+		fmt.Println(lipgloss.NewStyle().Foreground(styles.Green).Render("✅ No layer violations"))
+	} else {
+		fmt.Println(lipgloss.NewStyle().Foreground(styles.Orange).Bold(true).Render(fmt.Sprintf("⚠️  %d layer violations", len(graph.Violations))))
+		for _, violation := range graph.Violations {
+			fmt.Printf("   %s (%s) → %s (%s)\n", violation.From, violation.FromLayer, violation.To, violation.ToLayer)
+		}
+	}
+
+	if options.Graph != "" {
+		fmt.Println()
+		fmt.Println(dimStyle.Render("Graph written to " + options.Graph))
+	}
+}
+
+// instabilityStyle resalta los paquetes muy usados y a la vez muy inestables
+func instabilityStyle(pkg core.PackageCoupling) lipgloss.Style {
+	switch {
+	case pkg.Afferent+pkg.Efferent == 0:
+		return lipgloss.NewStyle().Foreground(styles.Gray)
+	case pkg.Afferent >= 3 && pkg.Instability > 0.7:
+		return lipgloss.NewStyle().Foreground(styles.Orange)
+	default:
+		return lipgloss.NewStyle().Foreground(styles.Green)
+	}
+}
@@ -0,0 +1,234 @@
+package views
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/ui/components"
+	"antoine-cli/internal/ui/styles"
+	"antoine-cli/internal/utils"
+	"antoine-cli/pkg/ascii"
+	"antoine-cli/pkg/terminal"
+)
+
+type BatchView struct {
+	client *core.AntoineClient
+}
+
+type BatchOptions struct {
+	File                string
+	OutputDir           string
+	Concurrency         int
+	Depth               string
+	IncludeDependencies bool
+	Focus               []string
+	Format              string
+}
+
+func NewBatchView(client *core.AntoineClient) *BatchView {
+	return &BatchView{client: client}
+}
+
+// batchProgress redibuja las barras de progreso de cada repositorio en el mismo sitio
+type batchProgress struct {
+	bars        *components.MultiProgress
+	ids         map[string]string
+	interactive bool
+	lines       int
+	mu          sync.Mutex
+}
+
+// AnalyzeBatch analiza todos los repositorios del archivo y muestra el ranking
+func (bv *BatchView) AnalyzeBatch(options *BatchOptions) {
+	targets, err := core.ParseBatchFile(options.File)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	runner, err := core.NewBatchRunner(bv.client, options.OutputDir, options.Concurrency)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	entries := runner.Prepare(options.File, targets, models.AnalysisOptions{
+		Depth:               options.Depth,
+		IncludeDependencies: options.IncludeDependencies,
+		Focus:               options.Focus,
+	})
+
+	done := 0
+	for _, entry := range entries {
+		if entry.Status == core.BatchCompleted {
+			done++
+		}
+	}
+
+	fmt.Printf("🔬 Analyzing %d repositories (%d concurrent)\n", len(entries), options.Concurrency)
+	if done > 0 {
+		fmt.Printf("♻️  Resuming: %d already analyzed in %s\n", done, runner.OutputDir())
+	}
+	fmt.Println()
+
+	// Ctrl+C detiene el lote; el estado queda guardado para reanudarlo
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	progress := newBatchProgress(entries)
+	progress.draw()
+
+	err = runner.Run(ctx, progress.update)
+	progress.draw()
+	fmt.Println()
+
+	if errors.Is(err, context.Canceled) {
+		fmt.Println("⏸️  Batch interrupted. Run the same command again to resume.")
+		fmt.Println()
+	} else if err != nil {
+		fmt.Printf("❌ Batch failed: %v\n", err)
+		return
+	}
+
+	ranking := runner.Ranking()
+	if options.Format == "json" {
+		printJSON(ranking)
+		return
+	}
+
+	printBatchRanking(ranking)
+	fmt.Printf("\n📁 Results written to %s\n", runner.OutputDir())
+}
+
+func newBatchProgress(entries []*core.BatchEntry) *batchProgress {
+	width := terminal.GetTerminalWidth()
+	if width <= 0 || width > 100 {
+		width = 100
+	}
+
+	bp := &batchProgress{
+		bars:        components.NewMultiProgress("Repositories", width),
+		ids:         make(map[string]string),
+		interactive: terminal.IsRunningInTerminal(),
+	}
+
+	for i, entry := range entries {
+		// El índice desambigua repos con el mismo nombre (forks, owners distintos)
+		id := fmt.Sprintf("%d. %s", i+1, utils.TruncateString(entry.Name, 15))
+		bp.ids[entry.Key] = id
+
+		bp.bars.AddProgress(id, components.ProgressConfig{
+			Type:        components.ProgressTypeBar,
+			ShowPercent: true,
+		})
+		if entry.Status == core.BatchCompleted {
+			bp.bars.SetProgress(id, 1)
+			bp.bars.SetColor(id, styles.Green)
+		}
+	}
+
+	return bp
+}
+
+// update recibe el progreso del runner (llamado desde varias goroutines)
+func (bp *batchProgress) update(entry *core.BatchEntry, value float64) {
+	bp.mu.Lock()
+	id := bp.ids[entry.Key]
+	bp.bars.SetProgress(id, value)
+	switch entry.Status {
+	case core.BatchCompleted:
+		bp.bars.SetColor(id, styles.Green)
+	case core.BatchFailed:
+		bp.bars.SetColor(id, styles.Red)
+	}
+	bp.mu.Unlock()
+
+	if bp.interactive {
+		bp.draw()
+		return
+	}
+
+	// Sin terminal se imprime una línea por repositorio terminado
+	switch entry.Status {
+	case core.BatchCompleted:
+		fmt.Printf("✅ %s\n", entry.Name)
+	case core.BatchFailed:
+		fmt.Printf("❌ %s: %s\n", entry.Name, entry.Error)
+	}
+}
+
+// draw vuelve a pintar las barras sobre las líneas anteriores
+func (bp *batchProgress) draw() {
+	if !bp.interactive {
+		return
+	}
+
+	bp.mu.Lock()
+	defer bp.mu.Unlock()
+
+	if bp.lines > 0 {
+		fmt.Printf("\033[%dA\033[J", bp.lines)
+	}
+	output := bp.bars.Render()
+	fmt.Println(output)
+	bp.lines = strings.Count(output, "\n") + 1
+}
+
+// printBatchRanking imprime la tabla de ranking del lote
+func printBatchRanking(ranking []*core.BatchEntry) {
+	fmt.Println(ascii.GetBanner("Batch Ranking", ascii.EmojiTrophy, 80))
+	fmt.Println()
+
+	headerStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	fmt.Println(headerStyle.Render(fmt.Sprintf("%-4s %-40s %7s %9s  %-10s %s",
+		"#", "REPOSITORY", "SCORE", "INSIGHTS", "STATUS", "TIME")))
+
+	bestStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	failedStyle := lipgloss.NewStyle().Foreground(styles.Red)
+
+	for i, entry := range ranking {
+		score := "-"
+		if entry.HasScore {
+			score = fmt.Sprintf("%.1f", entry.Score)
+		}
+
+		row := fmt.Sprintf("%-4d %-40s %7s %9d  %-10s %s",
+			i+1,
+			utils.TruncateString(entry.Name, 40),
+			score,
+			entry.Insights,
+			entry.Status,
+			entry.Duration)
+
+		switch {
+		case i == 0 && entry.Status == core.BatchCompleted:
+			row = bestStyle.Render(row + "  🏆")
+		case entry.Status == core.BatchFailed:
+			row = failedStyle.Render(row)
+		}
+		fmt.Println(row)
+	}
+
+	var failed []*core.BatchEntry
+	for _, entry := range ranking {
+		if entry.Status == core.BatchFailed {
+			failed = append(failed, entry)
+		}
+	}
+	if len(failed) > 0 {
+		fmt.Printf("\n⚠️  %d repositories failed:\n", len(failed))
+		for _, entry := range failed {
+			fmt.Printf("  • %s: %s\n", entry.Name, entry.Error)
+		}
+	}
+}
@@ -0,0 +1,440 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/ui/styles"
+	"antoine-cli/internal/utils"
+	"antoine-cli/pkg/ascii"
+	"antoine-cli/pkg/terminal"
+)
+
+type BoardView struct{}
+
+func NewBoardView() *BoardView {
+	return &BoardView{}
+}
+
+// Show abre el tablero kanban; sin terminal o con --format json lo lista
+func (bv *BoardView) Show(format string) {
+	board, err := core.LoadBoard()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	if format != "interactive" || !terminal.IsRunningInTerminal() {
+		bv.list(board, format)
+		return
+	}
+	if len(board.Entries) == 0 {
+		fmt.Println("📋 Your board is empty. Add a hackathon with 'antoine board add <id|url|name>'")
+		return
+	}
+
+	p := tea.NewProgram(newBoardModel(board), tea.WithAltScreen())
+	if _, err := p.Run(); err != nil {
+		fmt.Printf("Error: %v\n", err)
+	}
+}
+
+// List muestra el tablero como texto agrupado por estado
+func (bv *BoardView) List(format string) {
+	board, err := core.LoadBoard()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	bv.list(board, format)
+}
+
+func (bv *BoardView) list(board *core.Board, format string) {
+	if format == "json" {
+		columns := make(map[string][]*core.BoardEntry, len(core.BoardStatuses))
+		for _, status := range core.BoardStatuses {
+			columns[status] = board.Column(status)
+		}
+		printJSON(columns)
+		return
+	}
+
+	if len(board.Entries) == 0 {
+		fmt.Println("📋 Your board is empty. Add a hackathon with 'antoine board add <id|url|name>'")
+		return
+	}
+
+	nameStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+
+	for _, status := range core.BoardStatuses {
+		entries := board.Column(status)
+		fmt.Printf("%s %s (%d)\n", boardStatusIcon(status), strings.ToUpper(status), len(entries))
+		for _, entry := range entries {
+			fmt.Printf("   %s  %s  %s\n", nameStyle.Render(entry.Hackathon.Name), hackathonDate(entry.Hackathon),
+				dimStyle.Render(entry.Hackathon.ID))
+			for _, project := range entry.Projects {
+				fmt.Printf("      🔗 %s\n", project)
+			}
+			if len(entry.Notes) > 0 {
+				last := entry.Notes[len(entry.Notes)-1]
+				fmt.Printf("      📝 %s %s\n", last.Text, dimStyle.Render("("+utils.TimeAgo(last.CreatedAt)+")"))
+			}
+		}
+		fmt.Println()
+	}
+}
+
+// Add pone un hackathon de la caché local en el tablero; status vacío no cambia el de uno existente
+func (bv *BoardView) Add(ref, status, note string) {
+	hackathon, err := core.FindCachedHackathon(ref)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	bv.update(func(board *core.Board) (string, error) {
+		entry, err := board.Add(hackathon, status)
+		if err != nil {
+			return "", err
+		}
+		if note != "" {
+			entry.AddNote(note)
+		}
+		return fmt.Sprintf("📋 %s is now %s", hackathon.Name, entry.Status), nil
+	})
+}
+
+// Move cambia el estado de una participación
+func (bv *BoardView) Move(ref, status string) {
+	bv.updateEntry(ref, func(entry *core.BoardEntry) string {
+		from := entry.Status
+		entry.Move(status)
+		return fmt.Sprintf("%s %s: %s → %s", boardStatusIcon(status), entry.Hackathon.Name, from, status)
+	})
+}
+
+// Note añade una nota a una participación
+func (bv *BoardView) Note(ref, text string) {
+	bv.updateEntry(ref, func(entry *core.BoardEntry) string {
+		entry.AddNote(text)
+		return fmt.Sprintf("📝 Note added to %s", entry.Hackathon.Name)
+	})
+}
+
+// Link enlaza un proyecto a una participación
+func (bv *BoardView) Link(ref, link string) {
+	bv.updateEntry(ref, func(entry *core.BoardEntry) string {
+		if !entry.LinkProject(link) {
+			return fmt.Sprintf("🔗 %s is already linked to %s", link, entry.Hackathon.Name)
+		}
+		return fmt.Sprintf("🔗 Linked %s to %s", link, entry.Hackathon.Name)
+	})
+}
+
+// Remove quita un hackathon del tablero
+func (bv *BoardView) Remove(ref string) {
+	bv.update(func(board *core.Board) (string, error) {
+		entry, err := board.Find(ref)
+		if err != nil {
+			return "", err
+		}
+		board.Remove(entry)
+		return fmt.Sprintf("🗑️  Removed %s from the board", entry.Hackathon.Name), nil
+	})
+}
+
+func (bv *BoardView) updateEntry(ref string, change func(entry *core.BoardEntry) string) {
+	bv.update(func(board *core.Board) (string, error) {
+		entry, err := board.Find(ref)
+		if err != nil {
+			return "", err
+		}
+		return change(entry), nil
+	})
+}
+
+// update carga el tablero, aplica el cambio y lo guarda
+func (bv *BoardView) update(change func(board *core.Board) (string, error)) {
+	board, err := core.LoadBoard()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	message, err := change(board)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	if err := core.SaveBoard(board); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	fmt.Println(message)
+}
+
+func boardStatusIcon(status string) string {
+	switch status {
+	case core.BoardInterested:
+		return "👀"
+	case core.BoardRegistered:
+		return "✍️ "
+	case core.BoardBuilding:
+		return "🔨"
+	case core.BoardSubmitted:
+		return "📤"
+	case core.BoardJudged:
+		return "🏆"
+	default:
+		return "•"
+	}
+}
+
+// boardModel es el kanban interactivo: una columna por estado
+type boardModel struct {
+	board   *core.Board
+	columns [][]*core.BoardEntry
+	col     int
+	row     int
+	detail  bool
+	noting  bool
+	input   textinput.Model
+	message string
+	width   int
+	height  int
+}
+
+func newBoardModel(board *core.Board) boardModel {
+	input := textinput.New()
+	input.Placeholder = "Write a note and press enter..."
+	input.CharLimit = 280
+	input.Width = 60
+
+	m := boardModel{board: board, input: input, width: 100}
+	m.refresh()
+	// Empezar en la primera columna con tarjetas
+	for i, column := range m.columns {
+		if len(column) > 0 {
+			m.col = i
+			break
+		}
+	}
+	return m
+}
+
+// refresh recalcula las columnas y mantiene el cursor dentro de rango
+func (m *boardModel) refresh() {
+	m.columns = make([][]*core.BoardEntry, len(core.BoardStatuses))
+	for i, status := range core.BoardStatuses {
+		m.columns[i] = m.board.Column(status)
+	}
+	m.row = max(0, min(m.row, len(m.columns[m.col])-1))
+}
+
+func (m boardModel) selected() *core.BoardEntry {
+	column := m.columns[m.col]
+	if m.row < len(column) {
+		return column[m.row]
+	}
+	return nil
+}
+
+// save guarda el tablero y deja el resultado en la línea de estado
+func (m *boardModel) save(message string) {
+	if err := core.SaveBoard(m.board); err != nil {
+		m.message = "❌ " + err.Error()
+		return
+	}
+	m.message = message
+}
+
+func (m boardModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m boardModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		if m.noting {
+			return m.updateNote(msg)
+		}
+
+		switch msg.String() {
+		case "ctrl+c", "q", "esc":
+			return m, tea.Quit
+		case "left", "h":
+			m.col = max(0, m.col-1)
+			m.row = max(0, min(m.row, len(m.columns[m.col])-1))
+		case "right", "l":
+			m.col = min(len(m.columns)-1, m.col+1)
+			m.row = max(0, min(m.row, len(m.columns[m.col])-1))
+		case "up", "k":
+			m.row = max(0, m.row-1)
+		case "down", "j":
+			m.row = max(0, min(len(m.columns[m.col])-1, m.row+1))
+		case "shift+right", ">", "L":
+			m.step(1)
+		case "shift+left", "<", "H":
+			m.step(-1)
+		case "enter":
+			m.detail = !m.detail
+		case "n":
+			if m.selected() != nil {
+				m.noting = true
+				m.input.SetValue("")
+				return m, m.input.Focus()
+			}
+		}
+	}
+	return m, nil
+}
+
+// step mueve la tarjeta seleccionada a la columna siguiente o anterior, y el cursor con ella
+func (m *boardModel) step(delta int) {
+	entry := m.selected()
+	if entry == nil || !entry.Step(delta) {
+		return
+	}
+
+	m.col += delta
+	m.refresh()
+	for i, e := range m.columns[m.col] {
+		if e == entry {
+			m.row = i
+		}
+	}
+	m.save(fmt.Sprintf("%s %s → %s", boardStatusIcon(entry.Status), entry.Hackathon.Name, entry.Status))
+}
+
+func (m boardModel) updateNote(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.noting = false
+		m.input.Blur()
+		return m, nil
+	case "enter":
+		m.noting = false
+		m.input.Blur()
+		if text := strings.TrimSpace(m.input.Value()); text != "" {
+			entry := m.selected()
+			entry.AddNote(text)
+			m.save("📝 Note added to " + entry.Hackathon.Name)
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func (m boardModel) View() string {
+	var s strings.Builder
+
+	s.WriteString(ascii.GetBanner("Hackathon Board", ascii.EmojiTarget, m.width))
+	s.WriteString("\n\n")
+
+	// Cinco columnas con el espacio disponible
+	columnWidth := max(18, (m.width-len(m.columns)*2)/len(m.columns))
+	var columns []string
+	for i, entries := range m.columns {
+		columns = append(columns, m.renderColumn(i, entries, columnWidth))
+	}
+	s.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, columns...))
+	s.WriteString("\n")
+
+	if m.detail {
+		if entry := m.selected(); entry != nil {
+			s.WriteString(renderBoardEntry(entry, m.width))
+			s.WriteString("\n")
+		}
+	}
+
+	if m.noting {
+		s.WriteString("📝 " + m.input.View() + "\n")
+	} else if m.message != "" {
+		s.WriteString(m.message + "\n")
+	}
+
+	helpStyle := lipgloss.NewStyle().Foreground(ascii.Cyan)
+	if m.noting {
+		s.WriteString(helpStyle.Render("enter to save • esc to cancel"))
+	} else {
+		s.WriteString(helpStyle.Render("←/→ column • ↑/↓ card • </> move card • n note • enter details • q quit"))
+	}
+	return s.String()
+}
+
+func (m boardModel) renderColumn(index int, entries []*core.BoardEntry, width int) string {
+	status := core.BoardStatuses[index]
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(ascii.Cyan).Width(width).Align(lipgloss.Center)
+	if index == m.col {
+		headerStyle = headerStyle.Foreground(ascii.Gold)
+	}
+
+	cardStyle := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(styles.DarkGray).
+		Width(width-2).
+		Padding(0, 1)
+	selectedStyle := cardStyle.BorderForeground(ascii.Gold)
+	dimStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+
+	lines := []string{headerStyle.Render(fmt.Sprintf("%s %s (%d)", boardStatusIcon(status), strings.Title(status), len(entries)))}
+
+	for i, entry := range entries {
+		info := []string{hackathonDate(entry.Hackathon)}
+		if len(entry.Notes) > 0 {
+			info = append(info, fmt.Sprintf("📝%d", len(entry.Notes)))
+		}
+		if len(entry.Projects) > 0 {
+			info = append(info, fmt.Sprintf("🔗%d", len(entry.Projects)))
+		}
+
+		card := utils.TruncateString(entry.Hackathon.Name, width-4) + "\n" + dimStyle.Render(strings.Join(info, " "))
+		style := cardStyle
+		if index == m.col && i == m.row {
+			style = selectedStyle
+		}
+		lines = append(lines, style.Render(card))
+	}
+
+	return lipgloss.NewStyle().Width(width).MarginRight(2).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+// renderBoardEntry muestra el detalle de una participación
+func renderBoardEntry(entry *core.BoardEntry, width int) string {
+	var s strings.Builder
+	hackathon := entry.Hackathon
+	nameStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+
+	s.WriteString(nameStyle.Render(hackathon.Name) + "  " + dimStyle.Render(hackathon.ID) + "\n")
+	s.WriteString(fmt.Sprintf("📅 %s • %s %s\n", hackathonDate(hackathon), boardStatusIcon(entry.Status), entry.Status))
+	if hackathon.URL != "" {
+		s.WriteString("🌐 " + hackathon.URL + "\n")
+	}
+	for _, project := range entry.Projects {
+		s.WriteString("🔗 " + project + "\n")
+	}
+	for _, note := range entry.Notes[max(0, len(entry.Notes)-3):] {
+		s.WriteString(fmt.Sprintf("📝 %s %s\n", utils.TruncateString(note.Text, width-20), dimStyle.Render(utils.TimeAgo(note.CreatedAt))))
+	}
+	if len(entry.History) > 0 {
+		last := entry.History[len(entry.History)-1]
+		s.WriteString(dimStyle.Render(fmt.Sprintf("moved %s → %s %s", last.From, last.To, utils.TimeAgo(last.At))) + "\n")
+	}
+
+	return lipgloss.NewStyle().Border(lipgloss.RoundedBorder()).BorderForeground(ascii.Cyan).Padding(0, 1).Render(strings.TrimRight(s.String(), "\n"))
+}
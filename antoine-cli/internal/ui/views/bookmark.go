@@ -0,0 +1,86 @@
+package views
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/ui/styles"
+	"antoine-cli/pkg/ascii"
+)
+
+type BookmarkView struct{}
+
+func NewBookmarkView() *BookmarkView {
+	return &BookmarkView{}
+}
+
+// Add marca un hackathon de la caché local por ID, URL o nombre
+func (bv *BookmarkView) Add(ref, note string) {
+	hackathon, err := core.FindCachedHackathon(ref)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	if _, err := core.AddBookmark(hackathon, note); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	fmt.Printf("⭐ Bookmarked %s (%s). Export it with 'antoine calendar export'\n", hackathon.Name, hackathonDate(hackathon))
+}
+
+// Remove quita la marca de un hackathon
+func (bv *BookmarkView) Remove(ref string) {
+	bookmark, err := core.FindBookmark(ref)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	if err := core.RemoveBookmark(bookmark.Hackathon); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	fmt.Printf("🗑️  Removed bookmark for %s\n", bookmark.Hackathon.Name)
+}
+
+// List muestra los hackathons marcados con sus plazos
+func (bv *BookmarkView) List(format string) {
+	bookmarks, err := core.LoadBookmarks()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	if format == "json" {
+		printJSON(bookmarks)
+		return
+	}
+
+	if len(bookmarks) == 0 {
+		fmt.Println("No bookmarks yet. Add one with 'antoine bookmark add <id|url|name>'")
+		return
+	}
+
+	nameStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+
+	for _, bookmark := range bookmarks {
+		hackathon := bookmark.Hackathon
+		fmt.Printf("%s  %s\n", nameStyle.Render(hackathon.Name), hackathonDate(hackathon))
+		if !hackathon.RegistrationDeadline.IsZero() {
+			fmt.Printf("   ⏰ registration closes %s\n", hackathon.RegistrationDeadline.Format("2006-01-02 15:04 MST"))
+		}
+		if !hackathon.SubmissionDeadline.IsZero() {
+			fmt.Printf("   📤 submissions due %s\n", hackathon.SubmissionDeadline.Format("2006-01-02 15:04 MST"))
+		}
+		if bookmark.Note != "" {
+			fmt.Printf("   📌 %s\n", bookmark.Note)
+		}
+		if hackathon.URL != "" {
+			fmt.Println(dimStyle.Render("   " + hackathon.URL))
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package views
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"time"
+
+	"antoine-cli/internal/core"
+)
+
+type CalendarView struct{}
+
+type CalendarOptions struct {
+	Output string // archivo .ics; vacío = stdout
+	Cached bool   // incluir todos los hackathons de la caché, no solo los marcados
+	Past   bool
+	Alarms []time.Duration
+	Name   string
+}
+
+func NewCalendarView() *CalendarView {
+	return &CalendarView{}
+}
+
+// Export escribe los hackathons marcados (y opcionalmente los de la caché) como iCalendar
+func (cv *CalendarView) Export(options *CalendarOptions) {
+	bookmarks, err := core.LoadBookmarks()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return
+	}
+
+	// Las participaciones ya juzgadas que solo estaban en el tablero no van al calendario
+	bookmarks = slices.DeleteFunc(bookmarks, func(bookmark *core.Bookmark) bool {
+		return bookmark.BoardOnly && bookmark.Status == core.BoardJudged
+	})
+
+	hackathons, err := core.CalendarHackathons(bookmarks, options.Cached, options.Past, time.Now())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return
+	}
+	if len(hackathons) == 0 {
+		fmt.Fprintln(os.Stderr, "📅 Nothing to export: bookmark hackathons with 'antoine bookmark add', track them with 'antoine board add' or use --cached")
+		return
+	}
+
+	var out io.Writer = os.Stdout
+	if options.Output != "" {
+		file, err := os.Create(options.Output)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "❌ Failed to create %s: %v\n", options.Output, err)
+			return
+		}
+		defer file.Close()
+		out = file
+	}
+
+	calendar := core.CalendarOptions{Name: options.Name, Alarms: options.Alarms, Bookmarks: bookmarks}
+	if err := core.WriteCalendar(out, hackathons, calendar); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ Failed to write calendar: %v\n", err)
+		return
+	}
+
+	if options.Output != "" {
+		fmt.Printf("📅 Exported %d hackathons to %s\n", len(hackathons), options.Output)
+	}
+}
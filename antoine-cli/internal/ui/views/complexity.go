@@ -0,0 +1,89 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/ui/styles"
+	"antoine-cli/internal/utils"
+	"antoine-cli/pkg/ascii"
+)
+
+type ComplexityView struct{}
+
+type ComplexityOptions struct {
+	Path   string
+	Top    int
+	Sort   string // cognitive o cyclomatic
+	Limits core.LocalLimits
+	Format string
+}
+
+func NewComplexityView() *ComplexityView {
+	return &ComplexityView{}
+}
+
+// Show calcula la complejidad de las funciones de Path y lista las Top más complejas
+func (cv *ComplexityView) Show(options *ComplexityOptions) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	report, err := core.AnalyzeComplexity(ctx, options.Path, options.Limits)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	top := report.Top(options.Top, options.Sort)
+
+	if options.Format == "json" {
+		printJSON(struct {
+			*core.ComplexityReport
+			Functions []core.FunctionComplexity `json:"functions"`
+		}{report, top})
+		return
+	}
+
+	titleStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	headerStyle := lipgloss.NewStyle().Foreground(ascii.Cyan).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("🧮 Complexity of %s", report.Root)))
+	fmt.Printf("   %d files • %d functions • avg cyclomatic %.1f • avg cognitive %.1f • %d over limits (cyclomatic > %d or cognitive > %d)\n\n",
+		report.Files, len(report.Functions), report.Cyclomatic, report.Cognitive,
+		report.OverLimit, core.CyclomaticThreshold, core.CognitiveThreshold)
+
+	if len(top) == 0 {
+		fmt.Println("No functions found")
+	} else {
+		fmt.Println(headerStyle.Render(fmt.Sprintf("%4s  %9s  %10s  %5s  %-40s %s", "#", "cognitive", "cyclomatic", "lines", "function", "location")))
+		for i, function := range top {
+			cognitive := complexityStyle(function.Cognitive, core.CognitiveThreshold).Render(fmt.Sprintf("%9d", function.Cognitive))
+			cyclomatic := complexityStyle(function.Cyclomatic, core.CyclomaticThreshold).Render(fmt.Sprintf("%10d", function.Cyclomatic))
+			fmt.Printf("%4d  %s  %s  %5d  %-40s %s\n", i+1, cognitive, cyclomatic, function.Lines,
+				utils.TruncateString(function.Name, 40), dimStyle.Render(fmt.Sprintf("%s:%d", function.File, function.Line)))
+		}
+	}
+
+	for _, parseError := range report.Errors {
+		fmt.Println(dimStyle.Render("⚠️  " + parseError))
+	}
+}
+
+// complexityStyle colorea un valor según lo cerca que esté del umbral
+func complexityStyle(value, threshold int) lipgloss.Style {
+	switch {
+	case value > 2*threshold:
+		return lipgloss.NewStyle().Foreground(styles.Red).Bold(true)
+	case value > threshold:
+		return lipgloss.NewStyle().Foreground(styles.Orange)
+	case value > threshold/2:
+		return lipgloss.NewStyle().Foreground(styles.Yellow)
+	default:
+		return lipgloss.NewStyle().Foreground(styles.Green)
+	}
+}
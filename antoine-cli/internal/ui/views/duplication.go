@@ -0,0 +1,89 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/ui/styles"
+	"antoine-cli/pkg/ascii"
+)
+
+type DuplicationView struct{}
+
+type DuplicationOptions struct {
+	Path      string
+	Top       int
+	MinTokens int
+	Limits    core.LocalLimits
+	Format    string
+}
+
+func NewDuplicationView() *DuplicationView {
+	return &DuplicationView{}
+}
+
+// Show busca código duplicado en Path y lista los Top bloques más grandes con sus copias
+func (dv *DuplicationView) Show(options *DuplicationOptions) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	report, err := core.DetectDuplication(ctx, options.Path, options.Limits, options.MinTokens)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	blocks := report.Blocks
+	if options.Top > 0 && len(blocks) > options.Top {
+		blocks = blocks[:options.Top]
+	}
+
+	if options.Format == "json" {
+		printJSON(struct {
+			*core.DuplicationReport
+			Blocks []core.DuplicateBlock `json:"blocks"`
+		}{report, blocks})
+		return
+	}
+
+	titleStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	headerStyle := lipgloss.NewStyle().Foreground(ascii.Cyan).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("📋 Duplicated code in %s", report.Root)))
+	fmt.Printf("   %d files • %d lines • %s duplicated (%d lines in %d blocks of %d+ tokens)\n\n",
+		report.Files, report.Lines, duplicationStyle(report.Percentage).Render(fmt.Sprintf("%.1f%%", report.Percentage)),
+		report.DuplicatedLines, len(report.Blocks), report.MinTokens)
+
+	if len(blocks) == 0 {
+		fmt.Println(lipgloss.NewStyle().Foreground(styles.Green).Render("✅ No duplicated blocks"))
+		return
+	}
+	fmt.Println(headerStyle.Render(fmt.Sprintf("%4s  %5s  %6s  %6s  %s", "#", "lines", "tokens", "copies", "locations")))
+	for i, block := range blocks {
+		for j, location := range block.Locations {
+			where := fmt.Sprintf("%s:%d-%d", location.File, location.StartLine, location.EndLine)
+			if j == 0 {
+				fmt.Printf("%4d  %5d  %6d  %6d  %s\n", i+1, block.Lines, block.Tokens, len(block.Locations), where)
+			} else {
+				fmt.Printf("%29s%s\n", "", dimStyle.Render(where))
+			}
+		}
+	}
+}
+
+// duplicationStyle colorea el porcentaje: hasta un 3% es normal, más de un 10% es alto
+func duplicationStyle(percentage float64) lipgloss.Style {
+	switch {
+	case percentage > 10:
+		return lipgloss.NewStyle().Foreground(styles.Red).Bold(true)
+	case percentage > 3:
+		return lipgloss.NewStyle().Foreground(styles.Orange)
+	default:
+		return lipgloss.NewStyle().Foreground(styles.Green)
+	}
+}
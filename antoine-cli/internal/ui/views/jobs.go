@@ -0,0 +1,223 @@
+package views
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/utils"
+	"antoine-cli/pkg/ascii"
+)
+
+type JobsView struct {
+	client *core.AntoineClient
+	jobs   *core.JobManager
+}
+
+type JobsOptions struct {
+	ID     string
+	Follow bool
+	Format string
+}
+
+func NewJobsView(client *core.AntoineClient, jobs *core.JobManager) *JobsView {
+	return &JobsView{client: client, jobs: jobs}
+}
+
+// ListJobs muestra todos los trabajos en segundo plano
+func (jv *JobsView) ListJobs(options *JobsOptions) {
+	jobs, err := jv.jobs.List()
+	if err != nil {
+		fmt.Printf("❌ Failed to list jobs: %v\n", err)
+		return
+	}
+
+	if options.Format == "json" {
+		printJSON(jobs)
+		return
+	}
+
+	if len(jobs) == 0 {
+		fmt.Println("No background jobs. Start one with 'antoine analyze repo <url> --detach'")
+		return
+	}
+
+	headerStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	fmt.Println(headerStyle.Render(fmt.Sprintf("%-10s %-12s %-40s %-10s %8s  %s",
+		"ID", "TYPE", "TARGET", "STATUS", "PROGRESS", "CREATED")))
+
+	for _, job := range jobs {
+		fmt.Printf("%-10s %-12s %-40s %-10s %7d%%  %s\n",
+			job.ID,
+			job.Request.Type,
+			utils.TruncateString(job.Request.Target, 40),
+			jobStatusStyle(job.Status()).Render(fmt.Sprintf("%-10s", job.Status())),
+			job.Result.Progress,
+			utils.TimeAgo(job.CreatedAt))
+	}
+}
+
+// ShowStatus muestra el detalle de un trabajo
+func (jv *JobsView) ShowStatus(options *JobsOptions) {
+	job, err := jv.jobs.Get(options.ID)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	if options.Format == "json" {
+		printJSON(job)
+		return
+	}
+
+	fmt.Printf("🧵 Job %s\n\n", job.ID)
+	fmt.Printf("  Type:     %s\n", job.Request.Type)
+	fmt.Printf("  Target:   %s\n", job.Request.Target)
+	fmt.Printf("  Status:   %s\n", jobStatusStyle(job.Status()).Render(job.Status()))
+	fmt.Printf("  Progress: %s\n", ascii.GetProgressBar(job.Result.Progress, 100, 30))
+	fmt.Printf("  Created:  %s (%s)\n", job.CreatedAt.Format("2006-01-02 15:04:05"), utils.TimeAgo(job.CreatedAt))
+
+	if !job.Result.StartTime.IsZero() {
+		fmt.Printf("  Started:  %s\n", job.Result.StartTime.Format("2006-01-02 15:04:05"))
+	}
+	if job.Result.EndTime != nil {
+		fmt.Printf("  Finished: %s (took %s)\n", job.Result.EndTime.Format("2006-01-02 15:04:05"), utils.FormatDuration(job.Result.Duration))
+	}
+	if job.PID > 0 && !job.Finished() {
+		fmt.Printf("  Worker:   pid %d\n", job.PID)
+	}
+	if job.Error != "" {
+		fmt.Printf("  Error:    %s\n", job.Error)
+	}
+
+	if job.Status() == core.JobCompleted {
+		fmt.Printf("\n💡 Run 'antoine jobs result %s' to see the analysis\n", job.ID)
+	}
+}
+
+// ShowLogs imprime el log del trabajo, opcionalmente siguiéndolo hasta que termine
+func (jv *JobsView) ShowLogs(options *JobsOptions) {
+	job, err := jv.jobs.Get(options.ID)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	printed := 0
+	for {
+		logs, err := jv.jobs.ReadLog(job.ID)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+		if len(logs) > printed {
+			fmt.Print(logs[printed:])
+			printed = len(logs)
+		}
+
+		if !options.Follow {
+			return
+		}
+
+		job, err = jv.jobs.Get(job.ID)
+		if err != nil || job.Finished() {
+			return
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+}
+
+// CancelJob cancela un trabajo encolado o en ejecución
+func (jv *JobsView) CancelJob(options *JobsOptions) {
+	job, err := jv.jobs.Cancel(options.ID)
+	if err != nil {
+		fmt.Printf("❌ Failed to cancel job: %v\n", err)
+		return
+	}
+
+	fmt.Printf("🛑 Job %s cancelled\n", job.ID)
+}
+
+// ShowResult muestra el resultado de un trabajo completado
+func (jv *JobsView) ShowResult(options *JobsOptions) {
+	job, err := jv.jobs.Get(options.ID)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	if job.Status() != core.JobCompleted {
+		if job.Finished() {
+			fmt.Printf("❌ Job %s %s, no result available\n", job.ID, job.Status())
+		} else {
+			fmt.Printf("⏳ Job %s is %s (%d%%), no result available yet\n", job.ID, job.Status(), job.Result.Progress)
+		}
+		if job.Error != "" {
+			fmt.Printf("Error: %s\n", job.Error)
+		}
+		return
+	}
+
+	if options.Format == "json" {
+		printJSON(job.Result)
+		return
+	}
+
+	result := job.Result
+	fmt.Printf("📋 Analysis Results: %s\n\n", job.Request.Target)
+	fmt.Printf("Summary: %s\n\n", result.Summary)
+
+	if len(result.Insights) > 0 {
+		fmt.Println("💡 Key Insights:")
+		for _, insight := range result.Insights {
+			fmt.Printf("• %s: %s\n", insight.Title, insight.Description)
+		}
+		fmt.Println()
+	}
+
+	if len(result.Recommendations) > 0 {
+		fmt.Println("🎯 Recommendations:")
+		for i, rec := range result.Recommendations {
+			fmt.Printf("%d. [%s] %s\n   %s\n", i+1, strings.ToUpper(rec.Priority), rec.Title, rec.Description)
+		}
+		fmt.Println()
+	}
+
+	fmt.Printf("⏱️  Analysis completed in %s\n", utils.FormatDuration(result.Duration))
+}
+
+// RunJob ejecuta un trabajo en el proceso actual (lo usa el worker desacoplado)
+func (jv *JobsView) RunJob(options *JobsOptions, timeout time.Duration) error {
+	return jv.jobs.Run(context.Background(), jv.client, options.ID, timeout)
+}
+
+// jobStatusStyle colorea el estado de un trabajo
+func jobStatusStyle(status string) lipgloss.Style {
+	switch status {
+	case core.JobCompleted:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("#9ece6a"))
+	case core.JobFailed:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("#f7768e"))
+	case core.JobCancelled:
+		return lipgloss.NewStyle().Foreground(lipgloss.Color("#565f89"))
+	case core.JobRunning:
+		return lipgloss.NewStyle().Foreground(ascii.Cyan)
+	default:
+		return lipgloss.NewStyle().Foreground(ascii.Gold)
+	}
+}
+
+// printJSON imprime value como JSON indentado
+func printJSON(value interface{}) {
+	data, err := json.MarshalIndent(value, "", "  ")
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Println(string(data))
+}
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"antoine-cli/internal/core"
+	"antoine-cli/internal/models"
 	"antoine-cli/pkg/ascii"
 )
 
@@ -21,6 +22,7 @@ type MentorOptions struct {
 	ProjectID  string
 	Focus      string
 	Quick      bool
+	Profile    *models.UserProfile // contexto del usuario para personalizar los consejos
 }
 
 func NewMentorView(client *core.AntoineClient) *MentorView {
@@ -49,8 +51,8 @@ type mentorResponseMsg struct {
 	err      error
 }
 
-func (mv *MentorView) StartSession() {
-	model := mv.createMentorModel(nil)
+func (mv *MentorView) StartSession(options *MentorOptions) {
+	model := mv.createMentorModel(options)
 	p := tea.NewProgram(model, tea.WithAltScreen())
 
 	if _, err := p.Run(); err != nil {
@@ -94,6 +96,16 @@ func (mv *MentorView) createMentorModel(options *MentorOptions) mentorModel {
 		options:  options,
 	}
 
+	// Con perfil, Antoine adapta sus consejos al usuario
+	if options != nil && options.Profile != nil {
+		profileMsg := chatMessage{
+			sender:    "antoine",
+			content:   fmt.Sprintf("📋 I'll tailor my advice for %s.", options.Profile.Summary()),
+			timestamp: "now",
+		}
+		model.messages = append(model.messages, profileMsg)
+	}
+
 	// Si hay un proyecto específico, agregar contexto
 	if options != nil && options.ProjectURL != "" {
 		contextMsg := chatMessage{
@@ -247,6 +259,9 @@ func (m mentorModel) sendToAntoine(userInput string) tea.Cmd {
 		// En una implementación real, aquí se enviaría el mensaje a Antoine
 		// Por ahora, simular una respuesta
 		response := fmt.Sprintf("Great question! Based on my analysis of thousands of hackathon projects, here's my advice about: %s\n\n[This would be Antoine's AI-generated response based on the user's question and context]", userInput)
+		if m.options != nil && m.options.Profile != nil {
+			response += fmt.Sprintf("\n\n(Tailored for %s)", m.options.Profile.Summary())
+		}
 
 		return mentorResponseMsg{response: response, err: nil}
 	}
@@ -254,6 +269,9 @@ func (m mentorModel) sendToAntoine(userInput string) tea.Cmd {
 
 func (mv *MentorView) provideFeedbackNonInteractive(options *MentorOptions) {
 	fmt.Printf("🎯 Analyzing project: %s\n", options.ProjectURL)
+	if options.Profile != nil {
+		fmt.Printf("👤 Tailored for %s\n", options.Profile.Summary())
+	}
 
 	// Simular análisis
 	fmt.Println("📊 Quick Analysis Results:")
@@ -0,0 +1,383 @@
+package views
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/ui/components"
+	"antoine-cli/internal/utils"
+	"antoine-cli/pkg/ascii"
+	"antoine-cli/pkg/terminal"
+)
+
+type ProfileView struct {
+	client *core.AntoineClient
+}
+
+type ProfileOptions struct {
+	Force  bool
+	Set    map[string]string // valores por campo del formulario, sin abrir el formulario
+	Format string
+}
+
+func NewProfileView(client *core.AntoineClient) *ProfileView {
+	return &ProfileView{client: client}
+}
+
+// profileFormModel maneja el teclado sobre un components.Form
+type profileFormModel struct {
+	form *components.Form
+}
+
+// InitProfile crea el perfil del usuario con el formulario interactivo
+func (pv *ProfileView) InitProfile(options *ProfileOptions) {
+	existing, err := core.LoadProfile()
+	if err != nil && !errors.Is(err, core.ErrProfileNotFound) {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	if existing != nil && !options.Force {
+		fmt.Println("⚠️  A profile already exists. Use 'antoine profile edit' or 'antoine profile init --force'")
+		return
+	}
+
+	pv.editProfile(&models.UserProfile{Travel: models.TravelPreference{Willing: true}}, options)
+}
+
+// EditProfile modifica el perfil existente
+func (pv *ProfileView) EditProfile(options *ProfileOptions) {
+	profile, err := core.LoadProfile()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	pv.editProfile(profile, options)
+}
+
+// ShowProfile muestra el perfil guardado
+func (pv *ProfileView) ShowProfile(options *ProfileOptions) {
+	profile, err := core.LoadProfile()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	if options.Format == "json" {
+		printJSON(profile)
+		return
+	}
+
+	fmt.Println(renderProfile(profile))
+}
+
+func (pv *ProfileView) editProfile(profile *models.UserProfile, options *ProfileOptions) {
+	values := profileFormValues(profile)
+
+	if len(options.Set) > 0 {
+		for field, value := range options.Set {
+			if _, ok := values[field]; !ok {
+				fmt.Printf("❌ Unknown profile field %q (valid: %s)\n", field, strings.Join(profileFieldIDs(), ", "))
+				return
+			}
+			values[field] = value
+		}
+	} else {
+		if !terminal.IsRunningInTerminal() {
+			fmt.Println("❌ The profile form needs an interactive terminal. Use --set field=value instead")
+			return
+		}
+
+		form := profileForm(values)
+		result, err := tea.NewProgram(profileFormModel{form: form}, tea.WithAltScreen()).Run()
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+
+		form = result.(profileFormModel).form
+		if !form.IsSubmitted() {
+			fmt.Println("Profile not saved")
+			return
+		}
+		values = form.GetAllValues()
+	}
+
+	if err := applyProfileValues(profile, values); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	if err := core.SaveProfile(profile); err != nil {
+		fmt.Printf("❌ Invalid profile: %v\n", err)
+		return
+	}
+
+	fmt.Println("✅ Profile saved. Search and mentor commands will use it as default context.")
+}
+
+func (m profileFormModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m profileFormModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	focused := m.form.FocusedField()
+	isSelect := focused == "experience_level"
+
+	switch key.String() {
+	case "ctrl+c", "esc":
+		m.form.Cancel()
+		return m, tea.Quit
+	case "tab":
+		m.form.Focus()
+	case "shift+tab":
+		m.form.FocusPrev()
+	case "down":
+		if isSelect {
+			m.form.HandleInput("down")
+		} else {
+			m.form.Focus()
+		}
+	case "up":
+		if isSelect {
+			m.form.HandleInput("up")
+		} else {
+			m.form.FocusPrev()
+		}
+	case "enter":
+		// En los botones se envía o cancela; en los campos se pasa al siguiente
+		if focused != "" {
+			m.form.Focus()
+			break
+		}
+		m.form.HandleInput("enter")
+		if m.form.IsSubmitted() || m.form.IsCancelled() {
+			return m, tea.Quit
+		}
+	case "backspace":
+		m.form.HandleInput("backspace")
+	case " ":
+		m.form.HandleInput(" ")
+	default:
+		if key.Type == tea.KeyRunes {
+			for _, r := range key.Runes {
+				m.form.HandleInput(string(r))
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m profileFormModel) View() string {
+	helpStyle := lipgloss.NewStyle().Foreground(ascii.Cyan)
+	return m.form.Render() + "\n" +
+		helpStyle.Render("tab/↓ next • shift+tab/↑ previous • space toggles • enter on Save to finish • esc to cancel")
+}
+
+// profileForm construye el formulario con los valores actuales del perfil
+func profileForm(values map[string]string) *components.Form {
+	levelRule := components.ValidationRule{
+		Name: "expertise_level",
+		Validator: func(value string) error {
+			validation := utils.NewMentorValidation().ValidateExpertiseLevel(value)
+			if validation.HasErrors() {
+				return validation.Errors()
+			}
+			return nil
+		},
+	}
+
+	field := func(id, label string, inputType components.InputType, help string) components.FormField {
+		return components.FormField{
+			ID:       id,
+			Label:    label,
+			Type:     inputType,
+			Value:    values[id],
+			HelpText: help,
+		}
+	}
+
+	level := field("experience_level", "Experience Level", components.InputTypeSelect, "Use ↑/↓ to choose")
+	level.Options = core.ExperienceLevels
+	level.Required = true
+	level.Validations = []components.ValidationRule{levelRule}
+
+	name := field("name", "Name", components.InputTypeText, "")
+	name.Placeholder = "How should Antoine call you?"
+
+	return components.NewFormBuilder().
+		Title("👤 Antoine Profile").
+		Description("Antoine uses your profile as default context for searches and mentorship").
+		CompactMode(true).
+		AddField(name).
+		AddField(field("skills", "Skills", components.InputTypeText, "Comma separated, e.g. backend, ui design, pitching")).
+		AddField(field("technologies", "Preferred Technologies", components.InputTypeText, "Comma separated, e.g. Go, React, PostgreSQL")).
+		AddField(field("interests", "Interests", components.InputTypeText, "Themes you care about, e.g. fintech, health, climate")).
+		AddField(level).
+		AddField(field("timezone", "Timezone", components.InputTypeText, "IANA name, e.g. Europe/Madrid")).
+		AddField(field("location", "Location", components.InputTypeText, "City and country")).
+		AddField(field("travel", "Willing to Travel", components.InputTypeToggle, "Space to toggle")).
+		AddField(field("max_distance_km", "Max Travel Distance (km)", components.InputTypeNumber, "0 means no limit")).
+		AddField(field("online_only", "Online Events Only", components.InputTypeToggle, "Space to toggle")).
+		AddField(field("team_size", "Preferred Team Size", components.InputTypeNumber, "0 means no preference")).
+		AddField(field("roles", "Team Roles", components.InputTypeText, "Roles you want to cover, e.g. frontend, design")).
+		AddField(field("looking_for_team", "Looking for a Team", components.InputTypeToggle, "Space to toggle")).
+		SubmitText("Save Profile").
+		Build()
+}
+
+// profileFormValues convierte el perfil en los valores de texto del formulario
+func profileFormValues(profile *models.UserProfile) map[string]string {
+	level := profile.ExperienceLevel
+	if level == "" {
+		level = core.ExperienceLevels[0]
+	}
+
+	timezone := profile.Timezone
+	if timezone == "" {
+		timezone = os.Getenv("TZ")
+	}
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	return map[string]string{
+		"name":             profile.Name,
+		"skills":           strings.Join(profile.Skills, ", "),
+		"technologies":     strings.Join(profile.Technologies, ", "),
+		"interests":        strings.Join(profile.Interests, ", "),
+		"experience_level": level,
+		"timezone":         timezone,
+		"location":         profile.Location,
+		"travel":           strconv.FormatBool(profile.Travel.Willing),
+		"max_distance_km":  strconv.Itoa(profile.Travel.MaxDistance),
+		"online_only":      strconv.FormatBool(profile.Travel.OnlineOnly),
+		"team_size":        strconv.Itoa(profile.Team.PreferredSize),
+		"roles":            strings.Join(profile.Team.Roles, ", "),
+		"looking_for_team": strconv.FormatBool(profile.Team.LookingForTeam),
+	}
+}
+
+// applyProfileValues vuelca los valores del formulario en el perfil
+func applyProfileValues(profile *models.UserProfile, values map[string]string) error {
+	parseInt := func(field string) (int, error) {
+		value := strings.TrimSpace(values[field])
+		if value == "" {
+			return 0, nil
+		}
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return 0, fmt.Errorf("%s must be a whole number", field)
+		}
+		return n, nil
+	}
+	parseBool := func(field string) bool {
+		switch strings.ToLower(strings.TrimSpace(values[field])) {
+		case "true", "1", "yes", "y", "on":
+			return true
+		}
+		return false
+	}
+
+	maxDistance, err := parseInt("max_distance_km")
+	if err != nil {
+		return err
+	}
+	teamSize, err := parseInt("team_size")
+	if err != nil {
+		return err
+	}
+
+	profile.Name = strings.TrimSpace(values["name"])
+	profile.Skills = core.SplitList(values["skills"])
+	profile.Technologies = core.SplitList(values["technologies"])
+	profile.Interests = core.SplitList(values["interests"])
+	profile.ExperienceLevel = strings.ToLower(strings.TrimSpace(values["experience_level"]))
+	profile.Timezone = strings.TrimSpace(values["timezone"])
+	profile.Location = strings.TrimSpace(values["location"])
+	profile.Travel = models.TravelPreference{
+		Willing:     parseBool("travel"),
+		MaxDistance: maxDistance,
+		OnlineOnly:  parseBool("online_only"),
+	}
+	profile.Team = models.TeamPreference{
+		PreferredSize:  teamSize,
+		Roles:          core.SplitList(values["roles"]),
+		LookingForTeam: parseBool("looking_for_team"),
+	}
+
+	return nil
+}
+
+func profileFieldIDs() []string {
+	return []string{"name", "skills", "technologies", "interests", "experience_level", "timezone", "location",
+		"travel", "max_distance_km", "online_only", "team_size", "roles", "looking_for_team"}
+}
+
+// renderProfile dibuja el perfil en texto
+func renderProfile(profile *models.UserProfile) string {
+	var s strings.Builder
+
+	labelStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	line := func(label, value string) {
+		if value == "" {
+			value = "-"
+		}
+		s.WriteString(fmt.Sprintf("%s %s\n", labelStyle.Render(fmt.Sprintf("%-22s", label)), value))
+	}
+	yesNo := func(value bool) string {
+		if value {
+			return "yes"
+		}
+		return "no"
+	}
+
+	title := "Your Profile"
+	if profile.Name != "" {
+		title = profile.Name
+	}
+	s.WriteString(ascii.GetBanner(title, "👤", 80))
+	s.WriteString("\n\n")
+
+	line("Experience level", profile.ExperienceLevel)
+	line("Skills", strings.Join(profile.Skills, ", "))
+	line("Technologies", strings.Join(profile.Technologies, ", "))
+	line("Interests", strings.Join(profile.Interests, ", "))
+	line("Timezone", profile.Timezone)
+	line("Location", profile.Location)
+
+	travel := yesNo(profile.Travel.Willing)
+	if profile.Travel.Willing && profile.Travel.MaxDistance > 0 {
+		travel += fmt.Sprintf(" (up to %d km)", profile.Travel.MaxDistance)
+	}
+	line("Willing to travel", travel)
+	line("Online events only", yesNo(profile.Travel.OnlineOnly))
+
+	teamSize := "no preference"
+	if profile.Team.PreferredSize > 0 {
+		teamSize = strconv.Itoa(profile.Team.PreferredSize)
+	}
+	line("Preferred team size", teamSize)
+	line("Team roles", strings.Join(profile.Team.Roles, ", "))
+	line("Looking for a team", yesNo(profile.Team.LookingForTeam))
+
+	if !profile.UpdatedAt.IsZero() {
+		s.WriteString(fmt.Sprintf("\nLast updated %s\n", utils.TimeAgo(profile.UpdatedAt)))
+	}
+
+	return s.String()
+}
@@ -0,0 +1,114 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/ui/styles"
+	"antoine-cli/pkg/ascii"
+)
+
+type RatesView struct{}
+
+func NewRatesView() *RatesView {
+	return &RatesView{}
+}
+
+// Show muestra la tabla de cambios y cuánto vale cada moneda en la moneda base
+func (rv *RatesView) Show(format string) {
+	table, err := core.LoadRateTable()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	if format == "json" {
+		printJSON(table)
+		return
+	}
+
+	base := core.Prizes().Base()
+	titleStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	codeStyle := lipgloss.NewStyle().Foreground(ascii.Cyan).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("💱 Prize rates (base currency: %s)", base)))
+	fmt.Println(dimStyle.Render(fmt.Sprintf("   source: %s • updated %s", table.Source, table.UpdatedAt.Format("2006-01-02"))))
+	if _, ok := table.Rates[base]; !ok {
+		fmt.Printf("⚠️  %s is not in the rate table: prizes cannot be normalized. Add it with 'antoine config rates set %s <rate>'\n", base, base)
+	}
+	fmt.Println()
+
+	for _, currency := range table.Currencies() {
+		rate := table.Rates[currency]
+		line := fmt.Sprintf("  %s  %12s per 1 %s", codeStyle.Render(fmt.Sprintf("%-5s", currency)), formatRate(rate), table.Base)
+		if value, ok := table.Convert(1, currency, base); ok && currency != base {
+			line += dimStyle.Render(fmt.Sprintf("   1 %s = %s %s", currency, formatRate(value), base))
+		}
+		fmt.Println(line)
+	}
+}
+
+// Set fija el cambio de una moneda respecto a la base de la tabla
+func (rv *RatesView) Set(currency, value string) {
+	rate, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		fmt.Printf("❌ invalid rate %q: use a number like 0.92\n", value)
+		return
+	}
+
+	table, err := core.LoadRateTable()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	if err := table.Set(currency, rate); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	if err := core.SaveRateTable(table); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	fmt.Printf("✅ 1 %s = %s %s\n", table.Base, formatRate(rate), strings.ToUpper(currency))
+}
+
+// Refresh descarga los cambios actuales y los combina con la tabla local
+func (rv *RatesView) Refresh(url string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	table, err := core.RefreshRates(ctx, url)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	fmt.Printf("🔄 Updated %d rates (base %s) from %s\n", len(table.Rates), table.Base, table.Source)
+}
+
+// Reset vuelve a la tabla incluida en Antoine
+func (rv *RatesView) Reset() {
+	if err := core.ResetRateTable(); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	fmt.Println("♻️  Restored the bundled rate table")
+}
+
+// formatRate muestra un cambio con los decimales que necesite
+func formatRate(rate float64) string {
+	switch {
+	case rate >= 100:
+		return strconv.FormatFloat(rate, 'f', 0, 64)
+	case rate >= 1:
+		return strconv.FormatFloat(rate, 'f', 2, 64)
+	default:
+		return strconv.FormatFloat(rate, 'g', 4, 64)
+	}
+}
@@ -0,0 +1,130 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/ui/styles"
+	"antoine-cli/internal/utils"
+	"antoine-cli/pkg/ascii"
+)
+
+type RecommendView struct {
+	client *core.AntoineClient
+}
+
+type RecommendOptions struct {
+	Query       string
+	Source      string // auto, live, cache
+	Limit       int
+	Weights     core.RecommendationWeights
+	IncludePast bool
+	Profile     *models.UserProfile
+	Format      string
+}
+
+func NewRecommendView(client *core.AntoineClient) *RecommendView {
+	return &RecommendView{client: client}
+}
+
+// RecommendHackathons muestra los hackathons ordenados por afinidad con el perfil
+func (rv *RecommendView) RecommendHackathons(options *RecommendOptions) {
+	if options.Profile == nil {
+		fmt.Println("❌ Recommendations need your profile: run 'antoine profile init' first")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	// Búsqueda amplia: filtrar por el perfil aquí descartaría candidatos que el scorer sí valora
+	hackathons, source, err := rv.client.HackathonCandidates(ctx, options.Query, map[string]interface{}{}, options.Source)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	recommendations := core.RecommendHackathons(options.Profile, hackathons, options.Weights, options.IncludePast, time.Now())
+	if options.Limit > 0 && len(recommendations) > options.Limit {
+		recommendations = recommendations[:options.Limit]
+	}
+
+	if options.Format == "json" {
+		printJSON(map[string]interface{}{
+			"source":          source,
+			"weights":         options.Weights,
+			"recommendations": recommendations,
+		})
+		return
+	}
+
+	if len(recommendations) == 0 {
+		fmt.Println("🔍 No upcoming hackathons to recommend. Try --include-past or a different --source")
+		return
+	}
+
+	fmt.Println(renderRecommendations(recommendations, source))
+}
+
+// renderRecommendations dibuja el ranking con la explicación de cada factor
+func renderRecommendations(recommendations []*core.HackathonRecommendation, source string) string {
+	var s strings.Builder
+
+	nameStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+
+	s.WriteString(ascii.GetBanner("Recommended Hackathons", ascii.EmojiTarget, 80))
+	s.WriteString("\n")
+	s.WriteString(dimStyle.Render(fmt.Sprintf("Source: %s data", source)))
+	s.WriteString("\n\n")
+
+	for i, recommendation := range recommendations {
+		hackathon := recommendation.Hackathon
+
+		s.WriteString(nameStyle.Render(fmt.Sprintf("%d. %s", i+1, hackathon.Name)))
+		s.WriteString("  " + ascii.GetProgressBar(int(recommendation.Score), 100, 20) + "\n")
+
+		var details []string
+		if !hackathon.StartDate.IsZero() {
+			details = append(details, hackathon.StartDate.Format("2006-01-02"))
+		}
+		if hackathon.Location.Type != "" {
+			details = append(details, hackathon.Location.Type)
+		}
+		if hackathon.URL != "" {
+			details = append(details, hackathon.URL)
+		}
+		if len(details) > 0 {
+			s.WriteString(dimStyle.Render("   " + strings.Join(details, " • ")))
+			s.WriteString("\n")
+		}
+
+		for _, factor := range recommendation.Factors {
+			s.WriteString(fmt.Sprintf("   %s %-11s %5.1f pts  %s\n",
+				factorIcon(factor.Score),
+				factor.Name,
+				factor.Weight*factor.Score*100,
+				utils.TruncateString(factor.Reason, 55)))
+		}
+		s.WriteString("\n")
+	}
+
+	return s.String()
+}
+
+func factorIcon(score float64) string {
+	switch {
+	case score >= 0.75:
+		return lipgloss.NewStyle().Foreground(styles.Green).Render("✓")
+	case score >= 0.4:
+		return lipgloss.NewStyle().Foreground(ascii.Gold).Render("~")
+	default:
+		return lipgloss.NewStyle().Foreground(styles.Red).Render("✗")
+	}
+}
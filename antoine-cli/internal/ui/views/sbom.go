@@ -0,0 +1,61 @@
+package views
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"antoine-cli/internal/core"
+)
+
+type SBOMView struct {
+	client *core.AntoineClient
+}
+
+type SBOMOptions struct {
+	Target  string // ruta local o URL del repositorio
+	Format  string // cyclonedx-json o spdx-json
+	Output  string // vacío = salida estándar
+	Version string
+}
+
+func NewSBOMView(client *core.AntoineClient) *SBOMView {
+	return &SBOMView{client: client}
+}
+
+// Generate escribe el SBOM de Target. Los errores van a stderr para no mezclarse con el
+// documento cuando se redirige la salida. Devuelve false si falla.
+func (sv *SBOMView) Generate(options *SBOMOptions) bool {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+
+	sbom, warnings, err := sv.client.GenerateSBOM(ctx, options.Target, core.SBOMOptions{Format: options.Format, ToolVersion: options.Version})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return false
+	}
+	for _, warning := range warnings {
+		fmt.Fprintf(os.Stderr, "⚠️  SBOM may be incomplete: %s\n", warning)
+	}
+	data, err := json.MarshalIndent(sbom, "", "  ")
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return false
+	}
+
+	if options.Output == "" {
+		fmt.Println(string(data))
+		return true
+	}
+	if err := os.WriteFile(options.Output, append(data, '\n'), 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "❌ %v\n", err)
+		return false
+	}
+	fmt.Fprintf(os.Stderr, "📦 %s SBOM written to %s\n", options.Format, options.Output)
+	return true
+}
@@ -0,0 +1,82 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/ui/styles"
+	"antoine-cli/pkg/ascii"
+)
+
+type ScanView struct{}
+
+type ScanOptions struct {
+	Path      string
+	Allowlist string // vacío = .secretsignore en la raíz
+	Limits    core.LocalLimits
+	Format    string
+}
+
+func NewScanView() *ScanView {
+	return &ScanView{}
+}
+
+// Secrets escanea Path en busca de credenciales. Devuelve true si el árbol está limpio.
+func (sv *ScanView) Secrets(options *ScanOptions) bool {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	scan, err := core.ScanSecrets(ctx, options.Path, core.SecretScanOptions{Limits: options.Limits, Allowlist: options.Allowlist})
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return false
+	}
+
+	if options.Format == "json" {
+		printJSON(scan)
+		return len(scan.Findings) == 0
+	}
+
+	titleStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+
+	fmt.Println(titleStyle.Render(fmt.Sprintf("🔐 Secret scan of %s", scan.Root)))
+	summary := fmt.Sprintf("   %d files scanned", scan.Files)
+	if scan.Allowed > 0 {
+		summary += fmt.Sprintf(" • %d allowed by %s", scan.Allowed, core.SecretAllowlistFile)
+	}
+	fmt.Println(summary + "\n")
+
+	if len(scan.Findings) == 0 {
+		fmt.Println(lipgloss.NewStyle().Foreground(styles.Green).Render("✅ No secrets found"))
+		return true
+	}
+
+	for _, finding := range scan.Findings {
+		fmt.Printf("%s %s  %s\n", severityStyle(finding.Severity).Render(fmt.Sprintf("%-8s", finding.Severity)),
+			finding.Description, dimStyle.Render(fmt.Sprintf("%s:%d:%d", finding.File, finding.Line, finding.Column)))
+		fmt.Printf("         %s  %s\n", finding.Redacted, dimStyle.Render("fingerprint:"+finding.Fingerprint))
+	}
+	fmt.Println()
+	fmt.Printf("⚠️  %d possible secrets. Revoke real keys (they stay in the git history) and allow false positives in %s\n",
+		len(scan.Findings), core.SecretAllowlistFile)
+	return false
+}
+
+// severityStyle colorea una severidad de vulnerabilidad
+func severityStyle(severity string) lipgloss.Style {
+	switch severity {
+	case "critical":
+		return lipgloss.NewStyle().Foreground(styles.Red).Bold(true)
+	case "high":
+		return lipgloss.NewStyle().Foreground(styles.Red)
+	case "medium":
+		return lipgloss.NewStyle().Foreground(styles.Orange)
+	}
+	return lipgloss.NewStyle().Foreground(styles.Yellow)
+}
@@ -3,6 +3,8 @@ package views
 import (
 	"context"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
 	"time"
 
@@ -14,6 +16,7 @@ import (
 
 	"antoine-cli/internal/core"
 	"antoine-cli/internal/models"
+	"antoine-cli/internal/utils"
 	"antoine-cli/pkg/ascii"
 )
 
@@ -22,16 +25,23 @@ type SearchView struct {
 }
 
 type SearchOptions struct {
-	Tech      string
-	Location  string
-	PrizeMin  string
-	DateFrom  string
-	DateTo    string
-	Online    bool
-	Hackathon string
-	Category  string
-	Sort      string
-	Format    string
+	Query      string
+	Tech       string
+	Location   string
+	Near       string  // ciudad o lat,lng para filtrar por distancia
+	Radius     float64 // km; 0 = sin límite
+	PrizeMin   string
+	DateFrom   string
+	DateTo     string
+	Online     bool
+	Hackathon  string
+	Category   string
+	Sort       string
+	SortOrder  string
+	Difficulty string
+	Save       string              // nombre con el que guardar la búsqueda
+	Profile    *models.UserProfile // contexto por defecto para los filtros no indicados
+	Format     string
 }
 
 func NewSearchView(client *core.AntoineClient) *SearchView {
@@ -50,6 +60,7 @@ type searchModel struct {
 	width       int
 	height      int
 	client      *core.AntoineClient
+	geo         *core.GeoFilter
 }
 
 type searchCompleteMsg struct {
@@ -58,12 +69,28 @@ type searchCompleteMsg struct {
 }
 
 func (sv *SearchView) SearchHackathons(options *SearchOptions) {
-	if options.Format == "json" || options.Format == "yaml" {
-		sv.searchHackathonsNonInteractive(options)
+	// Geocodificar antes de buscar para avisar pronto de lugares desconocidos
+	geo, err := options.savedSearch("").GeoFilter()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	if options.Save != "" {
+		if err := core.SaveSearch(options.savedSearch(options.Save)); err != nil {
+			fmt.Printf("❌ %v\n", err)
+			return
+		}
+		fmt.Printf("💾 Saved search '%s'. Watch it with 'antoine watch %s'\n", options.Save, options.Save)
+	}
+
+	if options.Format == "json" || options.Format == "yaml" || options.Format == "ics" {
+		sv.searchHackathonsNonInteractive(options, geo)
 		return
 	}
 
 	model := sv.createSearchModel("hackathons", options)
+	model.geo = geo
 	p := tea.NewProgram(model, tea.WithAltScreen())
 
 	if _, err := p.Run(); err != nil {
@@ -89,6 +116,7 @@ func (sv *SearchView) createSearchModel(searchType string, options *SearchOption
 	// Configurar input de búsqueda
 	ti := textinput.New()
 	ti.Placeholder = "Enter search terms..."
+	ti.SetValue(options.Query)
 	ti.Focus()
 	ti.CharLimit = 156
 	ti.Width = 50
@@ -104,8 +132,8 @@ func (sv *SearchView) createSearchModel(searchType string, options *SearchOption
 		columns = []table.Column{
 			{Title: "Name", Width: 30},
 			{Title: "Start Date", Width: 12},
-			{Title: "Prize", Width: 10},
-			{Title: "Location", Width: 15},
+			{Title: "Prize (" + core.Prizes().Base() + ")", Width: 12},
+			{Title: "Location", Width: 22},
 			{Title: "Tech", Width: 20},
 		}
 	} else {
@@ -219,6 +247,10 @@ func (m searchModel) View() string {
 	if m.loading {
 		s.WriteString(fmt.Sprintf("%s Searching... This may take a moment\n\n", m.spinner.View()))
 	} else {
+		if m.geo != nil {
+			s.WriteString("📍 " + m.geo.Describe() + "\n\n")
+		}
+
 		// Input de búsqueda
 		s.WriteString("🔍 Search Query:\n")
 		s.WriteString(m.searchInput.View())
@@ -258,16 +290,7 @@ func (m searchModel) performSearch(query string) tea.Cmd {
 		var err error
 
 		// Convertir opciones a filtros
-		filters := make(map[string]interface{})
-		if m.options.Tech != "" {
-			filters["technologies"] = strings.Split(m.options.Tech, ",")
-		}
-		if m.options.Location != "" {
-			filters["location"] = m.options.Location
-		}
-		if m.options.Online {
-			filters["online"] = true
-		}
+		filters := m.options.filters(m.searchType)
 
 		if m.searchType == "hackathons" {
 			// Buscar hackathons
@@ -297,11 +320,10 @@ func (m *searchModel) updateTable() {
 
 	if m.searchType == "hackathons" {
 		if hackathons, ok := m.results.([]*models.Hackathon); ok {
+			hackathons = m.options.savedSearch("").Refine(hackathons, m.geo)
+			prizes := core.Prizes()
 			for _, h := range hackathons {
-				prize := fmt.Sprintf("$%d", h.PrizePool.Total)
-				if h.PrizePool.Total == 0 {
-					prize = "N/A"
-				}
+				prize := prizes.Format(h)
 
 				tech := strings.Join(h.Technologies[:min(3, len(h.Technologies))], ", ")
 				if len(h.Technologies) > 3 {
@@ -312,7 +334,7 @@ func (m *searchModel) updateTable() {
 					h.Name,
 					h.StartDate.Format("2006-01-02"),
 					prize,
-					h.Location.City,
+					hackathonPlace(h, m.geo),
 					tech,
 				})
 			}
@@ -343,20 +365,16 @@ func (m *searchModel) updateTable() {
 	m.table.SetRows(rows)
 }
 
-func (sv *SearchView) searchHackathonsNonInteractive(options *SearchOptions) {
+func (sv *SearchView) searchHackathonsNonInteractive(options *SearchOptions, geo *core.GeoFilter) {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
-	filters := make(map[string]interface{})
-	if options.Tech != "" {
-		filters["technologies"] = strings.Split(options.Tech, ",")
-	}
-
-	hackathons, err := sv.client.SearchHackathons(ctx, "", filters)
+	hackathons, err := sv.client.SearchHackathons(ctx, options.Query, options.filters("hackathons"))
 	if err != nil {
 		fmt.Printf("Error: %v\n", err)
 		return
 	}
+	hackathons = options.savedSearch("").Refine(hackathons, geo)
 
 	// Output según formato
 	switch options.Format {
@@ -366,14 +384,22 @@ func (sv *SearchView) searchHackathonsNonInteractive(options *SearchOptions) {
 	case "yaml":
 		// Implementar output YAML
 		fmt.Printf("Found %d hackathons\n", len(hackathons))
+	case "ics":
+		// Calendario con alarmas en los hackathons marcados
+		bookmarks, _ := core.LoadBookmarks()
+		calendar := core.CalendarOptions{Alarms: core.DefaultCalendarAlarms, Bookmarks: bookmarks}
+		if err := core.WriteCalendar(os.Stdout, hackathons, calendar); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
 	default:
 		// Tabla simple
+		prizes := core.Prizes()
 		for _, h := range hackathons {
-			fmt.Printf("%-30s %-12s %-10s %-15s\n",
+			fmt.Printf("%-30s %-12s %-12s %-22s\n",
 				h.Name,
 				h.StartDate.Format("2006-01-02"),
-				fmt.Sprintf("$%d", h.PrizePool.Total),
-				h.Location.City)
+				prizes.Format(h),
+				hackathonPlace(h, geo))
 		}
 	}
 }
@@ -383,6 +409,99 @@ func (sv *SearchView) searchProjectsNonInteractive(options *SearchOptions) {
 	fmt.Println("Project search not implemented in non-interactive mode")
 }
 
+// filters convierte las opciones en filtros y completa los que faltan con el perfil
+func (o *SearchOptions) filters(searchType string) map[string]interface{} {
+	filters := o.savedSearch("").Filters()
+
+	// En proyectos solo tiene sentido usar las tecnologías del perfil
+	if searchType == "projects" {
+		if _, ok := filters["technologies"]; !ok && o.Profile != nil && len(o.Profile.Technologies) > 0 {
+			filters["technologies"] = o.Profile.Technologies
+		}
+		return filters
+	}
+
+	filters = core.ProfileSearchFilters(o.Profile, filters)
+
+	// Con --near la ubicación se filtra en local por distancia
+	if o.Near != "" {
+		delete(filters, "location")
+		delete(filters, "online")
+	}
+	return filters
+}
+
+// hackathonPlace muestra la ciudad y, con filtro geográfico, la distancia
+func hackathonPlace(hackathon *models.Hackathon, geo *core.GeoFilter) string {
+	place := hackathon.Location.City
+	if strings.EqualFold(hackathon.Location.Type, "online") {
+		place = "Online"
+	}
+	if geo != nil && place != "Online" {
+		if distance, ok := geo.Distance(hackathon); ok {
+			place = fmt.Sprintf("%s (%s)", place, core.FormatDistance(distance))
+		}
+	}
+	return place
+}
+
+// savedSearch convierte las opciones en una búsqueda guardable con el nombre dado
+func (o *SearchOptions) savedSearch(name string) *core.SavedSearch {
+	prizeMin, _ := strconv.Atoi(o.PrizeMin)
+	return &core.SavedSearch{
+		Name:         name,
+		Query:        o.Query,
+		Technologies: core.SplitList(o.Tech),
+		Location:     o.Location,
+		Near:         o.Near,
+		RadiusKm:     o.Radius,
+		PrizeMin:     prizeMin,
+		DateFrom:     o.DateFrom,
+		DateTo:       o.DateTo,
+		Online:       o.Online,
+		Difficulty:   o.Difficulty,
+		SortBy:       o.Sort,
+		SortOrder:    o.SortOrder,
+	}
+}
+
+// ListSavedSearches muestra las búsquedas guardadas
+func (sv *SearchView) ListSavedSearches(format string) {
+	searches, err := core.LoadSavedSearches()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	if format == "json" {
+		printJSON(searches)
+		return
+	}
+
+	if len(searches) == 0 {
+		fmt.Println("No saved searches. Create one with 'antoine search hackathons --save <name>'")
+		return
+	}
+
+	nameStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	for _, search := range searches {
+		lastRun := "never watched"
+		if !search.LastRun.IsZero() {
+			lastRun = "watched " + utils.TimeAgo(search.LastRun)
+		}
+		fmt.Printf("%s  %s\n   %s\n", nameStyle.Render(search.Name), lastRun, search.Describe())
+	}
+}
+
+// DeleteSavedSearch borra una búsqueda guardada
+func (sv *SearchView) DeleteSavedSearch(name string) {
+	if err := core.DeleteSavedSearch(name); err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	fmt.Printf("🗑️  Deleted saved search '%s'\n", name)
+}
+
 func min(a, b int) int {
 	if a < b {
 		return a
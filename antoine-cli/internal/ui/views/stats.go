@@ -0,0 +1,161 @@
+package views
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/pkg/ascii"
+)
+
+type StatsView struct {
+	client *core.AntoineClient
+}
+
+type StatsOptions struct {
+	Top    int
+	Reset  bool
+	Format string
+}
+
+func NewStatsView(client *core.AntoineClient) *StatsView {
+	return &StatsView{client: client}
+}
+
+// ShowStats muestra el desglose de métricas de uso por ventana de tiempo
+func (sv *StatsView) ShowStats(options *StatsOptions) {
+	analytics := sv.client.Analytics()
+
+	if options.Reset {
+		if err := analytics.Reset(); err != nil {
+			fmt.Printf("❌ Failed to reset statistics: %v\n", err)
+			return
+		}
+		fmt.Println("✅ Usage statistics cleared")
+		return
+	}
+
+	now := time.Now()
+	year, month, day := now.Date()
+	windows := []core.AnalyticsSummary{
+		analytics.Summary("today", time.Date(year, month, day, 0, 0, 0, 0, now.Location())),
+		analytics.Summary("7 days", now.AddDate(0, 0, -7)),
+		analytics.Summary("30 days", now.AddDate(0, 0, -30)),
+		analytics.Totals(),
+	}
+
+	if options.Format == "json" {
+		data, err := json.MarshalIndent(map[string]interface{}{
+			"windows":          windows,
+			"top_technologies": windows[len(windows)-1].TopTechnologies(options.Top),
+			"last_update":      analytics.LastUpdate(),
+		}, "", "  ")
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+		fmt.Println(string(data))
+		return
+	}
+
+	fmt.Println(ascii.GetBanner("Usage Statistics", ascii.EmojiTarget, 80))
+	fmt.Println()
+
+	headerStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	header := fmt.Sprintf("%-24s", "")
+	for _, window := range windows {
+		header += fmt.Sprintf("%12s", window.Window)
+	}
+	fmt.Println(headerStyle.Render(header))
+
+	// Búsquedas por tipo
+	fmt.Println("🔍 Searches")
+	for _, searchType := range collectKeys(windows, func(s core.AnalyticsSummary) map[string]int { return s.Searches }) {
+		printStatsRow("  "+searchType, windows, func(s core.AnalyticsSummary) string {
+			return fmt.Sprintf("%d", s.Searches[searchType])
+		})
+	}
+	printStatsRow("  total", windows, func(s core.AnalyticsSummary) string {
+		return fmt.Sprintf("%d", s.TotalSearches())
+	})
+	printStatsRow("  results found", windows, func(s core.AnalyticsSummary) string {
+		total := 0
+		for _, count := range s.Results {
+			total += count
+		}
+		return fmt.Sprintf("%d", total)
+	})
+	fmt.Println()
+
+	// Análisis ejecutados
+	fmt.Println("🔬 Analyses")
+	for _, analysisType := range collectKeys(windows, func(s core.AnalyticsSummary) map[string]int { return s.Analyses }) {
+		printStatsRow("  "+analysisType, windows, func(s core.AnalyticsSummary) string {
+			return fmt.Sprintf("%d", s.Analyses[analysisType])
+		})
+	}
+	printStatsRow("  total", windows, func(s core.AnalyticsSummary) string {
+		return fmt.Sprintf("%d", s.TotalAnalyses())
+	})
+	fmt.Println()
+
+	// Caché
+	fmt.Println("⚡ Cache")
+	printStatsRow("  lookups", windows, func(s core.AnalyticsSummary) string {
+		return fmt.Sprintf("%d", s.CacheHits+s.CacheMisses)
+	})
+	printStatsRow("  hit rate", windows, func(s core.AnalyticsSummary) string {
+		if s.CacheHits+s.CacheMisses == 0 {
+			return "-"
+		}
+		return fmt.Sprintf("%.0f%%", s.CacheHitRate()*100)
+	})
+	fmt.Println()
+
+	// Tecnologías más consultadas
+	fmt.Println("📈 Top Technologies")
+	top := windows[len(windows)-1].TopTechnologies(options.Top)
+	if len(top) == 0 {
+		fmt.Println("  No trend lookups recorded yet")
+	}
+	for i, tech := range top {
+		fmt.Printf("  %d. %-20s %s\n", i+1, tech.Name, ascii.GetProgressBar(tech.Count, top[0].Count, 20))
+	}
+	fmt.Println()
+
+	lastUpdate := "never"
+	if updated := analytics.LastUpdate(); !updated.IsZero() {
+		lastUpdate = updated.Format("2006-01-02 15:04")
+	}
+	fmt.Printf("🕒 Last update: %s\n", lastUpdate)
+}
+
+// printStatsRow imprime una fila con un valor por ventana de tiempo
+func printStatsRow(label string, windows []core.AnalyticsSummary, value func(core.AnalyticsSummary) string) {
+	row := fmt.Sprintf("%-24s", label)
+	for _, window := range windows {
+		row += fmt.Sprintf("%12s", value(window))
+	}
+	fmt.Println(strings.TrimRight(row, " "))
+}
+
+// collectKeys devuelve las claves ordenadas presentes en cualquiera de las ventanas
+func collectKeys(windows []core.AnalyticsSummary, field func(core.AnalyticsSummary) map[string]int) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, window := range windows {
+		for key := range field(window) {
+			if !seen[key] {
+				seen[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
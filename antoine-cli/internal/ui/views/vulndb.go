@@ -0,0 +1,77 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/ui/styles"
+	"antoine-cli/pkg/ascii"
+)
+
+type VulnDBView struct{}
+
+func NewVulnDBView() *VulnDBView {
+	return &VulnDBView{}
+}
+
+// Status muestra los ecosistemas importados en la base de datos local
+func (vv *VulnDBView) Status(format string) {
+	status, err := core.LoadVulnDBStatus()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	if format == "json" {
+		printJSON(status)
+		return
+	}
+
+	if len(status.Ecosystems) == 0 {
+		fmt.Println("The vulnerability database is empty. Import OSV data with 'antoine vulndb update --from <all.zip>'")
+		return
+	}
+
+	nameStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	dimStyle := lipgloss.NewStyle().Foreground(styles.Gray)
+
+	fmt.Printf("🛡️  Vulnerability database (%s)\n", status.Path)
+	for _, ecosystem := range core.VulnEcosystems {
+		info, ok := status.Ecosystems[ecosystem]
+		if !ok {
+			fmt.Printf("   %s %s\n", nameStyle.Render(fmt.Sprintf("%-10s", ecosystem)), dimStyle.Render("not imported"))
+			continue
+		}
+		fmt.Printf("   %s %6d advisories, %6d packages  %s\n", nameStyle.Render(fmt.Sprintf("%-10s", ecosystem)),
+			info.Advisories, info.Packages, dimStyle.Render(fmt.Sprintf("updated %s from %s", info.UpdatedAt.Format("2006-01-02"), info.Source)))
+	}
+}
+
+// Update importa exportaciones de OSV en la base de datos local
+func (vv *VulnDBView) Update(sources []string, format string) {
+	summary, err := core.ImportOSV(sources)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+
+	if format == "json" {
+		printJSON(summary)
+		return
+	}
+
+	var parts []string
+	for _, ecosystem := range core.VulnEcosystems {
+		if count, ok := summary.Ecosystems[ecosystem]; ok {
+			parts = append(parts, fmt.Sprintf("%s %d", ecosystem, count))
+		}
+	}
+	fmt.Printf("🛡️  Imported %d records: %s\n", summary.Files, strings.Join(parts, ", "))
+	if summary.Withdrawn > 0 || summary.Skipped > 0 {
+		fmt.Println(lipgloss.NewStyle().Foreground(styles.Gray).Render(
+			fmt.Sprintf("   skipped %d withdrawn and %d unsupported or unreadable records", summary.Withdrawn, summary.Skipped)))
+	}
+}
@@ -0,0 +1,196 @@
+package views
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"antoine-cli/internal/core"
+	"antoine-cli/internal/models"
+	"antoine-cli/internal/ui/styles"
+	"antoine-cli/internal/utils"
+	"antoine-cli/pkg/ascii"
+)
+
+type WatchView struct {
+	client *core.AntoineClient
+}
+
+type WatchOptions struct {
+	Names    []string // búsquedas a vigilar; vacío = todas
+	Interval time.Duration
+	Once     bool
+	Notifier *core.Notifier
+	Format   string
+}
+
+func NewWatchView(client *core.AntoineClient) *WatchView {
+	return &WatchView{client: client}
+}
+
+// Watch repite las búsquedas guardadas cada Interval y avisa de hackathons nuevos o modificados
+func (wv *WatchView) Watch(options *WatchOptions) {
+	searches, err := selectSavedSearches(options.Names)
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		return
+	}
+	if len(searches) == 0 {
+		fmt.Println("No saved searches to watch. Create one with 'antoine search hackathons --save <name>'")
+		return
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	if options.Format != "json" {
+		channels := "none"
+		if enabled := options.Notifier.Channels(); len(enabled) > 0 {
+			channels = strings.Join(enabled, ", ")
+		}
+		if options.Once {
+			fmt.Printf("👀 Checking %d saved searches (notifications: %s)\n\n", len(searches), channels)
+		} else {
+			fmt.Printf("👀 Watching %d saved searches every %s (notifications: %s). Press Ctrl+C to stop.\n\n",
+				len(searches), utils.FormatDuration(options.Interval), channels)
+		}
+	}
+
+	for {
+		wv.checkSearches(ctx, searches, options)
+		if options.Once {
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			fmt.Println("\n👋 Stopped watching")
+			return
+		case <-time.After(options.Interval):
+		}
+	}
+}
+
+// selectSavedSearches devuelve las búsquedas pedidas por nombre, o todas
+func selectSavedSearches(names []string) ([]*core.SavedSearch, error) {
+	if len(names) == 0 {
+		return core.LoadSavedSearches()
+	}
+
+	var searches []*core.SavedSearch
+	for _, name := range names {
+		search, err := core.GetSavedSearch(name)
+		if err != nil {
+			return nil, err
+		}
+		searches = append(searches, search)
+	}
+	return searches, nil
+}
+
+// checkSearches hace una pasada por todas las búsquedas y notifica las novedades
+func (wv *WatchView) checkSearches(ctx context.Context, searches []*core.SavedSearch, options *WatchOptions) {
+	var results []*core.WatchResult
+
+	for _, search := range searches {
+		if ctx.Err() != nil {
+			return
+		}
+
+		searchCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+		result, err := wv.client.CheckSavedSearch(searchCtx, search)
+		cancel()
+		if err != nil {
+			result = &core.WatchResult{Search: search, Error: err.Error()}
+		}
+		results = append(results, result)
+
+		if options.Format != "json" {
+			printWatchResult(result)
+		}
+
+		if result.HasUpdates() {
+			if err := options.Notifier.Notify(ctx, watchNotification(result)); err != nil {
+				fmt.Printf("⚠️  %v\n", err)
+			}
+		}
+	}
+
+	if options.Format == "json" {
+		printJSON(results)
+	}
+}
+
+// watchNotification resume las novedades de una búsqueda en una notificación
+func watchNotification(result *core.WatchResult) core.Notification {
+	var parts []string
+	if len(result.New) > 0 {
+		parts = append(parts, fmt.Sprintf("%d new", len(result.New)))
+	}
+	if len(result.Changed) > 0 {
+		parts = append(parts, fmt.Sprintf("%d changed", len(result.Changed)))
+	}
+
+	var names []string
+	hackathons := append([]*models.Hackathon{}, result.New...)
+	for _, change := range result.Changed {
+		hackathons = append(hackathons, change.Hackathon)
+	}
+	for _, hackathon := range hackathons[:min(3, len(hackathons))] {
+		names = append(names, hackathon.Name)
+	}
+	if len(hackathons) > 3 {
+		names = append(names, fmt.Sprintf("and %d more", len(hackathons)-3))
+	}
+
+	event := "watch.new"
+	if len(result.New) == 0 {
+		event = "watch.changed"
+	}
+
+	return core.Notification{
+		Title:      fmt.Sprintf("Antoine: %s hackathons in '%s'", strings.Join(parts, ", "), result.Search.Name),
+		Message:    strings.Join(names, ", "),
+		Event:      event,
+		Hackathons: hackathons,
+	}
+}
+
+func printWatchResult(result *core.WatchResult) {
+	nameStyle := lipgloss.NewStyle().Foreground(ascii.Gold).Bold(true)
+	timestamp := time.Now().Format("15:04:05")
+	header := fmt.Sprintf("[%s] %s", timestamp, nameStyle.Render(result.Search.Name))
+
+	switch {
+	case result.Error != "":
+		fmt.Printf("%s %s\n", header, lipgloss.NewStyle().Foreground(styles.Red).Render("❌ "+result.Error))
+		return
+	case result.Baseline:
+		fmt.Printf("%s 📸 baseline saved with %d hackathons\n", header, result.Total)
+		return
+	case !result.HasUpdates():
+		fmt.Printf("%s no changes (%d hackathons)\n", header, result.Total)
+		return
+	}
+
+	fmt.Printf("%s ✨ %d new, %d changed, %d gone\n", header, len(result.New), len(result.Changed), result.Removed)
+	for _, hackathon := range result.New {
+		fmt.Printf("   ➕ %s  %s  %s\n", hackathon.Name, hackathonDate(hackathon), hackathon.URL)
+	}
+	for _, change := range result.Changed {
+		fmt.Printf("   ✏️  %s: %s\n", change.Hackathon.Name, strings.Join(change.Changes, "; "))
+	}
+}
+
+func hackathonDate(hackathon *models.Hackathon) string {
+	if hackathon.StartDate.IsZero() {
+		return "TBA"
+	}
+	return hackathon.StartDate.Format("2006-01-02")
+}
@@ -578,6 +578,18 @@ func FormatBytes(bytes int64) string {
 	return fmt.Sprintf("%.1f %s", float64(bytes)/float64(div), units[exp])
 }
 
+// FormatUSD abbreviates a dollar amount ($1.2M, $3.4B)
+func FormatUSD(amount float64) string {
+	switch {
+	case amount >= 1e9:
+		return fmt.Sprintf("$%.1fB", amount/1e9)
+	case amount >= 1e6:
+		return fmt.Sprintf("$%.1fM", amount/1e6)
+	default:
+		return fmt.Sprintf("$%.0fK", amount/1e3)
+	}
+}
+
 // ParseBytes parses human-readable byte format
 func ParseBytes(s string) (int64, error) {
 	re := regexp.MustCompile(`^(\d+(?:\.\d+)?)\s*(B|KB|MB|GB|TB|PB)?$`)
@@ -332,60 +332,60 @@ func (cl *ContextLogger) WithError(err error) *ContextLogger {
 
 // Logging methods for ContextLogger
 func (cl *ContextLogger) Trace(args ...interface{}) {
-	cl.logger.WithFields(cl.fields).Trace(args...)
+	cl.logger.Logger.WithFields(cl.fields).Trace(args...)
 }
 
 func (cl *ContextLogger) Debug(args ...interface{}) {
-	cl.logger.WithFields(cl.fields).Debug(args...)
+	cl.logger.Logger.WithFields(cl.fields).Debug(args...)
 }
 
 func (cl *ContextLogger) Info(args ...interface{}) {
-	cl.logger.WithFields(cl.fields).Info(args...)
+	cl.logger.Logger.WithFields(cl.fields).Info(args...)
 }
 
 func (cl *ContextLogger) Warn(args ...interface{}) {
-	cl.logger.WithFields(cl.fields).Warn(args...)
+	cl.logger.Logger.WithFields(cl.fields).Warn(args...)
 }
 
 func (cl *ContextLogger) Error(args ...interface{}) {
-	cl.logger.WithFields(cl.fields).Error(args...)
+	cl.logger.Logger.WithFields(cl.fields).Error(args...)
 }
 
 func (cl *ContextLogger) Fatal(args ...interface{}) {
-	cl.logger.WithFields(cl.fields).Fatal(args...)
+	cl.logger.Logger.WithFields(cl.fields).Fatal(args...)
 }
 
 func (cl *ContextLogger) Panic(args ...interface{}) {
-	cl.logger.WithFields(cl.fields).Panic(args...)
+	cl.logger.Logger.WithFields(cl.fields).Panic(args...)
 }
 
 // Formatted logging methods for ContextLogger
 func (cl *ContextLogger) Tracef(format string, args ...interface{}) {
-	cl.logger.WithFields(cl.fields).Tracef(format, args...)
+	cl.logger.Logger.WithFields(cl.fields).Tracef(format, args...)
 }
 
 func (cl *ContextLogger) Debugf(format string, args ...interface{}) {
-	cl.logger.WithFields(cl.fields).Debugf(format, args...)
+	cl.logger.Logger.WithFields(cl.fields).Debugf(format, args...)
 }
 
 func (cl *ContextLogger) Infof(format string, args ...interface{}) {
-	cl.logger.WithFields(cl.fields).Infof(format, args...)
+	cl.logger.Logger.WithFields(cl.fields).Infof(format, args...)
 }
 
 func (cl *ContextLogger) Warnf(format string, args ...interface{}) {
-	cl.logger.WithFields(cl.fields).Warnf(format, args...)
+	cl.logger.Logger.WithFields(cl.fields).Warnf(format, args...)
 }
 
 func (cl *ContextLogger) Errorf(format string, args ...interface{}) {
-	cl.logger.WithFields(cl.fields).Errorf(format, args...)
+	cl.logger.Logger.WithFields(cl.fields).Errorf(format, args...)
 }
 
 func (cl *ContextLogger) Fatalf(format string, args ...interface{}) {
-	cl.logger.WithFields(cl.fields).Fatalf(format, args...)
+	cl.logger.Logger.WithFields(cl.fields).Fatalf(format, args...)
 }
 
 func (cl *ContextLogger) Panicf(format string, args ...interface{}) {
-	cl.logger.WithFields(cl.fields).Panicf(format, args...)
+	cl.logger.Logger.WithFields(cl.fields).Panicf(format, args...)
 }
 
 // Performance logging helpers
@@ -8,6 +8,7 @@ import (
 	"runtime"
 	"time"
 
+	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"antoine-cli/cmd"
@@ -69,10 +70,12 @@ func initializeApp() error {
 	// Set up global flags and environment
 	setupGlobalEnvironment(termInfo)
 
-	// Display welcome message if appropriate
-	if shouldShowWelcome() {
-		displayWelcome(termInfo)
-	}
+	// Display welcome message if appropriate, once cobra has parsed the flags
+	cmd.SetWelcome(func(command *cobra.Command) {
+		if shouldShowWelcome(command) {
+			displayWelcome(termInfo)
+		}
+	})
 
 	return nil
 }
@@ -207,14 +210,16 @@ func setupGlobalEnvironment(termInfo *terminal.TerminalInfo) {
 	})
 }
 
-// shouldShowWelcome determines if we should show the welcome message
-func shouldShowWelcome() bool {
-	// Obtener flags del comando root
-	rootCmd := cmd.GetRootCommand()
+// shouldShowWelcome determines if we should show the welcome message before running command
+func shouldShowWelcome(command *cobra.Command) bool {
+	quiet, _ := command.Flags().GetBool("quiet")
 
-	// Verificar flag quiet
-	quietFlag := rootCmd.PersistentFlags().Lookup("quiet")
-	isQuiet := quietFlag != nil && quietFlag.Changed
+	// Con un formato de salida no interactivo (json, ics...) stdout debe quedar limpio
+	if command.Flags().Changed("format") {
+		if format, _ := command.Flags().GetString("format"); format != "interactive" {
+			quiet = true
+		}
+	}
 
 	// Don't show welcome if:
 	// - Running in non-interactive mode
@@ -222,7 +227,7 @@ func shouldShowWelcome() bool {
 	// - NO_COLOR is set (might be in a script)
 	// - Not a TTY
 
-	if isQuiet || os.Getenv("NO_COLOR") != "" {
+	if quiet || os.Getenv("NO_COLOR") != "" {
 		return false
 	}
 
@@ -230,21 +235,15 @@ func shouldShowWelcome() bool {
 		return false
 	}
 
-	// Check if we're running a specific command (not just help)
-	args := os.Args[1:]
-	if len(args) > 0 {
-		// Don't show welcome for help commands
-		if args[0] == "help" || args[0] == "--help" || args[0] == "-h" {
-			return false
-		}
-		// Don't show welcome for version commands
-		if args[0] == "version" || args[0] == "--version" || args[0] == "-v" {
-			return false
-		}
-		// Don't show welcome for config commands
-		if args[0] == "config" {
-			return false
-		}
+	// Don't show welcome for help, version or config commands; cmd already skips it when
+	// stdout is not a terminal or the command writes a document to it
+	top := command
+	for top.HasParent() && top.Parent().HasParent() {
+		top = top.Parent()
+	}
+	switch top.Name() {
+	case "help", "version", "config":
+		return false
 	}
 
 	return true
@@ -245,6 +245,12 @@ func stripAnsi(s string) string {
 	return result
 }
 
+// DashboardTrend es una barra del bloque de tecnologías del dashboard
+type DashboardTrend struct {
+	Name    string
+	Percent int
+}
+
 // Dashboard components
 func GetDashboardStats(stats map[string]interface{}) string {
 	style := lipgloss.NewStyle().
@@ -253,24 +259,33 @@ func GetDashboardStats(stats map[string]interface{}) string {
 		Padding(1, 2).
 		Margin(1, 0)
 
+	trends := "No trend lookups yet - try 'antoine trends --tech AI'"
+	if items, ok := stats["top_technologies"].([]DashboardTrend); ok && len(items) > 0 {
+		lines := make([]string, 0, len(items))
+		for _, item := range items {
+			filled := item.Percent * 12 / 100
+			lines = append(lines, fmt.Sprintf("%-12s %s (%d%%)",
+				strings.Repeat("█", filled), item.Name, item.Percent))
+		}
+		trends = strings.Join(lines, "\n")
+	}
+
 	content := fmt.Sprintf(`%s Antoine Dashboard %s
 
-🎯 Active Hackathons: %v    📊 Projects Analyzed: %v
-🔍 Searches Today: %v       ⭐ Success Rate: %v%%
+🎯 Hackathon Results (total, all searches): %v    📊 Projects Analyzed: %v
+🔍 Searches Today: %v       🔎 Last Search: %v hackathons
+⚡ Cache Hit Rate: %v
 
 %s Trending Technologies:
-████████████ AI/ML (89%%)
-██████████   Blockchain (76%%)
-████████     Web3 (65%%)
-
-%s Recent Achievements:
-• ETHGlobal Winner: "DeFi Innovation"
-• 3 projects secured funding this week
-• Community grew by 150 developers`,
+%s
+
+%s Last update: %v`,
 		EmojiRocket, EmojiSparkles,
 		stats["hackathons"], stats["projects"],
-		stats["searches"], stats["success_rate"],
-		EmojiBrain, EmojiTrophy)
+		stats["searches"], stats["last_search"],
+		stats["cache_hit_rate"],
+		EmojiBrain, trends,
+		EmojiTrophy, stats["last_update"])
 
 	return style.Render(content)
 }